@@ -91,6 +91,13 @@ func NewAppConfigurationV1UsingExternalConfig(options *AppConfigurationV1Options
 		return
 	}
 
+	if options.URL == "" {
+		err = configureServiceURLFromRegion(appConfiguration, options.ServiceName)
+		if err != nil {
+			return
+		}
+	}
+
 	if options.URL != "" {
 		err = appConfiguration.Service.SetServiceURL(options.URL)
 		err = core.RepurposeSDKProblem(err, "url-set-error")
@@ -126,9 +133,11 @@ func NewAppConfigurationV1(options *AppConfigurationV1Options) (service *AppConf
 	return
 }
 
-// GetServiceURLForRegion returns the service URL to be used for the specified region
+// GetServiceURLForRegion returns the service URL to be used for the specified region, without the instance GUID.
+// Prefix the region with "private." to get the private endpoint. Use GetServiceURLForInstance to get the complete
+// URL of an instance.
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	return serviceURLForRegion(region)
 }
 
 // Clone makes a copy of "appConfiguration" suitable for processing requests.
@@ -253,7 +262,7 @@ func (appConfiguration *AppConfigurationV1) ListEnvironmentsWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_environments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listEnvironmentsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -341,7 +350,7 @@ func (appConfiguration *AppConfigurationV1) CreateEnvironmentWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_environment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, createEnvironmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -430,7 +439,7 @@ func (appConfiguration *AppConfigurationV1) UpdateEnvironmentWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_environment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateEnvironmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -506,7 +515,7 @@ func (appConfiguration *AppConfigurationV1) GetEnvironmentWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_environment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getEnvironmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -575,7 +584,7 @@ func (appConfiguration *AppConfigurationV1) DeleteEnvironmentWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_environment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteEnvironmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -663,7 +672,7 @@ func (appConfiguration *AppConfigurationV1) ListCollectionsWithContext(ctx conte
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_collections", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listCollectionsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -748,7 +757,7 @@ func (appConfiguration *AppConfigurationV1) CreateCollectionWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_collection", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, createCollectionOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -834,7 +843,7 @@ func (appConfiguration *AppConfigurationV1) UpdateCollectionWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_collection", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateCollectionOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -910,7 +919,7 @@ func (appConfiguration *AppConfigurationV1) GetCollectionWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_collection", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getCollectionOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -979,7 +988,7 @@ func (appConfiguration *AppConfigurationV1) DeleteCollectionWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_collection", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteCollectionOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1076,7 +1085,7 @@ func (appConfiguration *AppConfigurationV1) ListFeaturesWithContext(ctx context.
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_features", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, listFeaturesOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1195,7 +1204,7 @@ func (appConfiguration *AppConfigurationV1) CreateFeatureWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_feature", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, createFeatureOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1306,7 +1315,7 @@ func (appConfiguration *AppConfigurationV1) UpdateFeatureWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_feature", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateFeatureOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1413,7 +1422,7 @@ func (appConfiguration *AppConfigurationV1) UpdateFeatureValuesWithContext(ctx c
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_feature_values", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateFeatureValuesOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1487,7 +1496,7 @@ func (appConfiguration *AppConfigurationV1) GetFeatureWithContext(ctx context.Co
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_feature", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getFeatureOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1557,7 +1566,7 @@ func (appConfiguration *AppConfigurationV1) DeleteFeatureWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_feature", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteFeatureOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1638,7 +1647,7 @@ func (appConfiguration *AppConfigurationV1) ToggleFeatureWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "toggle_feature", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, toggleFeatureOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1724,7 +1733,7 @@ func (appConfiguration *AppConfigurationV1) StopFeatureRolloutWithContext(ctx co
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "stop_feature_rollout", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, stopFeatureRolloutOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1823,7 +1832,7 @@ func (appConfiguration *AppConfigurationV1) CreateFeatureRuleWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_feature_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, createFeatureRuleOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1893,7 +1902,7 @@ func (appConfiguration *AppConfigurationV1) ListFeatureRulesWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_feature_rules", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, listFeatureRulesOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -1964,7 +1973,7 @@ func (appConfiguration *AppConfigurationV1) GetFeatureRuleWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_feature_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getFeatureRuleOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2061,7 +2070,7 @@ func (appConfiguration *AppConfigurationV1) UpdateFeatureRuleWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_feature_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateFeatureRuleOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2132,7 +2141,7 @@ func (appConfiguration *AppConfigurationV1) DeleteFeatureRuleWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_feature_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteFeatureRuleOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2220,7 +2229,7 @@ func (appConfiguration *AppConfigurationV1) StopFeatureRuleRolloutWithContext(ct
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "stop_feature_rule_rollout", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, stopFeatureRuleRolloutOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2296,7 +2305,7 @@ func (appConfiguration *AppConfigurationV1) UpdateFeatureRuleOrderWithContext(ct
 	response, err = appConfiguration.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_feature_rule_order", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateFeatureRuleOrderOptions)
 		return
 	}
 
@@ -2385,7 +2394,7 @@ func (appConfiguration *AppConfigurationV1) ListPropertiesWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_properties", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, listPropertiesOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2489,7 +2498,7 @@ func (appConfiguration *AppConfigurationV1) CreatePropertyWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, createPropertyOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2585,7 +2594,7 @@ func (appConfiguration *AppConfigurationV1) UpdatePropertyWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updatePropertyOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2679,7 +2688,7 @@ func (appConfiguration *AppConfigurationV1) UpdatePropertyValuesWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_property_values", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updatePropertyValuesOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2753,7 +2762,7 @@ func (appConfiguration *AppConfigurationV1) GetPropertyWithContext(ctx context.C
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getPropertyOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2823,7 +2832,7 @@ func (appConfiguration *AppConfigurationV1) DeletePropertyWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deletePropertyOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2905,7 +2914,7 @@ func (appConfiguration *AppConfigurationV1) ListSegmentsWithContext(ctx context.
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_segments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listSegmentsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -2993,7 +3002,7 @@ func (appConfiguration *AppConfigurationV1) CreateSegmentWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_segment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, createSegmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3082,7 +3091,7 @@ func (appConfiguration *AppConfigurationV1) UpdateSegmentWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_segment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateSegmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3155,7 +3164,7 @@ func (appConfiguration *AppConfigurationV1) GetSegmentWithContext(ctx context.Co
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_segment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getSegmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3224,7 +3233,7 @@ func (appConfiguration *AppConfigurationV1) DeleteSegmentWithContext(ctx context
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_segment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteSegmentOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3303,7 +3312,7 @@ func (appConfiguration *AppConfigurationV1) ListGitconfigsWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_gitconfigs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listGitconfigsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3400,7 +3409,7 @@ func (appConfiguration *AppConfigurationV1) CreateGitconfigWithContext(ctx conte
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_gitconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, createGitconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3498,7 +3507,7 @@ func (appConfiguration *AppConfigurationV1) UpdateGitconfigWithContext(ctx conte
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_gitconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateGitconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3567,7 +3576,7 @@ func (appConfiguration *AppConfigurationV1) GetGitconfigWithContext(ctx context.
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_gitconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getGitconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3634,7 +3643,7 @@ func (appConfiguration *AppConfigurationV1) DeleteGitconfigWithContext(ctx conte
 	response, err = appConfiguration.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_gitconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteGitconfigOptions)
 		return
 	}
 
@@ -3699,7 +3708,7 @@ func (appConfiguration *AppConfigurationV1) PromoteGitconfigWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "promote_gitconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, promoteGitconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3773,7 +3782,7 @@ func (appConfiguration *AppConfigurationV1) RestoreGitconfigWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "restore_gitconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, restoreGitconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3843,7 +3852,7 @@ func (appConfiguration *AppConfigurationV1) ListIntegrationsWithContext(ctx cont
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_integrations", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listIntegrationsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3925,7 +3934,7 @@ func (appConfiguration *AppConfigurationV1) CreateIntegrationWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_integration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, createIntegrationOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -3994,7 +4003,7 @@ func (appConfiguration *AppConfigurationV1) GetIntegrationWithContext(ctx contex
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_integration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getIntegrationOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4061,7 +4070,7 @@ func (appConfiguration *AppConfigurationV1) DeleteIntegrationWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_integration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteIntegrationOptions)
 		return
 	}
 
@@ -4113,7 +4122,7 @@ func (appConfiguration *AppConfigurationV1) ListOriginconfigsWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_originconfigs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listOriginconfigsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4189,7 +4198,7 @@ func (appConfiguration *AppConfigurationV1) UpdateOriginconfigsWithContext(ctx c
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_originconfigs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, updateOriginconfigsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4261,7 +4270,7 @@ func (appConfiguration *AppConfigurationV1) ListWorkflowconfigWithContext(ctx co
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_workflowconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, listWorkflowconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4340,7 +4349,7 @@ func (appConfiguration *AppConfigurationV1) CreateWorkflowconfigWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_Workflowconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, createWorkflowconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4419,7 +4428,7 @@ func (appConfiguration *AppConfigurationV1) UpdateWorkflowconfigWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_Workflowconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateWorkflowconfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4489,7 +4498,7 @@ func (appConfiguration *AppConfigurationV1) DeleteWorkflowconfigWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_workflowconfig", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteWorkflowconfigOptions)
 		return
 	}
 
@@ -4554,7 +4563,7 @@ func (appConfiguration *AppConfigurationV1) ListWorkflowConfigsWithContext(ctx c
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_workflow_configs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listWorkflowConfigsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4642,7 +4651,7 @@ func (appConfiguration *AppConfigurationV1) CreateWorkflowConfigsWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_workflow_configs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, createWorkflowConfigsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4711,7 +4720,7 @@ func (appConfiguration *AppConfigurationV1) GetWorkflowConfigWithContext(ctx con
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_workflow_config", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, getWorkflowConfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4803,7 +4812,7 @@ func (appConfiguration *AppConfigurationV1) UpdateWorkflowConfigsWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_workflow_configs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, updateWorkflowConfigsOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -4870,7 +4879,7 @@ func (appConfiguration *AppConfigurationV1) DeleteWorkflowConfigsWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_workflow_configs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, deleteWorkflowConfigsOptions)
 		return
 	}
 
@@ -4942,7 +4951,7 @@ func (appConfiguration *AppConfigurationV1) ToggleWorkflowConfigWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "toggle_workflow_config", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, toggleWorkflowConfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -5011,7 +5020,7 @@ func (appConfiguration *AppConfigurationV1) TestWorkflowConfigWithContext(ctx co
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "test_workflow_config", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, testWorkflowConfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -5097,7 +5106,7 @@ func (appConfiguration *AppConfigurationV1) ImportConfigWithContext(ctx context.
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "import_config", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, importConfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -5157,7 +5166,7 @@ func (appConfiguration *AppConfigurationV1) ListInstanceConfigWithContext(ctx co
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_instance_config", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, listInstanceConfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -5226,7 +5235,7 @@ func (appConfiguration *AppConfigurationV1) PromoteRestoreConfigWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "promote_restore_config", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, nil, promoteRestoreConfigOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -5297,7 +5306,7 @@ func (appConfiguration *AppConfigurationV1) InstanceConfigStatusWithContext(ctx
 	response, err = appConfiguration.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "instance_config_status", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = newOperationError(err, pathParamsMap, instanceConfigStatusOptions)
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,537 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultBulkConcurrency is the number of operations run in parallel by ExecuteBulk when
+// BulkOptions.Concurrency is not set.
+const DefaultBulkConcurrency = 5
+
+// BulkOperation : A single operation to be run by ExecuteBulk.
+type BulkOperation struct {
+	// Name identifies the operation in the bulk results, for example "toggle-feature dev/feature_1".
+	Name string
+
+	// Invoke runs the operation with the supplied client. The result is the model returned by the underlying
+	// service method (for example *Feature or *WorkflowApprovalInitiatedResponse).
+	Invoke func(ctx context.Context, client *AppConfigurationV1) (result interface{}, response *core.DetailedResponse, err error)
+}
+
+// BulkOptions : Options that control how ExecuteBulk runs a list of operations.
+type BulkOptions struct {
+	// The maximum number of operations running at the same time. Defaults to DefaultBulkConcurrency.
+	Concurrency int
+
+	// If set to true, no further operations are started once an operation has failed. Operations that were not
+	// started are reported with Skipped set to true.
+	StopOnError bool
+}
+
+// BulkResult : The outcome of a single operation run by ExecuteBulk.
+type BulkResult struct {
+	// Position of the operation in the list passed to ExecuteBulk.
+	Index int
+
+	// Name of the operation.
+	Name string
+
+	// The model returned by the service method, if any.
+	Result interface{}
+
+	// The detailed response returned by the service method, if any.
+	Response *core.DetailedResponse
+
+	// The HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+
+	// Set when the change was not applied directly because a workflow approval was initiated for it.
+	WorkflowApproval *WorkflowApprovalInitiatedResponse
+
+	// The error returned by the operation, if any.
+	Err error
+
+	// True if the operation was not started because an earlier operation failed and StopOnError was set, or
+	// because the context was cancelled.
+	Skipped bool
+}
+
+// BulkResults : The per-operation outcomes of a call to ExecuteBulk, in the same order as the operations.
+type BulkResults []BulkResult
+
+// Failed returns the results of the operations that were started and returned an error.
+func (results BulkResults) Failed() (failed BulkResults) {
+	for _, result := range results {
+		if result.Err != nil && !result.Skipped {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// PendingApproval returns the results of the operations that initiated a workflow approval.
+func (results BulkResults) PendingApproval() (pending BulkResults) {
+	for _, result := range results {
+		if result.WorkflowApproval != nil {
+			pending = append(pending, result)
+		}
+	}
+	return
+}
+
+// ExecuteBulk : Run a list of operations with bounded concurrency
+// Run the operations through this client, at most BulkOptions.Concurrency at a time, and return one result per
+// operation. The returned error is nil unless StopOnError was set and an operation failed, or the context was
+// cancelled; per-operation errors are always reported in the results.
+func (appConfiguration *AppConfigurationV1) ExecuteBulk(operations []BulkOperation, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.ExecuteBulkWithContext(context.Background(), operations, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ExecuteBulkWithContext is an alternate form of the ExecuteBulk method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) ExecuteBulkWithContext(ctx context.Context, operations []BulkOperation, options *BulkOptions) (results BulkResults, err error) {
+	concurrency := DefaultBulkConcurrency
+	stopOnError := false
+	if options != nil {
+		if options.Concurrency < 0 {
+			err = core.SDKErrorf(nil, "bulk concurrency must not be negative", "bulk-concurrency-error", common.GetComponentInfo())
+			return
+		}
+		if options.Concurrency > 0 {
+			concurrency = options.Concurrency
+		}
+		stopOnError = options.StopOnError
+	}
+	for i, operation := range operations {
+		if operation.Invoke == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("bulk operation %d (%s) has no Invoke function", i, operation.Name), "bulk-operation-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results = make(BulkResults, len(operations))
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		firstErr error
+	)
+	semaphore := make(chan struct{}, concurrency)
+
+	for i, operation := range operations {
+		results[i] = BulkResult{Index: i, Name: operation.Name}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-runCtx.Done():
+		}
+		if runCtx.Err() != nil {
+			results[i].Skipped = true
+			results[i].Err = runCtx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, operation BulkOperation) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result, response, opErr := operation.Invoke(runCtx, appConfiguration)
+			outcome := &results[i]
+			outcome.Result = result
+			outcome.Response = response
+			outcome.Err = opErr
+			if response != nil {
+				outcome.StatusCode = response.GetStatusCode()
+			}
+			outcome.WorkflowApproval = workflowApprovalFromResult(result, response)
+
+			if opErr != nil && stopOnError {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = opErr
+				}
+				mutex.Unlock()
+				cancel()
+			}
+		}(i, operation)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		err = core.SDKErrorf(firstErr, "bulk execution stopped after an operation failed", "bulk-stopped", common.GetComponentInfo())
+		return
+	}
+	if ctx.Err() != nil {
+		err = core.SDKErrorf(ctx.Err(), "", "bulk-context-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// workflowApprovalFromResult returns the workflow approval initiated by an operation, if any. Delete operations
+// return a WorkflowApprovalInitiatedResponse directly; other operations answer with a 202 status and the approval
+// details attached to the returned resource.
+func workflowApprovalFromResult(result interface{}, response *core.DetailedResponse) *WorkflowApprovalInitiatedResponse {
+	if approval, ok := result.(*WorkflowApprovalInitiatedResponse); ok && approval != nil && approval.WorkflowApproval != nil {
+		return approval
+	}
+	if response == nil || response.GetStatusCode() != http.StatusAccepted {
+		return nil
+	}
+
+	var info *WorkflowApprovalInfo
+	switch model := result.(type) {
+	case *Feature:
+		if model != nil {
+			info = model.WorkflowApproval
+		}
+	case *Property:
+		if model != nil {
+			info = model.WorkflowApproval
+		}
+	case *Segment:
+		if model != nil {
+			info = model.WorkflowApproval
+		}
+	case *Environment:
+		if model != nil {
+			info = model.WorkflowApproval
+		}
+	}
+	if info == nil {
+		return nil
+	}
+	return &WorkflowApprovalInitiatedResponse{
+		Message: core.StringPtr("workflow approval initiated"),
+		WorkflowApproval: &WorkflowApprovalDetails{
+			WorkflowName:        info.WorkflowName,
+			WorkflowID:          info.WorkflowID,
+			ProviderType:        info.ProviderType,
+			ChangeRequestNumber: info.ChangeRequestNumber,
+			ChangeRequestStatus: info.ChangeRequestStatus,
+			ExecutionStatus:     info.ExecutionStatus,
+			ApprovalURL:         info.ApprovalURL,
+			ResourceType:        info.ResourceType,
+			ResourceID:          info.ResourceID,
+			EnvironmentID:       info.EnvironmentID,
+			CreatedTime:         info.CreatedTime,
+			UpdatedTime:         info.UpdatedTime,
+		},
+	}
+}
+
+// NewToggleFeatureBulkOperation : Instantiate a BulkOperation that runs ToggleFeature
+func (*AppConfigurationV1) NewToggleFeatureBulkOperation(options *ToggleFeatureOptions) BulkOperation {
+	return BulkOperation{
+		Name: fmt.Sprintf("toggle-feature %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.ToggleFeatureWithContext(ctx, options)
+		},
+	}
+}
+
+// NewUpdateFeatureBulkOperation : Instantiate a BulkOperation that runs UpdateFeature
+func (*AppConfigurationV1) NewUpdateFeatureBulkOperation(options *UpdateFeatureOptions) BulkOperation {
+	return BulkOperation{
+		Name: fmt.Sprintf("update-feature %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateFeatureWithContext(ctx, options)
+		},
+	}
+}
+
+// NewUpdateFeatureValuesBulkOperation : Instantiate a BulkOperation that runs UpdateFeatureValues
+func (*AppConfigurationV1) NewUpdateFeatureValuesBulkOperation(options *UpdateFeatureValuesOptions) BulkOperation {
+	return BulkOperation{
+		Name: fmt.Sprintf("update-feature-values %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateFeatureValuesWithContext(ctx, options)
+		},
+	}
+}
+
+// NewDeleteFeatureBulkOperation : Instantiate a BulkOperation that runs DeleteFeature
+func (*AppConfigurationV1) NewDeleteFeatureBulkOperation(options *DeleteFeatureOptions) BulkOperation {
+	return BulkOperation{
+		Name: fmt.Sprintf("delete-feature %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.DeleteFeatureWithContext(ctx, options)
+		},
+	}
+}
+
+// NewUpdatePropertyBulkOperation : Instantiate a BulkOperation that runs UpdateProperty
+func (*AppConfigurationV1) NewUpdatePropertyBulkOperation(options *UpdatePropertyOptions) BulkOperation {
+	return BulkOperation{
+		Name: fmt.Sprintf("update-property %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.PropertyID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdatePropertyWithContext(ctx, options)
+		},
+	}
+}
+
+// NewUpdatePropertyValuesBulkOperation : Instantiate a BulkOperation that runs UpdatePropertyValues
+func (*AppConfigurationV1) NewUpdatePropertyValuesBulkOperation(options *UpdatePropertyValuesOptions) BulkOperation {
+	return BulkOperation{
+		Name: fmt.Sprintf("update-property-values %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.PropertyID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdatePropertyValuesWithContext(ctx, options)
+		},
+	}
+}
+
+// NewDeletePropertyBulkOperation : Instantiate a BulkOperation that runs DeleteProperty
+func (*AppConfigurationV1) NewDeletePropertyBulkOperation(options *DeletePropertyOptions) BulkOperation {
+	return BulkOperation{
+		Name: fmt.Sprintf("delete-property %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.PropertyID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.DeletePropertyWithContext(ctx, options)
+		},
+	}
+}
+
+// ToggleFeaturesByTag : Toggle every feature with the given tags
+// Turn on or off all the features of an environment that are associated with any of the specified comma separated
+// tags.
+func (appConfiguration *AppConfigurationV1) ToggleFeaturesByTag(environmentID string, tags string, enabled bool, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.ToggleFeaturesByTagWithContext(context.Background(), environmentID, tags, enabled, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ToggleFeaturesByTagWithContext is an alternate form of the ToggleFeaturesByTag method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) ToggleFeaturesByTagWithContext(ctx context.Context, environmentID string, tags string, enabled bool, options *BulkOptions) (results BulkResults, err error) {
+	features, err := appConfiguration.listFeaturesByTag(ctx, environmentID, tags)
+	if err != nil {
+		return
+	}
+
+	operations := make([]BulkOperation, 0, len(features))
+	for _, feature := range features {
+		operations = append(operations, appConfiguration.NewToggleFeatureBulkOperation(
+			appConfiguration.NewToggleFeatureOptions(environmentID, *feature.FeatureID, enabled)))
+	}
+	return appConfiguration.ExecuteBulkWithContext(ctx, operations, options)
+}
+
+// DeleteFeaturesByTag : Delete every feature with the given tags
+// Delete all the features of an environment that are associated with any of the specified comma separated tags.
+func (appConfiguration *AppConfigurationV1) DeleteFeaturesByTag(environmentID string, tags string, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.DeleteFeaturesByTagWithContext(context.Background(), environmentID, tags, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteFeaturesByTagWithContext is an alternate form of the DeleteFeaturesByTag method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) DeleteFeaturesByTagWithContext(ctx context.Context, environmentID string, tags string, options *BulkOptions) (results BulkResults, err error) {
+	features, err := appConfiguration.listFeaturesByTag(ctx, environmentID, tags)
+	if err != nil {
+		return
+	}
+
+	operations := make([]BulkOperation, 0, len(features))
+	for _, feature := range features {
+		operations = append(operations, appConfiguration.NewDeleteFeatureBulkOperation(
+			appConfiguration.NewDeleteFeatureOptions(environmentID, *feature.FeatureID)))
+	}
+	return appConfiguration.ExecuteBulkWithContext(ctx, operations, options)
+}
+
+// DeletePropertiesByTag : Delete every property with the given tags
+// Delete all the properties of an environment that are associated with any of the specified comma separated tags.
+func (appConfiguration *AppConfigurationV1) DeletePropertiesByTag(environmentID string, tags string, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.DeletePropertiesByTagWithContext(context.Background(), environmentID, tags, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeletePropertiesByTagWithContext is an alternate form of the DeletePropertiesByTag method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) DeletePropertiesByTagWithContext(ctx context.Context, environmentID string, tags string, options *BulkOptions) (results BulkResults, err error) {
+	properties, err := appConfiguration.listPropertiesByTag(ctx, environmentID, tags)
+	if err != nil {
+		return
+	}
+
+	operations := make([]BulkOperation, 0, len(properties))
+	for _, property := range properties {
+		operations = append(operations, appConfiguration.NewDeletePropertyBulkOperation(
+			appConfiguration.NewDeletePropertyOptions(environmentID, *property.PropertyID)))
+	}
+	return appConfiguration.ExecuteBulkWithContext(ctx, operations, options)
+}
+
+// AddFeaturesToCollection : Add features to a collection
+// Add each of the specified features of an environment to a collection. The existing collections of each feature
+// are preserved.
+func (appConfiguration *AppConfigurationV1) AddFeaturesToCollection(environmentID string, collectionID string, featureIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.AddFeaturesToCollectionWithContext(context.Background(), environmentID, collectionID, featureIDs, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AddFeaturesToCollectionWithContext is an alternate form of the AddFeaturesToCollection method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) AddFeaturesToCollectionWithContext(ctx context.Context, environmentID string, collectionID string, featureIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	return appConfiguration.ExecuteBulkWithContext(ctx, featureMembershipOperations(environmentID, collectionID, featureIDs, false), options)
+}
+
+// RemoveFeaturesFromCollection : Remove features from a collection
+// Remove each of the specified features of an environment from a collection. The other collections of each feature
+// are preserved.
+func (appConfiguration *AppConfigurationV1) RemoveFeaturesFromCollection(environmentID string, collectionID string, featureIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.RemoveFeaturesFromCollectionWithContext(context.Background(), environmentID, collectionID, featureIDs, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RemoveFeaturesFromCollectionWithContext is an alternate form of the RemoveFeaturesFromCollection method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) RemoveFeaturesFromCollectionWithContext(ctx context.Context, environmentID string, collectionID string, featureIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	return appConfiguration.ExecuteBulkWithContext(ctx, featureMembershipOperations(environmentID, collectionID, featureIDs, true), options)
+}
+
+// AddPropertiesToCollection : Add properties to a collection
+// Add each of the specified properties of an environment to a collection. The existing collections of each property
+// are preserved.
+func (appConfiguration *AppConfigurationV1) AddPropertiesToCollection(environmentID string, collectionID string, propertyIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.AddPropertiesToCollectionWithContext(context.Background(), environmentID, collectionID, propertyIDs, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AddPropertiesToCollectionWithContext is an alternate form of the AddPropertiesToCollection method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) AddPropertiesToCollectionWithContext(ctx context.Context, environmentID string, collectionID string, propertyIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	return appConfiguration.ExecuteBulkWithContext(ctx, propertyMembershipOperations(environmentID, collectionID, propertyIDs, false), options)
+}
+
+// RemovePropertiesFromCollection : Remove properties from a collection
+// Remove each of the specified properties of an environment from a collection. The other collections of each
+// property are preserved.
+func (appConfiguration *AppConfigurationV1) RemovePropertiesFromCollection(environmentID string, collectionID string, propertyIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	results, err = appConfiguration.RemovePropertiesFromCollectionWithContext(context.Background(), environmentID, collectionID, propertyIDs, options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RemovePropertiesFromCollectionWithContext is an alternate form of the RemovePropertiesFromCollection method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) RemovePropertiesFromCollectionWithContext(ctx context.Context, environmentID string, collectionID string, propertyIDs []string, options *BulkOptions) (results BulkResults, err error) {
+	return appConfiguration.ExecuteBulkWithContext(ctx, propertyMembershipOperations(environmentID, collectionID, propertyIDs, true), options)
+}
+
+// featureMembershipOperations builds the operations that add features to, or remove them from, a collection. The
+// update replaces the whole feature, so each operation changes the collections of the current feature with
+// MutateFeature, which sends its other fields unchanged.
+func featureMembershipOperations(environmentID string, collectionID string, featureIDs []string, remove bool) []BulkOperation {
+	action := "add-feature-to-collection"
+	if remove {
+		action = "remove-feature-from-collection"
+	}
+
+	operations := make([]BulkOperation, 0, len(featureIDs))
+	for _, featureID := range featureIDs {
+		featureID := featureID
+		operations = append(operations, BulkOperation{
+			Name: fmt.Sprintf("%s %s/%s %s", action, environmentID, featureID, collectionID),
+			Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+				return client.MutateFeature(ctx, environmentID, featureID, func(feature *Feature) error {
+					feature.Collections = collectionMembership(feature.Collections, collectionID, remove)
+					return nil
+				})
+			},
+		})
+	}
+	return operations
+}
+
+// propertyMembershipOperations is the property counterpart of featureMembershipOperations.
+func propertyMembershipOperations(environmentID string, collectionID string, propertyIDs []string, remove bool) []BulkOperation {
+	action := "add-property-to-collection"
+	if remove {
+		action = "remove-property-from-collection"
+	}
+
+	operations := make([]BulkOperation, 0, len(propertyIDs))
+	for _, propertyID := range propertyIDs {
+		propertyID := propertyID
+		operations = append(operations, BulkOperation{
+			Name: fmt.Sprintf("%s %s/%s %s", action, environmentID, propertyID, collectionID),
+			Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+				return client.MutateProperty(ctx, environmentID, propertyID, func(property *Property) error {
+					property.Collections = collectionMembership(property.Collections, collectionID, remove)
+					return nil
+				})
+			},
+		})
+	}
+	return operations
+}
+
+// collectionMembership returns the collections of a resource with the specified collection added, or removed.
+func collectionMembership(current []CollectionRef, collectionID string, remove bool) []CollectionRef {
+	collections := make([]CollectionRef, 0, len(current)+1)
+	for _, collection := range current {
+		if collection.CollectionID == nil {
+			continue
+		}
+		if *collection.CollectionID == collectionID {
+			if remove {
+				continue
+			}
+			return current
+		}
+		collections = append(collections, collection)
+	}
+	if !remove {
+		collections = append(collections, CollectionRef{CollectionID: core.StringPtr(collectionID)})
+	}
+	return collections
+}
+
+func (appConfiguration *AppConfigurationV1) listFeaturesByTag(ctx context.Context, environmentID string, tags string) (features []Feature, err error) {
+	listOptions := appConfiguration.NewListFeaturesOptions(environmentID)
+	listOptions.SetTags(tags)
+	pager, err := appConfiguration.NewFeaturesPager(listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "pager-error")
+		return
+	}
+	features, err = pager.GetAllWithContext(ctx)
+	err = core.RepurposeSDKProblem(err, "list-features-error")
+	return
+}
+
+func (appConfiguration *AppConfigurationV1) listPropertiesByTag(ctx context.Context, environmentID string, tags string) (properties []Property, err error) {
+	listOptions := appConfiguration.NewListPropertiesOptions(environmentID)
+	listOptions.SetTags(tags)
+	pager, err := appConfiguration.NewPropertiesPager(listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "pager-error")
+		return
+	}
+	properties, err = pager.GetAllWithContext(ctx)
+	err = core.RepurposeSDKProblem(err, "list-properties-error")
+	return
+}
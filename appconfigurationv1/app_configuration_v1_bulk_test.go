@@ -0,0 +1,293 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 bulk operations`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+
+	newService := func() {
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`ExecuteBulk(operations []BulkOperation, options *BulkOptions)`, func() {
+		var inFlight, maxInFlight int32
+
+		BeforeEach(func() {
+			atomic.StoreInt32(&inFlight, 0)
+			atomic.StoreInt32(&maxInFlight, 0)
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					seen := atomic.LoadInt32(&maxInFlight)
+					if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+
+				Expect(req.Method).To(Equal("PUT"))
+				featureID := strings.Split(req.URL.EscapedPath(), "/")[4]
+				res.Header().Set("Content-type", "application/json")
+				switch featureID {
+				case "broken":
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"errors": [{"code": "bad_request", "message": "invalid feature"}]}`)
+				case "approval":
+					res.WriteHeader(202)
+					fmt.Fprint(res, `{"message": "Workflow approval initiated", "workflow_approval": {"workflow_name": "WorkflowName", "workflow_id": "WorkflowID", "provider_type": "SERVICENOW_IBM", "change_request_number": "CHG0001", "change_request_status": "PENDING", "execution_status": "PENDING", "approval_url": "ApprovalURL", "resource_type": "FEATURE", "resource_id": "approval", "environment_id": "dev", "created_time": "2019-01-01T12:00:00.000Z", "updated_time": "2019-01-01T12:00:00.000Z"}}`)
+				default:
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"name": "%s", "feature_id": "%s", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true}`, featureID, featureID)
+				}
+			}))
+			newService()
+		})
+
+		It(`Runs every operation with bounded concurrency`, func() {
+			operations := []appconfigurationv1.BulkOperation{}
+			for i := 0; i < 12; i++ {
+				operations = append(operations, appConfigurationService.NewToggleFeatureBulkOperation(
+					appConfigurationService.NewToggleFeatureOptions("dev", fmt.Sprintf("feature_%d", i), true)))
+			}
+
+			results, err := appConfigurationService.ExecuteBulk(operations, &appconfigurationv1.BulkOptions{Concurrency: 3})
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(12))
+			Expect(results.Failed()).To(BeEmpty())
+			Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 3))
+			for i, result := range results {
+				Expect(result.Index).To(Equal(i))
+				Expect(result.StatusCode).To(Equal(200))
+				Expect(result.Result).To(BeAssignableToTypeOf(&appconfigurationv1.Feature{}))
+				Expect(*result.Result.(*appconfigurationv1.Feature).FeatureID).To(Equal(fmt.Sprintf("feature_%d", i)))
+			}
+		})
+
+		It(`Reports per-item failures and workflow approvals`, func() {
+			operations := []appconfigurationv1.BulkOperation{
+				appConfigurationService.NewToggleFeatureBulkOperation(appConfigurationService.NewToggleFeatureOptions("dev", "ok", true)),
+				appConfigurationService.NewToggleFeatureBulkOperation(appConfigurationService.NewToggleFeatureOptions("dev", "broken", true)),
+				appConfigurationService.NewToggleFeatureBulkOperation(appConfigurationService.NewToggleFeatureOptions("dev", "approval", true)),
+			}
+
+			results, err := appConfigurationService.ExecuteBulk(operations, nil)
+			Expect(err).To(BeNil())
+			Expect(results.Failed()).To(HaveLen(1))
+			Expect(results[1].StatusCode).To(Equal(400))
+			Expect(results[1].Err).ToNot(BeNil())
+			Expect(results.PendingApproval()).To(HaveLen(1))
+			Expect(results[2].StatusCode).To(Equal(202))
+			Expect(*results[2].WorkflowApproval.WorkflowApproval.ChangeRequestNumber).To(Equal("CHG0001"))
+		})
+
+		It(`Stops starting operations after the first failure when requested`, func() {
+			operations := []appconfigurationv1.BulkOperation{
+				appConfigurationService.NewToggleFeatureBulkOperation(appConfigurationService.NewToggleFeatureOptions("dev", "broken", true)),
+			}
+			for i := 0; i < 5; i++ {
+				operations = append(operations, appConfigurationService.NewToggleFeatureBulkOperation(
+					appConfigurationService.NewToggleFeatureOptions("dev", fmt.Sprintf("feature_%d", i), true)))
+			}
+
+			results, err := appConfigurationService.ExecuteBulk(operations, &appconfigurationv1.BulkOptions{Concurrency: 1, StopOnError: true})
+			Expect(err).ToNot(BeNil())
+			Expect(results[0].Err).ToNot(BeNil())
+			for _, result := range results[1:] {
+				Expect(result.Skipped).To(BeTrue())
+			}
+		})
+
+		It(`Rejects invalid input`, func() {
+			_, err := appConfigurationService.ExecuteBulk(nil, &appconfigurationv1.BulkOptions{Concurrency: -1})
+			Expect(err).ToNot(BeNil())
+			_, err = appConfigurationService.ExecuteBulk([]appconfigurationv1.BulkOperation{{Name: "empty"}}, nil)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It(`Honors context cancellation`, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			results, err := appConfigurationService.ExecuteBulkWithContext(ctx, []appconfigurationv1.BulkOperation{
+				appConfigurationService.NewToggleFeatureBulkOperation(appConfigurationService.NewToggleFeatureOptions("dev", "ok", true)),
+			}, nil)
+			Expect(err).ToNot(BeNil())
+			Expect(results[0].Skipped).To(BeTrue())
+		})
+	})
+
+	Describe(`ToggleFeaturesByTag and DeleteFeaturesByTag`, func() {
+		var mutex sync.Mutex
+		var calls []string
+
+		BeforeEach(func() {
+			calls = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				mutex.Lock()
+				calls = append(calls, req.Method+" "+req.URL.EscapedPath())
+				mutex.Unlock()
+
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.Method == "GET" && req.URL.EscapedPath() == "/environments/dev/features":
+					Expect(req.URL.Query()["tags"]).To(Equal([]string{"beta"}))
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"features": [{"name": "a", "feature_id": "a", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false}, {"name": "b", "feature_id": "b", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false}], "limit": 10, "offset": 0, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}}`)
+				case req.Method == "PUT":
+					Expect(strings.HasSuffix(req.URL.EscapedPath(), "/toggle")).To(BeTrue())
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					Expect(body["enabled"]).To(Equal(false))
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"name": "a", "feature_id": "a", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false}`)
+				case req.Method == "DELETE":
+					res.WriteHeader(204)
+				default:
+					Fail("unexpected request " + req.Method + " " + req.URL.String())
+				}
+			}))
+			newService()
+		})
+
+		It(`Toggles every feature with the tag`, func() {
+			results, err := appConfigurationService.ToggleFeaturesByTag("dev", "beta", false, nil)
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(2))
+			Expect(results.Failed()).To(BeEmpty())
+			Expect(calls).To(ContainElements("PUT /environments/dev/features/a/toggle", "PUT /environments/dev/features/b/toggle"))
+		})
+
+		It(`Deletes every feature with the tag`, func() {
+			results, err := appConfigurationService.DeleteFeaturesByTag("dev", "beta", nil)
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(2))
+			Expect(results.Failed()).To(BeEmpty())
+			Expect(calls).To(ContainElements("DELETE /environments/dev/features/a", "DELETE /environments/dev/features/b"))
+		})
+	})
+
+	Describe(`AddFeaturesToCollection and RemovePropertiesFromCollection`, func() {
+		var updates map[string]map[string]interface{}
+		var mutex sync.Mutex
+
+		BeforeEach(func() {
+			updates = map[string]map[string]interface{}{}
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				path := req.URL.EscapedPath()
+				switch req.Method {
+				case "GET":
+					Expect(req.URL.Query().Get("include")).To(Equal("collections,rules"))
+					res.WriteHeader(200)
+					if strings.Contains(path, "/features/") {
+						fmt.Fprint(res, `{"name": "f", "feature_id": "f", "description": "Flag", "tags": "web", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false,
+							"enabled": true, "rollout_percentage": 40, "segment_rules": [{"rules": [{"segments": ["beta"]}], "value": false, "order": 1}],
+							"collections": [{"collection_id": "web", "name": "Web"}]}`)
+					} else {
+						fmt.Fprint(res, `{"name": "p", "property_id": "p", "tags": "web", "type": "BOOLEAN", "value": true,
+							"segment_rules": [{"rules": [{"segments": ["beta"]}], "value": false, "order": 1}],
+							"collections": [{"collection_id": "web", "name": "Web"}, {"collection_id": "mobile", "name": "Mobile"}]}`)
+					}
+				case "PUT":
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					mutex.Lock()
+					updates[path] = body
+					mutex.Unlock()
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{}`)
+				}
+			}))
+			newService()
+		})
+
+		It(`Keeps existing collections when adding`, func() {
+			results, err := appConfigurationService.AddFeaturesToCollection("dev", "mobile", []string{"f"}, nil)
+			Expect(err).To(BeNil())
+			Expect(results.Failed()).To(BeEmpty())
+			update := updates["/environments/dev/features/f"]
+			Expect(update["collections"]).To(ConsistOf(
+				map[string]interface{}{"collection_id": "web"},
+				map[string]interface{}{"collection_id": "mobile"},
+			))
+			// The update replaces the feature flag, so it carries its other fields unchanged.
+			Expect(update).To(HaveKeyWithValue("name", "f"))
+			Expect(update).To(HaveKeyWithValue("description", "Flag"))
+			Expect(update).To(HaveKeyWithValue("tags", "web"))
+			Expect(update).To(HaveKeyWithValue("enabled", true))
+			Expect(update).To(HaveKeyWithValue("enabled_value", true))
+			Expect(update).To(HaveKeyWithValue("disabled_value", false))
+			Expect(update).To(HaveKeyWithValue("rollout_percentage", float64(40)))
+			Expect(update["segment_rules"]).To(HaveLen(1))
+		})
+
+		It(`Does not write a feature flag already in the collection`, func() {
+			results, err := appConfigurationService.AddFeaturesToCollection("dev", "web", []string{"f"}, nil)
+			Expect(err).To(BeNil())
+			Expect(results.Failed()).To(BeEmpty())
+			Expect(updates).To(BeEmpty())
+		})
+
+		It(`Marks the collection as deleted when removing`, func() {
+			results, err := appConfigurationService.RemovePropertiesFromCollection("dev", "mobile", []string{"p"}, nil)
+			Expect(err).To(BeNil())
+			Expect(results.Failed()).To(BeEmpty())
+			update := updates["/environments/dev/properties/p"]
+			Expect(update["collections"]).To(ConsistOf(
+				map[string]interface{}{"collection_id": "web"},
+				map[string]interface{}{"collection_id": "mobile", "deleted": true},
+			))
+			Expect(update).To(HaveKeyWithValue("name", "p"))
+			Expect(update).To(HaveKeyWithValue("tags", "web"))
+			Expect(update).To(HaveKeyWithValue("value", true))
+			Expect(update["segment_rules"]).To(HaveLen(1))
+		})
+	})
+})
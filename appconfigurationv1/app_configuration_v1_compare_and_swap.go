@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// compareAndSwapAttempts is the number of times the resource is read before a compare-and-swap update gives up, when
+// a merge function keeps reapplying the update to newer versions of the resource.
+const compareAndSwapAttempts = 5
+
+// ConcurrentModificationError : A compare-and-swap update was not applied because the resource was updated after
+// the caller read it. It matches ErrConflict with errors.Is.
+type ConcurrentModificationError struct {
+	// The type of the resource, one of the ServiceError_ResourceType_* constants, and its ID.
+	ResourceType string
+	ResourceID   string
+
+	// The update time the caller read, and the current one.
+	ExpectedUpdatedTime *strfmt.DateTime
+	UpdatedTime         *strfmt.DateTime
+}
+
+// Error returns the resource and its update times, for example
+// `resource conflict: feature 'checkout' was updated at 2026-03-02T10:00:00.000Z, expected 2026-03-01T09:00:00.000Z`.
+func (concurrentModificationError *ConcurrentModificationError) Error() string {
+	return fmt.Sprintf("%s: %s '%s' was updated at %s, expected %s", ErrConflict, concurrentModificationError.ResourceType,
+		concurrentModificationError.ResourceID, formatUpdatedTime(concurrentModificationError.UpdatedTime),
+		formatUpdatedTime(concurrentModificationError.ExpectedUpdatedTime))
+}
+
+// Unwrap returns ErrConflict.
+func (concurrentModificationError *ConcurrentModificationError) Unwrap() error {
+	return ErrConflict
+}
+
+// UpdateFeatureIfUnchanged : Update a feature flag unless it changed since it was read
+// Read the feature flag and update it only if its update time is still lastUpdatedTime. Otherwise, if merge is nil,
+// fail with a *ConcurrentModificationError. If merge is set, it is called with the current feature flag and the
+// update, and returns the update to apply to it instead, or nil to fail with the conflict. The merged update is applied
+// if the feature flag does not change again, up to a few attempts. The service has no conditional update, so the check
+// narrows the window in which concurrent updates are lost but cannot close it.
+func (appConfiguration *AppConfigurationV1) UpdateFeatureIfUnchanged(updateFeatureOptions *UpdateFeatureOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Feature, updateFeatureOptions *UpdateFeatureOptions) (*UpdateFeatureOptions, error)) (result *Feature, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.UpdateFeatureIfUnchangedWithContext(context.Background(), updateFeatureOptions, lastUpdatedTime, merge)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateFeatureIfUnchangedWithContext is an alternate form of the UpdateFeatureIfUnchanged method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpdateFeatureIfUnchangedWithContext(ctx context.Context, updateFeatureOptions *UpdateFeatureOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Feature, updateFeatureOptions *UpdateFeatureOptions) (*UpdateFeatureOptions, error)) (result *Feature, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateFeatureOptions, "updateFeatureOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	return compareAndSwap(ServiceError_ResourceType_Feature, updateFeatureOptions.FeatureID, lastUpdatedTime, updateFeatureOptions, merge,
+		func() (*Feature, *core.DetailedResponse, error) {
+			return appConfiguration.GetFeatureWithContext(ctx, &GetFeatureOptions{
+				EnvironmentID: updateFeatureOptions.EnvironmentID,
+				FeatureID:     updateFeatureOptions.FeatureID,
+				Include:       []string{GetFeatureOptions_Include_Collections, GetFeatureOptions_Include_Rules},
+				Headers:       updateFeatureOptions.Headers,
+			})
+		},
+		func(current *Feature) *strfmt.DateTime { return current.UpdatedTime },
+		func(update *UpdateFeatureOptions) (*Feature, *core.DetailedResponse, error) {
+			return appConfiguration.UpdateFeatureWithContext(ctx, update)
+		})
+}
+
+// UpdatePropertyIfUnchanged : Update a property unless it changed since it was read
+// Read the property and update it only if its update time is still lastUpdatedTime. See UpdateFeatureIfUnchanged for
+// how conflicts are reported or merged.
+func (appConfiguration *AppConfigurationV1) UpdatePropertyIfUnchanged(updatePropertyOptions *UpdatePropertyOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Property, updatePropertyOptions *UpdatePropertyOptions) (*UpdatePropertyOptions, error)) (result *Property, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.UpdatePropertyIfUnchangedWithContext(context.Background(), updatePropertyOptions, lastUpdatedTime, merge)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdatePropertyIfUnchangedWithContext is an alternate form of the UpdatePropertyIfUnchanged method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpdatePropertyIfUnchangedWithContext(ctx context.Context, updatePropertyOptions *UpdatePropertyOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Property, updatePropertyOptions *UpdatePropertyOptions) (*UpdatePropertyOptions, error)) (result *Property, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updatePropertyOptions, "updatePropertyOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	return compareAndSwap(ServiceError_ResourceType_Property, updatePropertyOptions.PropertyID, lastUpdatedTime, updatePropertyOptions, merge,
+		func() (*Property, *core.DetailedResponse, error) {
+			return appConfiguration.GetPropertyWithContext(ctx, &GetPropertyOptions{
+				EnvironmentID: updatePropertyOptions.EnvironmentID,
+				PropertyID:    updatePropertyOptions.PropertyID,
+				Include:       []string{GetPropertyOptions_Include_Collections, GetPropertyOptions_Include_Rules},
+				Headers:       updatePropertyOptions.Headers,
+			})
+		},
+		func(current *Property) *strfmt.DateTime { return current.UpdatedTime },
+		func(update *UpdatePropertyOptions) (*Property, *core.DetailedResponse, error) {
+			return appConfiguration.UpdatePropertyWithContext(ctx, update)
+		})
+}
+
+// UpdateSegmentIfUnchanged : Update a segment unless it changed since it was read
+// Read the segment and update it only if its update time is still lastUpdatedTime. See UpdateFeatureIfUnchanged for
+// how conflicts are reported or merged.
+func (appConfiguration *AppConfigurationV1) UpdateSegmentIfUnchanged(updateSegmentOptions *UpdateSegmentOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Segment, updateSegmentOptions *UpdateSegmentOptions) (*UpdateSegmentOptions, error)) (result *Segment, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.UpdateSegmentIfUnchangedWithContext(context.Background(), updateSegmentOptions, lastUpdatedTime, merge)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateSegmentIfUnchangedWithContext is an alternate form of the UpdateSegmentIfUnchanged method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpdateSegmentIfUnchangedWithContext(ctx context.Context, updateSegmentOptions *UpdateSegmentOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Segment, updateSegmentOptions *UpdateSegmentOptions) (*UpdateSegmentOptions, error)) (result *Segment, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateSegmentOptions, "updateSegmentOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	return compareAndSwap(ServiceError_ResourceType_Segment, updateSegmentOptions.SegmentID, lastUpdatedTime, updateSegmentOptions, merge,
+		func() (*Segment, *core.DetailedResponse, error) {
+			return appConfiguration.GetSegmentWithContext(ctx, &GetSegmentOptions{
+				SegmentID: updateSegmentOptions.SegmentID,
+				Headers:   updateSegmentOptions.Headers,
+			})
+		},
+		func(current *Segment) *strfmt.DateTime { return current.UpdatedTime },
+		func(update *UpdateSegmentOptions) (*Segment, *core.DetailedResponse, error) {
+			return appConfiguration.UpdateSegmentWithContext(ctx, update)
+		})
+}
+
+// UpdateCollectionIfUnchanged : Update a collection unless it changed since it was read
+// Read the collection and update it only if its update time is still lastUpdatedTime. See UpdateFeatureIfUnchanged
+// for how conflicts are reported or merged.
+func (appConfiguration *AppConfigurationV1) UpdateCollectionIfUnchanged(updateCollectionOptions *UpdateCollectionOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Collection, updateCollectionOptions *UpdateCollectionOptions) (*UpdateCollectionOptions, error)) (result *CollectionLite, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.UpdateCollectionIfUnchangedWithContext(context.Background(), updateCollectionOptions, lastUpdatedTime, merge)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateCollectionIfUnchangedWithContext is an alternate form of the UpdateCollectionIfUnchanged method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpdateCollectionIfUnchangedWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Collection, updateCollectionOptions *UpdateCollectionOptions) (*UpdateCollectionOptions, error)) (result *CollectionLite, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateCollectionOptions, "updateCollectionOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	return compareAndSwap(ServiceError_ResourceType_Collection, updateCollectionOptions.CollectionID, lastUpdatedTime, updateCollectionOptions, merge,
+		func() (*Collection, *core.DetailedResponse, error) {
+			return appConfiguration.GetCollectionWithContext(ctx, &GetCollectionOptions{
+				CollectionID: updateCollectionOptions.CollectionID,
+				Headers:      updateCollectionOptions.Headers,
+			})
+		},
+		func(current *Collection) *strfmt.DateTime { return current.UpdatedTime },
+		func(update *UpdateCollectionOptions) (*CollectionLite, *core.DetailedResponse, error) {
+			return appConfiguration.UpdateCollectionWithContext(ctx, update)
+		})
+}
+
+// UpdateEnvironmentIfUnchanged : Update an environment unless it changed since it was read
+// Read the environment and update it only if its update time is still lastUpdatedTime. See UpdateFeatureIfUnchanged
+// for how conflicts are reported or merged.
+func (appConfiguration *AppConfigurationV1) UpdateEnvironmentIfUnchanged(updateEnvironmentOptions *UpdateEnvironmentOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Environment, updateEnvironmentOptions *UpdateEnvironmentOptions) (*UpdateEnvironmentOptions, error)) (result *Environment, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.UpdateEnvironmentIfUnchangedWithContext(context.Background(), updateEnvironmentOptions, lastUpdatedTime, merge)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateEnvironmentIfUnchangedWithContext is an alternate form of the UpdateEnvironmentIfUnchanged method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpdateEnvironmentIfUnchangedWithContext(ctx context.Context, updateEnvironmentOptions *UpdateEnvironmentOptions, lastUpdatedTime *strfmt.DateTime, merge func(current *Environment, updateEnvironmentOptions *UpdateEnvironmentOptions) (*UpdateEnvironmentOptions, error)) (result *Environment, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateEnvironmentOptions, "updateEnvironmentOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	return compareAndSwap(ServiceError_ResourceType_Environment, updateEnvironmentOptions.EnvironmentID, lastUpdatedTime, updateEnvironmentOptions, merge,
+		func() (*Environment, *core.DetailedResponse, error) {
+			return appConfiguration.GetEnvironmentWithContext(ctx, &GetEnvironmentOptions{
+				EnvironmentID: updateEnvironmentOptions.EnvironmentID,
+				Headers:       updateEnvironmentOptions.Headers,
+			})
+		},
+		func(current *Environment) *strfmt.DateTime { return current.UpdatedTime },
+		func(update *UpdateEnvironmentOptions) (*Environment, *core.DetailedResponse, error) {
+			return appConfiguration.UpdateEnvironmentWithContext(ctx, update)
+		})
+}
+
+// compareAndSwap reads a resource with get and applies update with write if the update time of the resource is still
+// lastUpdatedTime, merging the update into newer versions of the resource when merge is set. An update rejected with
+// a conflict by the service is retried the same way.
+func compareAndSwap[Resource, Options, Result any](resourceType string, resourceID *string, lastUpdatedTime *strfmt.DateTime, update *Options,
+	merge func(*Resource, *Options) (*Options, error),
+	get func() (*Resource, *core.DetailedResponse, error),
+	updatedTime func(*Resource) *strfmt.DateTime,
+	write func(*Options) (*Result, *core.DetailedResponse, error)) (result *Result, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(lastUpdatedTime, "lastUpdatedTime cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	expected := lastUpdatedTime
+	for attempt := 1; ; attempt++ {
+		var current *Resource
+		current, response, err = get()
+		if err != nil {
+			return nil, response, err
+		}
+		if actual := updatedTime(current); !sameUpdatedTime(actual, expected) {
+			conflict := &ConcurrentModificationError{
+				ResourceType:        resourceType,
+				ResourceID:          core.StringNilMapper(resourceID),
+				ExpectedUpdatedTime: expected,
+				UpdatedTime:         actual,
+			}
+			if merge == nil || attempt >= compareAndSwapAttempts {
+				return nil, response, core.SDKErrorf(conflict, "", "concurrent-modification", common.GetComponentInfo())
+			}
+			var merged *Options
+			merged, err = merge(current, update)
+			if err != nil {
+				return nil, response, core.SDKErrorf(err, "", "merge-error", common.GetComponentInfo())
+			}
+			if merged == nil {
+				return nil, response, core.SDKErrorf(conflict, "", "concurrent-modification", common.GetComponentInfo())
+			}
+			update, expected = merged, actual
+			continue
+		}
+
+		result, response, err = write(update)
+		if err != nil && merge != nil && attempt < compareAndSwapAttempts && isConflict(err) {
+			continue
+		}
+		return result, response, err
+	}
+}
+
+// sameUpdatedTime returns true if the update times are both missing or the same instant.
+func sameUpdatedTime(a *strfmt.DateTime, b *strfmt.DateTime) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return time.Time(*a).Equal(time.Time(*b))
+}
+
+func formatUpdatedTime(updatedTime *strfmt.DateTime) string {
+	if updatedTime == nil {
+		return "an unknown time"
+	}
+	return updatedTime.String()
+}
@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 compare-and-swap updates`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+	var updatedTimes []string
+	var updates []map[string]interface{}
+
+	// The fake service returns the feature with the next of updatedTimes on each read, and records the updates.
+	BeforeEach(func() {
+		updatedTimes = nil
+		updates = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/environments/dev/features/checkout"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method {
+			case "GET":
+				updatedTime := updatedTimes[0]
+				if len(updatedTimes) > 1 {
+					updatedTimes = updatedTimes[1:]
+				}
+				fmt.Fprintf(res, `{"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "tags": "shop", "updated_time": "%s"}`, updatedTime)
+			case "PUT":
+				body := map[string]interface{}{}
+				content, _ := io.ReadAll(req.Body)
+				Expect(json.Unmarshal(content, &body)).To(Succeed())
+				updates = append(updates, body)
+				res.Write(content)
+			}
+		}))
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	lastUpdatedTime := func() *strfmt.DateTime {
+		updatedTime, err := core.ParseDateTime("2026-03-01T09:00:00Z")
+		Expect(err).To(BeNil())
+		return &updatedTime
+	}
+
+	updateFeatureOptions := func() *appconfigurationv1.UpdateFeatureOptions {
+		return &appconfigurationv1.UpdateFeatureOptions{
+			EnvironmentID: core.StringPtr("dev"),
+			FeatureID:     core.StringPtr("checkout"),
+			Name:          core.StringPtr("Checkout v2"),
+		}
+	}
+
+	It(`Updates a feature that did not change`, func() {
+		updatedTimes = []string{"2026-03-01T09:00:00Z"}
+		result, _, err := appConfigurationService.UpdateFeatureIfUnchanged(updateFeatureOptions(), lastUpdatedTime(), nil)
+		Expect(err).To(BeNil())
+		Expect(*result.Name).To(Equal("Checkout v2"))
+		Expect(updates).To(HaveLen(1))
+	})
+
+	It(`Fails with a conflict when the feature changed`, func() {
+		updatedTimes = []string{"2026-03-02T10:00:00Z"}
+		_, _, err := appConfigurationService.UpdateFeatureIfUnchanged(updateFeatureOptions(), lastUpdatedTime(), nil)
+		Expect(errors.Is(err, appconfigurationv1.ErrConflict)).To(BeTrue())
+		var conflict *appconfigurationv1.ConcurrentModificationError
+		Expect(errors.As(err, &conflict)).To(BeTrue())
+		Expect(conflict.ResourceType).To(Equal(appconfigurationv1.ServiceError_ResourceType_Feature))
+		Expect(conflict.ResourceID).To(Equal("checkout"))
+		Expect(conflict.Error()).To(Equal("resource conflict: feature 'checkout' was updated at 2026-03-02T10:00:00.000Z, expected 2026-03-01T09:00:00.000Z"))
+		Expect(updates).To(BeEmpty())
+
+		_, _, err = appConfigurationService.UpdateFeatureIfUnchanged(updateFeatureOptions(), nil, nil)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Merges the update into a changed feature`, func() {
+		updatedTimes = []string{"2026-03-02T10:00:00Z", "2026-03-02T10:00:00Z"}
+		merges := 0
+		merge := func(current *appconfigurationv1.Feature, update *appconfigurationv1.UpdateFeatureOptions) (*appconfigurationv1.UpdateFeatureOptions, error) {
+			merges++
+			update.Tags = current.Tags
+			return update, nil
+		}
+		_, _, err := appConfigurationService.UpdateFeatureIfUnchanged(updateFeatureOptions(), lastUpdatedTime(), merge)
+		Expect(err).To(BeNil())
+		Expect(merges).To(Equal(1))
+		Expect(updates).To(Equal([]map[string]interface{}{{"name": "Checkout v2", "tags": "shop"}}))
+
+		// The feature keeps changing, so the update is given up after a few attempts.
+		updates, merges = nil, 0
+		updatedTimes = []string{"2026-03-02T10:00:00Z", "2026-03-02T11:00:00Z", "2026-03-02T12:00:00Z", "2026-03-02T13:00:00Z", "2026-03-02T14:00:00Z"}
+		_, _, err = appConfigurationService.UpdateFeatureIfUnchanged(updateFeatureOptions(), lastUpdatedTime(), merge)
+		Expect(errors.Is(err, appconfigurationv1.ErrConflict)).To(BeTrue())
+		Expect(merges).To(Equal(4))
+		Expect(updates).To(BeEmpty())
+
+		// A merge function can refuse the update.
+		updatedTimes = []string{"2026-03-02T10:00:00Z"}
+		_, _, err = appConfigurationService.UpdateFeatureIfUnchanged(updateFeatureOptions(), lastUpdatedTime(), func(*appconfigurationv1.Feature, *appconfigurationv1.UpdateFeatureOptions) (*appconfigurationv1.UpdateFeatureOptions, error) {
+			return nil, nil
+		})
+		Expect(errors.Is(err, appconfigurationv1.ErrConflict)).To(BeTrue())
+	})
+})
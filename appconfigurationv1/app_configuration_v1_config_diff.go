@@ -0,0 +1,428 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ConfigChange : A single difference between two instance configurations, as returned by DiffConfig.
+type ConfigChange struct {
+	// The kind of resource that changed, one of the ConfigChange_Kind_* constants.
+	Kind string
+
+	// What has to be done to the resource, one of the ConfigChange_Action_* constants.
+	Action string
+
+	// The environment of the feature or property. Empty for other kinds of resources.
+	EnvironmentID string
+
+	// The ID of the resource.
+	ID string
+
+	// The JSON names of the attributes that differ, for updates.
+	Fields []string
+
+	// The resource in the current configuration (nil for creates). One of *ImportEnvironmentSchema,
+	// *ImportCollectionSchema, *ImportSegmentSchema, *ImportFeatureRequestBody or *ImportPropertyRequestBody.
+	Current interface{}
+
+	// The resource in the desired configuration (nil for deletes), of the same type as Current.
+	Desired interface{}
+}
+
+// Constants associated with the ConfigChange.Kind property.
+const (
+	ConfigChange_Kind_Collection  = "collection"
+	ConfigChange_Kind_Environment = "environment"
+	ConfigChange_Kind_Feature     = "feature"
+	ConfigChange_Kind_Property    = "property"
+	ConfigChange_Kind_Segment     = "segment"
+)
+
+// Constants associated with the ConfigChange.Action property.
+// `replace` is used when an attribute that cannot be updated in place (the type or format of a feature or property)
+// differs, so the resource has to be deleted and created again.
+const (
+	ConfigChange_Action_Create  = "create"
+	ConfigChange_Action_Delete  = "delete"
+	ConfigChange_Action_Replace = "replace"
+	ConfigChange_Action_Update  = "update"
+)
+
+// String returns a one-line description of the change, for example `update feature dev/flag_1 (enabled, tags)`.
+func (change ConfigChange) String() string {
+	id := change.ID
+	if change.EnvironmentID != "" {
+		id = change.EnvironmentID + "/" + id
+	}
+	description := fmt.Sprintf("%s %s %s", change.Action, change.Kind, id)
+	if len(change.Fields) > 0 {
+		description += " (" + strings.Join(change.Fields, ", ") + ")"
+	}
+	return description
+}
+
+// ConfigDiff : The changes that turn one instance configuration into another.
+// Creates and updates come first, parents before children (collections, segments, environments, then features and
+// properties); deletes come last, children before parents.
+type ConfigDiff []ConfigChange
+
+// Count returns the number of changes with the given action.
+func (diff ConfigDiff) Count(action string) (count int) {
+	for _, change := range diff {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns one line per change.
+func (diff ConfigDiff) String() string {
+	lines := make([]string, len(diff))
+	for i, change := range diff {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DiffConfig returns the changes that turn the current configuration into the desired one. Either configuration
+// may be nil, which is the same as an empty configuration.
+// Server-assigned attributes (segment rule IDs and collection names) are ignored, and collection membership is
+// compared regardless of order.
+func DiffConfig(current *ImportConfig, desired *ImportConfig) (diff ConfigDiff) {
+	if current == nil {
+		current = &ImportConfig{}
+	}
+	if desired == nil {
+		desired = &ImportConfig{}
+	}
+
+	currentCollections := map[string]interface{}{}
+	for i := range current.Collections {
+		currentCollections[*current.Collections[i].CollectionID] = &current.Collections[i]
+	}
+	desiredCollections := map[string]interface{}{}
+	for i := range desired.Collections {
+		desiredCollections[*desired.Collections[i].CollectionID] = &desired.Collections[i]
+	}
+	currentSegments := map[string]interface{}{}
+	for i := range current.Segments {
+		currentSegments[*current.Segments[i].SegmentID] = &current.Segments[i]
+	}
+	desiredSegments := map[string]interface{}{}
+	for i := range desired.Segments {
+		desiredSegments[*desired.Segments[i].SegmentID] = &desired.Segments[i]
+	}
+	currentEnvironments := map[string]interface{}{}
+	for i := range current.Environments {
+		currentEnvironments[*current.Environments[i].EnvironmentID] = &current.Environments[i]
+	}
+	desiredEnvironments := map[string]interface{}{}
+	for i := range desired.Environments {
+		desiredEnvironments[*desired.Environments[i].EnvironmentID] = &desired.Environments[i]
+	}
+
+	collections := diffResources(ConfigChange_Kind_Collection, "", currentCollections, desiredCollections)
+	segments := diffResources(ConfigChange_Kind_Segment, "", currentSegments, desiredSegments)
+	environments := diffResources(ConfigChange_Kind_Environment, "", currentEnvironments, desiredEnvironments)
+
+	var children ConfigDiff
+	for _, environmentID := range sortedKeys(currentEnvironments, desiredEnvironments) {
+		currentFeatures, currentProperties := map[string]interface{}{}, map[string]interface{}{}
+		if environment, ok := currentEnvironments[environmentID]; ok {
+			environment := environment.(*ImportEnvironmentSchema)
+			for i := range environment.Features {
+				currentFeatures[*environment.Features[i].FeatureID] = &environment.Features[i]
+			}
+			for i := range environment.Properties {
+				currentProperties[*environment.Properties[i].PropertyID] = &environment.Properties[i]
+			}
+		}
+		desiredFeatures, desiredProperties := map[string]interface{}{}, map[string]interface{}{}
+		if environment, ok := desiredEnvironments[environmentID]; ok {
+			environment := environment.(*ImportEnvironmentSchema)
+			for i := range environment.Features {
+				desiredFeatures[*environment.Features[i].FeatureID] = &environment.Features[i]
+			}
+			for i := range environment.Properties {
+				desiredProperties[*environment.Properties[i].PropertyID] = &environment.Properties[i]
+			}
+		}
+		children = append(children, diffResources(ConfigChange_Kind_Feature, environmentID, currentFeatures, desiredFeatures)...)
+		children = append(children, diffResources(ConfigChange_Kind_Property, environmentID, currentProperties, desiredProperties)...)
+	}
+
+	isDelete := func(change ConfigChange) bool { return change.Action == ConfigChange_Action_Delete }
+	for _, group := range []ConfigDiff{collections, segments, environments, children} {
+		for _, change := range group {
+			if !isDelete(change) {
+				diff = append(diff, change)
+			}
+		}
+	}
+	for _, group := range []ConfigDiff{children, environments, segments, collections} {
+		for _, change := range group {
+			if isDelete(change) {
+				diff = append(diff, change)
+			}
+		}
+	}
+	return
+}
+
+func diffResources(kind string, environmentID string, current map[string]interface{}, desired map[string]interface{}) (diff ConfigDiff) {
+	for _, id := range sortedKeys(current, desired) {
+		currentResource, inCurrent := current[id]
+		desiredResource, inDesired := desired[id]
+		change := ConfigChange{
+			Kind:          kind,
+			EnvironmentID: environmentID,
+			ID:            id,
+			Current:       currentResource,
+			Desired:       desiredResource,
+		}
+		switch {
+		case !inCurrent:
+			change.Action = ConfigChange_Action_Create
+		case !inDesired:
+			change.Action = ConfigChange_Action_Delete
+		default:
+			change.Fields = diffFields(normalizeForDiff(kind, currentResource), normalizeForDiff(kind, desiredResource))
+			if len(change.Fields) == 0 {
+				continue
+			}
+			change.Action = ConfigChange_Action_Update
+			for _, field := range change.Fields {
+				if field == "type" || field == "format" {
+					change.Action = ConfigChange_Action_Replace
+				}
+			}
+		}
+		diff = append(diff, change)
+	}
+	return
+}
+
+// normalizeForDiff converts a resource to its JSON form, without the attributes that are not compared.
+func normalizeForDiff(kind string, resource interface{}) map[string]interface{} {
+	normalized := map[string]interface{}{}
+	buffer, err := json.Marshal(resource)
+	if err == nil {
+		err = json.Unmarshal(buffer, &normalized)
+	}
+	if err != nil {
+		return normalized
+	}
+
+	switch kind {
+	case ConfigChange_Kind_Environment:
+		delete(normalized, "features")
+		delete(normalized, "properties")
+	case ConfigChange_Kind_Feature, ConfigChange_Kind_Property:
+		if collections, ok := normalized["collections"].([]interface{}); ok {
+			ids := []string{}
+			for _, collection := range collections {
+				if collection, ok := collection.(map[string]interface{}); ok {
+					ids = append(ids, fmt.Sprint(collection["collection_id"]))
+				}
+			}
+			sort.Strings(ids)
+			normalized["collections"] = ids
+		}
+		if rules, ok := normalized["segment_rules"].([]interface{}); ok {
+			for _, rule := range rules {
+				if rule, ok := rule.(map[string]interface{}); ok {
+					delete(rule, "rule_id")
+				}
+			}
+		}
+	}
+	return normalized
+}
+
+func diffFields(current map[string]interface{}, desired map[string]interface{}) (fields []string) {
+	keys := map[string]bool{}
+	for key := range current {
+		keys[key] = true
+	}
+	for key := range desired {
+		keys[key] = true
+	}
+	for key := range keys {
+		if !reflect.DeepEqual(current[key], desired[key]) {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return
+}
+
+func sortedKeys(maps ...map[string]interface{}) []string {
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GetLiveInstanceConfig : Read the full configuration of the instance through the list operations
+// Build the same model as ListInstanceConfig, using the paginated list operations of every environment, collection,
+// segment, feature and property. Use this instead of ListInstanceConfig to see changes that were made since the
+// instance configuration was last exported.
+func (appConfiguration *AppConfigurationV1) GetLiveInstanceConfig() (result *ImportConfig, err error) {
+	result, err = appConfiguration.GetLiveInstanceConfigWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetLiveInstanceConfigWithContext is an alternate form of the GetLiveInstanceConfig method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) GetLiveInstanceConfigWithContext(ctx context.Context) (result *ImportConfig, err error) {
+	result = &ImportConfig{}
+
+	collectionsPager, err := appConfiguration.NewCollectionsPager(&ListCollectionsOptions{Expand: core.BoolPtr(true)})
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-collections-error", common.GetComponentInfo())
+	}
+	collections, err := collectionsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-collections-error", common.GetComponentInfo())
+	}
+	for _, collection := range collections {
+		result.Collections = append(result.Collections, ImportCollectionSchema{
+			CollectionID: collection.CollectionID,
+			Name:         collection.Name,
+			Description:  collection.Description,
+			Tags:         collection.Tags,
+		})
+	}
+
+	segmentsPager, err := appConfiguration.NewSegmentsPager(&ListSegmentsOptions{
+		Expand:  core.BoolPtr(true),
+		Include: []string{ListSegmentsOptions_Include_Rules},
+	})
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-segments-error", common.GetComponentInfo())
+	}
+	segments, err := segmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-segments-error", common.GetComponentInfo())
+	}
+	for _, segment := range segments {
+		result.Segments = append(result.Segments, ImportSegmentSchema{
+			SegmentID:   segment.SegmentID,
+			Name:        segment.Name,
+			Description: segment.Description,
+			Tags:        segment.Tags,
+			Rules:       segment.Rules,
+		})
+	}
+
+	environmentsPager, err := appConfiguration.NewEnvironmentsPager(&ListEnvironmentsOptions{Expand: core.BoolPtr(true)})
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-environments-error", common.GetComponentInfo())
+	}
+	environments, err := environmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-environments-error", common.GetComponentInfo())
+	}
+	for _, environment := range environments {
+		imported := ImportEnvironmentSchema{
+			EnvironmentID: environment.EnvironmentID,
+			Name:          environment.Name,
+			Description:   environment.Description,
+			Tags:          environment.Tags,
+			ColorCode:     environment.ColorCode,
+		}
+
+		featuresPager, err := appConfiguration.NewFeaturesPager(&ListFeaturesOptions{
+			EnvironmentID: environment.EnvironmentID,
+			Expand:        core.BoolPtr(true),
+			Include:       []string{ListFeaturesOptions_Include_Collections, ListFeaturesOptions_Include_Rules},
+		})
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "list-features-error", common.GetComponentInfo())
+		}
+		features, err := featuresPager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("could not list the features of environment '%s'", *environment.EnvironmentID), "list-features-error", common.GetComponentInfo())
+		}
+		for _, feature := range features {
+			imported.Features = append(imported.Features, ImportFeatureRequestBody{
+				FeatureID:            feature.FeatureID,
+				Name:                 feature.Name,
+				Description:          feature.Description,
+				Type:                 feature.Type,
+				Format:               feature.Format,
+				EnabledValue:         feature.EnabledValue,
+				DisabledValue:        feature.DisabledValue,
+				Enabled:              feature.Enabled,
+				RolloutPercentage:    feature.RolloutPercentage,
+				RolloutType:          feature.RolloutType,
+				RolloutConfiguration: feature.RolloutConfiguration,
+				Tags:                 feature.Tags,
+				SegmentRules:         feature.SegmentRules,
+				Collections:          feature.Collections,
+			})
+		}
+
+		propertiesPager, err := appConfiguration.NewPropertiesPager(&ListPropertiesOptions{
+			EnvironmentID: environment.EnvironmentID,
+			Expand:        core.BoolPtr(true),
+			Include:       []string{ListPropertiesOptions_Include_Collections, ListPropertiesOptions_Include_Rules},
+		})
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "list-properties-error", common.GetComponentInfo())
+		}
+		properties, err := propertiesPager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("could not list the properties of environment '%s'", *environment.EnvironmentID), "list-properties-error", common.GetComponentInfo())
+		}
+		for _, property := range properties {
+			imported.Properties = append(imported.Properties, ImportPropertyRequestBody{
+				PropertyID:   property.PropertyID,
+				Name:         property.Name,
+				Description:  property.Description,
+				Type:         property.Type,
+				Format:       property.Format,
+				Value:        property.Value,
+				Tags:         property.Tags,
+				SegmentRules: property.SegmentRules,
+				Collections:  property.Collections,
+			})
+		}
+
+		result.Environments = append(result.Environments, imported)
+	}
+	return
+}
@@ -0,0 +1,191 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 configuration diff`, func() {
+	newFeature := func(id string, enabled bool, collections ...string) appconfigurationv1.ImportFeatureRequestBody {
+		feature := appconfigurationv1.ImportFeatureRequestBody{
+			FeatureID:     core.StringPtr(id),
+			Name:          core.StringPtr(id),
+			Type:          core.StringPtr("BOOLEAN"),
+			EnabledValue:  true,
+			DisabledValue: false,
+			Enabled:       core.BoolPtr(enabled),
+		}
+		for _, collection := range collections {
+			feature.Collections = append(feature.Collections, appconfigurationv1.CollectionRef{CollectionID: core.StringPtr(collection)})
+		}
+		return feature
+	}
+
+	Describe(`DiffConfig(current *ImportConfig, desired *ImportConfig)`, func() {
+		It(`Returns no changes for equal configurations`, func() {
+			current := &appconfigurationv1.ImportConfig{
+				Environments: []appconfigurationv1.ImportEnvironmentSchema{{
+					EnvironmentID: core.StringPtr("dev"),
+					Name:          core.StringPtr("Dev"),
+					Features:      []appconfigurationv1.ImportFeatureRequestBody{newFeature("flag", true, "web", "mobile")},
+				}},
+			}
+			desired := &appconfigurationv1.ImportConfig{
+				Environments: []appconfigurationv1.ImportEnvironmentSchema{{
+					EnvironmentID: core.StringPtr("dev"),
+					Name:          core.StringPtr("Dev"),
+					Features:      []appconfigurationv1.ImportFeatureRequestBody{newFeature("flag", true, "mobile", "web")},
+				}},
+			}
+			desired.Environments[0].Features[0].Collections[0].Name = core.StringPtr("Mobile")
+			Expect(appconfigurationv1.DiffConfig(current, desired)).To(BeEmpty())
+		})
+
+		It(`Orders creates and updates before deletes`, func() {
+			current := &appconfigurationv1.ImportConfig{
+				Collections: []appconfigurationv1.ImportCollectionSchema{{CollectionID: core.StringPtr("old"), Name: core.StringPtr("old")}},
+				Environments: []appconfigurationv1.ImportEnvironmentSchema{{
+					EnvironmentID: core.StringPtr("dev"),
+					Name:          core.StringPtr("Dev"),
+					Features:      []appconfigurationv1.ImportFeatureRequestBody{newFeature("changed", false), newFeature("removed", true)},
+				}},
+			}
+			retyped := newFeature("retyped", true)
+			desired := &appconfigurationv1.ImportConfig{
+				Collections: []appconfigurationv1.ImportCollectionSchema{{CollectionID: core.StringPtr("new"), Name: core.StringPtr("new")}},
+				Environments: []appconfigurationv1.ImportEnvironmentSchema{{
+					EnvironmentID: core.StringPtr("dev"),
+					Name:          core.StringPtr("Dev"),
+					Features:      []appconfigurationv1.ImportFeatureRequestBody{newFeature("changed", true, "new"), newFeature("added", true)},
+				}},
+			}
+			current.Environments[0].Features = append(current.Environments[0].Features, retyped)
+			retyped.Type = core.StringPtr("STRING")
+			retyped.Format = core.StringPtr("TEXT")
+			desired.Environments[0].Features = append(desired.Environments[0].Features, retyped)
+
+			diff := appconfigurationv1.DiffConfig(current, desired)
+			Expect(diff.String()).To(Equal(`create collection new
+create feature dev/added
+update feature dev/changed (collections, enabled)
+replace feature dev/retyped (format, type)
+delete feature dev/removed
+delete collection old`))
+			Expect(diff.Count(appconfigurationv1.ConfigChange_Action_Delete)).To(Equal(2))
+			Expect(diff[2].Current).To(BeAssignableToTypeOf(&appconfigurationv1.ImportFeatureRequestBody{}))
+			Expect(diff[4].Desired).To(BeNil())
+		})
+
+		It(`Treats nil as an empty configuration`, func() {
+			desired := &appconfigurationv1.ImportConfig{
+				Segments: []appconfigurationv1.ImportSegmentSchema{{SegmentID: core.StringPtr("beta"), Name: core.StringPtr("beta")}},
+			}
+			Expect(appconfigurationv1.DiffConfig(nil, desired).String()).To(Equal(`create segment beta`))
+			Expect(appconfigurationv1.DiffConfig(desired, nil).String()).To(Equal(`delete segment beta`))
+		})
+	})
+
+	Describe(`GetLiveInstanceConfig()`, func() {
+		var testServer *httptest.Server
+
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Reads every page of every resource`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				offset := req.URL.Query().Get("offset")
+				switch req.URL.EscapedPath() {
+				case "/collections":
+					fmt.Fprint(res, `{"collections": [{"name": "web", "collection_id": "web"}], "limit": 10, "offset": 0, "total_count": 1}`)
+				case "/segments":
+					Expect(req.URL.Query().Get("include")).To(Equal("rules"))
+					fmt.Fprint(res, `{"segments": [{"name": "beta", "segment_id": "beta", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com"]}]}], "limit": 10, "offset": 0, "total_count": 1}`)
+				case "/environments":
+					fmt.Fprint(res, `{"environments": [{"name": "Dev", "environment_id": "dev"}], "limit": 10, "offset": 0, "total_count": 1}`)
+				case "/environments/dev/features":
+					Expect(req.URL.Query().Get("include")).To(Equal("collections,rules"))
+					if offset == "" || offset == "0" {
+						fmt.Fprint(res, `{"features": [{"name": "f1", "feature_id": "f1", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "collections": [{"collection_id": "web"}]}], "limit": 1, "offset": 0, "total_count": 2, "next": {"href": "/environments/dev/features?offset=1"}}`)
+					} else {
+						fmt.Fprint(res, `{"features": [{"name": "f2", "feature_id": "f2", "type": "NUMERIC", "enabled_value": 1, "disabled_value": 0}], "limit": 1, "offset": 1, "total_count": 2}`)
+					}
+				case "/environments/dev/properties":
+					fmt.Fprint(res, `{"properties": [{"name": "p1", "property_id": "p1", "type": "STRING", "format": "TEXT", "value": "v"}], "limit": 10, "offset": 0, "total_count": 1}`)
+				default:
+					Fail("unexpected request " + req.URL.String())
+				}
+			}))
+			appConfigurationService, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			config, err := appConfigurationService.GetLiveInstanceConfig()
+			Expect(err).To(BeNil())
+			Expect(config.Collections).To(HaveLen(1))
+			Expect(config.Segments).To(HaveLen(1))
+			Expect(config.Segments[0].Rules).To(HaveLen(1))
+			Expect(config.Environments).To(HaveLen(1))
+			Expect(config.Environments[0].Features).To(HaveLen(2))
+			Expect(*config.Environments[0].Features[0].Collections[0].CollectionID).To(Equal("web"))
+			Expect(*config.Environments[0].Features[1].FeatureID).To(Equal("f2"))
+			Expect(config.Environments[0].Properties).To(HaveLen(1))
+		})
+
+		It(`Reports the environment whose features cannot be listed`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.EscapedPath() {
+				case "/collections":
+					fmt.Fprint(res, `{"collections": [], "total_count": 0}`)
+				case "/segments":
+					fmt.Fprint(res, `{"segments": [], "total_count": 0}`)
+				case "/environments":
+					fmt.Fprint(res, `{"environments": [{"name": "Dev", "environment_id": "dev"}], "total_count": 1}`)
+				default:
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "unavailable"}]}`)
+				}
+			}))
+			appConfigurationService, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			config, err := appConfigurationService.GetLiveInstanceConfig()
+			Expect(config).To(BeNil())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("could not list the features of environment 'dev'"))
+			_, ok := err.(*core.SDKProblem)
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
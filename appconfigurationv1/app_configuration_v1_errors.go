@@ -0,0 +1,418 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The categories of service failures. The errors of the operations of the client that received an error response
+// are *core.SDKProblem values with a *ServiceError in their chain, so they match one of these with errors.Is:
+//
+//	_, err := appConfigurationService.DeleteSegment(deleteSegmentOptions)
+//	if errors.Is(err, appconfigurationv1.ErrReferencedResource) {
+//		...
+//	}
+var (
+	// ErrNotFound : The resource, or its environment, does not exist.
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrConflict : A resource with the same ID already exists, or the resource was changed concurrently.
+	ErrConflict = errors.New("resource conflict")
+
+	// ErrReferencedResource : The resource cannot be deleted or changed because other resources use it, for example a
+	// segment targeted by features or a collection holding properties.
+	ErrReferencedResource = errors.New("resource is referenced by other resources")
+
+	// ErrValidation : The request was rejected as invalid. ServiceError.Fields holds the invalid fields, if the
+	// service reported them.
+	ErrValidation = errors.New("invalid request")
+
+	// ErrRateLimited : Too many requests were sent. ServiceError.RetryAfter holds how long to wait, if the service
+	// reported it.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrApprovalRequired : The change requires a workflow approval and was not applied.
+	ErrApprovalRequired = errors.New("workflow approval required")
+
+	// ErrUnauthorized : The credentials are missing, invalid or not allowed to perform the operation.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrQuotaExceeded : A limit of the plan of the instance was reached, for example the number of features.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// ServiceError : A failed operation, with its category and the details of the error response.
+type ServiceError struct {
+	// The category of the failure, one of the Err* variables, or nil if it matches none.
+	Kind error
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The operation that failed, for example `create_feature`.
+	OperationID string
+
+	// The type of the resource of the operation, one of the ServiceError_ResourceType_* constants, or empty if it is
+	// not known.
+	ResourceType string
+
+	// The ID of the resource, when known: the ID of the path or of the options of the operation, or the one passed to
+	// ClassifyResourceError.
+	ResourceID string
+
+	// The error code and message returned by the service.
+	Code    string
+	Message string
+
+	// The invalid fields of validation failures.
+	Fields []FieldError
+
+	// How long to wait before retrying, for rate limited requests. Zero if the service did not say.
+	RetryAfter time.Duration
+
+	// The classified error: the *core.HTTPProblem of the error response for the errors of the operations of the
+	// client, or the error passed to ClassifyError.
+	Err error
+}
+
+// FieldError : An invalid field of a request, as reported by the service.
+type FieldError struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+// Constants associated with the ServiceError.ResourceType property.
+const (
+	ServiceError_ResourceType_Collection     = "collection"
+	ServiceError_ResourceType_Environment    = "environment"
+	ServiceError_ResourceType_Feature        = "feature"
+	ServiceError_ResourceType_FeatureRule    = "feature_rule"
+	ServiceError_ResourceType_Gitconfig      = "gitconfig"
+	ServiceError_ResourceType_Instance       = "instance"
+	ServiceError_ResourceType_Integration    = "integration"
+	ServiceError_ResourceType_Originconfig   = "originconfig"
+	ServiceError_ResourceType_Property       = "property"
+	ServiceError_ResourceType_Segment        = "segment"
+	ServiceError_ResourceType_WorkflowConfig = "workflow_config"
+)
+
+// Error returns the category, the resource and the message of the service, for example
+// `resource not found: feature 'checkout': Feature flag not found`.
+func (serviceError *ServiceError) Error() string {
+	parts := []string{}
+	if serviceError.Kind != nil {
+		parts = append(parts, serviceError.Kind.Error())
+	} else {
+		parts = append(parts, fmt.Sprintf("request failed with status code %d", serviceError.StatusCode))
+	}
+	if serviceError.ResourceType != "" {
+		resource := serviceError.ResourceType
+		if serviceError.ResourceID != "" {
+			resource += fmt.Sprintf(" '%s'", serviceError.ResourceID)
+		}
+		parts = append(parts, resource)
+	}
+	if serviceError.Message != "" {
+		parts = append(parts, serviceError.Message)
+	}
+	return strings.Join(parts, ": ")
+}
+
+// Unwrap returns the category and the classified error, so that errors.Is matches the category and errors.As finds
+// the core.SDKProblem and core.HTTPProblem of the failure.
+func (serviceError *ServiceError) Unwrap() []error {
+	unwrapped := []error{}
+	if serviceError.Kind != nil {
+		unwrapped = append(unwrapped, serviceError.Kind)
+	}
+	if serviceError.Err != nil {
+		unwrapped = append(unwrapped, serviceError.Err)
+	}
+	return unwrapped
+}
+
+// GetConsoleMessage, GetDebugMessage and GetID implement core.Problem with the messages and ID of the
+// *core.HTTPProblem of the failure, so that the *core.SDKProblem wrapping a ServiceError keeps it in its chain.
+func (serviceError *ServiceError) GetConsoleMessage() string {
+	if problem, ok := serviceError.Err.(core.Problem); ok {
+		return problem.GetConsoleMessage()
+	}
+	return serviceError.Error()
+}
+
+// GetDebugMessage : See GetConsoleMessage.
+func (serviceError *ServiceError) GetDebugMessage() string {
+	if problem, ok := serviceError.Err.(core.Problem); ok {
+		return problem.GetDebugMessage()
+	}
+	return serviceError.Error()
+}
+
+// GetID : See GetConsoleMessage.
+func (serviceError *ServiceError) GetID() string {
+	if problem, ok := serviceError.Err.(core.Problem); ok {
+		return problem.GetID()
+	}
+	return core.CreateIDHash("service_error", serviceError.OperationID, strconv.Itoa(serviceError.StatusCode))
+}
+
+// ClassifyError returns a *ServiceError describing err if it is the failure of a service operation, err otherwise.
+// The category is derived from the status code and the error response, and the resource type from the operation.
+// The errors of the operations of the client are already classified, and returned unchanged; ClassifyError is for
+// the errors of requests made otherwise, for example with a core.BaseService of your own.
+func ClassifyError(err error) error {
+	return ClassifyResourceError(err, "")
+}
+
+// ClassifyResourceError is ClassifyError for an operation on the resource resourceID, which is reported in the
+// ServiceError.
+func ClassifyResourceError(err error, resourceID string) error {
+	if err == nil {
+		return nil
+	}
+	var classified *ServiceError
+	if errors.As(err, &classified) {
+		if resourceID != "" && classified.ResourceID == "" {
+			copied := *classified
+			copied.ResourceID = resourceID
+			return &copied
+		}
+		return err
+	}
+	// The error of a core.BaseService request only exposes its *core.HTTPProblem once wrapped by the SDK.
+	var problem *core.HTTPProblem
+	if !errors.As(err, &problem) && !errors.As(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()), &problem) {
+		return err
+	}
+	if problem.Response == nil {
+		return err
+	}
+
+	return newServiceError(problem, resourceID, err)
+}
+
+// newOperationError returns the error of the request of an operation of the client. An error response is classified
+// with the ID of the resource of the operation, read from its path parameters or its options; the error remains a
+// *core.SDKProblem with the same message, and the *ServiceError in its chain.
+func newOperationError(err error, pathParams map[string]string, options interface{}) error {
+	sdkProblem := core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	var problem *core.HTTPProblem
+	if !errors.As(sdkProblem, &problem) || problem.Response == nil {
+		return sdkProblem
+	}
+	serviceError := newServiceError(problem, operationResourceID(operationResourceType(problem.OperationID), pathParams, options), problem)
+	return core.SDKErrorf(serviceError, sdkProblem.Summary, "http-request-err", common.GetComponentInfo())
+}
+
+func newServiceError(problem *core.HTTPProblem, resourceID string, err error) *ServiceError {
+	serviceError := &ServiceError{
+		StatusCode:   problem.Response.GetStatusCode(),
+		OperationID:  problem.OperationID,
+		ResourceType: operationResourceType(problem.OperationID),
+		ResourceID:   resourceID,
+		Err:          err,
+	}
+	parseErrorBody(problem.Response, serviceError)
+	if serviceError.Message == "" {
+		serviceError.Message = problem.Summary
+	}
+	serviceError.Kind = classify(serviceError)
+	if serviceError.Kind == ErrRateLimited {
+		serviceError.RetryAfter = parseRetryAfter(problem.Response.GetHeaders().Get("Retry-After"), time.Now())
+	}
+	return serviceError
+}
+
+// operationResourceIDParams are the path parameters, and option fields, holding the IDs of the types of resources.
+var operationResourceIDParams = map[string]string{
+	ServiceError_ResourceType_Collection:     "collection_id",
+	ServiceError_ResourceType_Environment:    "environment_id",
+	ServiceError_ResourceType_Feature:        "feature_id",
+	ServiceError_ResourceType_FeatureRule:    "rule_id",
+	ServiceError_ResourceType_Gitconfig:      "git_config_id",
+	ServiceError_ResourceType_Integration:    "integration_id",
+	ServiceError_ResourceType_Property:       "property_id",
+	ServiceError_ResourceType_Segment:        "segment_id",
+	ServiceError_ResourceType_WorkflowConfig: "workflow_config_id",
+}
+
+// operationResourceID returns the ID of the resource of an operation from its path parameters, or from its options
+// for the operations that create the resource, or an empty string.
+func operationResourceID(resourceType string, pathParams map[string]string, options interface{}) string {
+	param, ok := operationResourceIDParams[resourceType]
+	if !ok {
+		return ""
+	}
+	if id := pathParams[param]; id != "" {
+		return id
+	}
+	content, err := json.Marshal(options)
+	if err != nil {
+		return ""
+	}
+	fields := map[string]interface{}{}
+	if json.Unmarshal(content, &fields) != nil {
+		return ""
+	}
+	id, _ := fields[param].(string)
+	return id
+}
+
+// classify derives the category of a failure from its status code. The error code and message only refine the
+// generic 400 and 409 statuses, which the service returns for several kinds of failures.
+func classify(serviceError *ServiceError) error {
+	text := strings.ToLower(serviceError.Code + " " + serviceError.Message)
+	mentions := func(keywords ...string) bool {
+		for _, keyword := range keywords {
+			if strings.Contains(text, keyword) {
+				return true
+			}
+		}
+		return false
+	}
+	refine := func(fallback error) error {
+		switch {
+		case mentions("in use", "referenced", "is used", "being used", "associated with", "mapped to", "attached to"):
+			return ErrReferencedResource
+		case mentions("approval"):
+			return ErrApprovalRequired
+		case mentions("quota", "limit exceeded", "limit reached", "maximum number", "plan limit"):
+			return ErrQuotaExceeded
+		case mentions("already exists"):
+			return ErrConflict
+		}
+		return fallback
+	}
+
+	switch serviceError.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return refine(ErrConflict)
+	case http.StatusPreconditionFailed:
+		return ErrConflict
+	case http.StatusPreconditionRequired:
+		return ErrApprovalRequired
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadRequest:
+		return refine(ErrValidation)
+	case http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+	return nil
+}
+
+// parseErrorBody reads the code, message and invalid fields of an error response, either in the IBM Cloud format
+// (`{"errors": [{"code", "message", "target": {"type", "name"}}]}`) or as top-level `code` and `message` fields.
+func parseErrorBody(response *core.DetailedResponse, serviceError *ServiceError) {
+	body, ok := response.GetResultAsMap()
+	if !ok {
+		if len(response.RawResult) == 0 || json.Unmarshal(response.RawResult, &body) != nil {
+			return
+		}
+	}
+	text := func(fields map[string]interface{}, keys ...string) string {
+		for _, key := range keys {
+			if value, ok := fields[key].(string); ok && value != "" {
+				return value
+			}
+		}
+		return ""
+	}
+
+	serviceError.Code = text(body, "code", "error_code")
+	serviceError.Message = text(body, "message", "error", "errorMessage")
+	entries, _ := body["errors"].([]interface{})
+	messages := []string{}
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		code, message := text(fields, "code"), text(fields, "message")
+		if serviceError.Code == "" {
+			serviceError.Code = code
+		}
+		if message != "" {
+			messages = append(messages, message)
+		}
+		if target, ok := fields["target"].(map[string]interface{}); ok && text(target, "name") != "" {
+			serviceError.Fields = append(serviceError.Fields, FieldError{Field: text(target, "name"), Code: code, Message: message})
+		}
+	}
+	if serviceError.Message == "" {
+		serviceError.Message = strings.Join(messages, "; ")
+	}
+}
+
+// parseRetryAfter reads a Retry-After header, either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// operationResourceType returns the type of the resource an operation acts on, for example `feature` for
+// `toggle_feature`.
+func operationResourceType(operationID string) string {
+	switch {
+	case strings.Contains(operationID, "feature_rule"):
+		return ServiceError_ResourceType_FeatureRule
+	case strings.Contains(operationID, "instance_config"), operationID == "import_config", operationID == "promote_restore_config":
+		return ServiceError_ResourceType_Instance
+	case strings.Contains(operationID, "workflow"):
+		return ServiceError_ResourceType_WorkflowConfig
+	}
+	for _, resourceType := range []string{
+		ServiceError_ResourceType_Feature,
+		ServiceError_ResourceType_Gitconfig,
+		ServiceError_ResourceType_Integration,
+		ServiceError_ResourceType_Originconfig,
+		ServiceError_ResourceType_Segment,
+		ServiceError_ResourceType_Collection,
+		ServiceError_ResourceType_Environment,
+	} {
+		if strings.Contains(operationID, resourceType) {
+			return resourceType
+		}
+	}
+	if strings.Contains(operationID, "propert") {
+		return ServiceError_ResourceType_Property
+	}
+	return ""
+}
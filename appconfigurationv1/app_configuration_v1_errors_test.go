@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 error classification`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+	var status int
+	var body string
+	var headers map[string]string
+
+	BeforeEach(func() {
+		headers = map[string]string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			for name, value := range headers {
+				res.Header().Set(name, value)
+			}
+			res.WriteHeader(status)
+			fmt.Fprint(res, body)
+		}))
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	getFeature := func() error {
+		_, _, err := appConfigurationService.GetFeature(&appconfigurationv1.GetFeatureOptions{
+			EnvironmentID: core.StringPtr("dev"),
+			FeatureID:     core.StringPtr("checkout"),
+		})
+		return err
+	}
+
+	deleteSegment := func() error {
+		_, _, err := appConfigurationService.DeleteSegment(&appconfigurationv1.DeleteSegmentOptions{SegmentID: core.StringPtr("beta")})
+		return err
+	}
+
+	It(`Classifies not found errors with the resource`, func() {
+		status, body = 404, `{"errors": [{"code": "not_found", "message": "Feature flag not found"}]}`
+		err := getFeature()
+		Expect(errors.Is(err, appconfigurationv1.ErrNotFound)).To(BeTrue())
+		Expect(errors.Is(err, appconfigurationv1.ErrConflict)).To(BeFalse())
+
+		var serviceError *appconfigurationv1.ServiceError
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.StatusCode).To(Equal(404))
+		Expect(serviceError.OperationID).To(Equal("get_feature"))
+		Expect(serviceError.ResourceType).To(Equal(appconfigurationv1.ServiceError_ResourceType_Feature))
+		Expect(serviceError.ResourceID).To(Equal("checkout"))
+		Expect(serviceError.Code).To(Equal("not_found"))
+		Expect(serviceError.Error()).To(Equal("resource not found: feature 'checkout': Feature flag not found"))
+
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+		var problem *core.HTTPProblem
+		Expect(errors.As(err, &problem)).To(BeTrue())
+		Expect(problem.OperationID).To(Equal("get_feature"))
+		Expect(appconfigurationv1.ClassifyError(err)).To(BeIdenticalTo(err))
+		Expect(appconfigurationv1.ClassifyResourceError(err, "other")).To(BeIdenticalTo(err))
+	})
+
+	It(`Classifies the errors of requests made without the operations of the client`, func() {
+		status, body = 404, `{"errors": [{"code": "not_found", "message": "Feature flag not found"}]}`
+		builder := core.NewRequestBuilder(core.GET)
+		_, builderErr := builder.ResolveRequestURL(testServer.URL, `/environments/dev/features/checkout`, nil)
+		Expect(builderErr).To(BeNil())
+		request, builderErr := builder.Build()
+		Expect(builderErr).To(BeNil())
+		_, requestErr := appConfigurationService.Service.Request(request, nil)
+		Expect(errors.Is(requestErr, appconfigurationv1.ErrNotFound)).To(BeFalse())
+
+		err := appconfigurationv1.ClassifyResourceError(requestErr, "checkout")
+		Expect(errors.Is(err, appconfigurationv1.ErrNotFound)).To(BeTrue())
+		var serviceError *appconfigurationv1.ServiceError
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.ResourceID).To(Equal("checkout"))
+	})
+
+	It(`Reads the resource ID from the options of the operations that create it`, func() {
+		status, body = 409, `{"message": "Segment with id beta already exists"}`
+		_, _, err := appConfigurationService.CreateSegment(&appconfigurationv1.CreateSegmentOptions{
+			Name:      core.StringPtr("Beta"),
+			SegmentID: core.StringPtr("beta"),
+			Rules:     []appconfigurationv1.Rule{},
+		})
+		Expect(errors.Is(err, appconfigurationv1.ErrConflict)).To(BeTrue())
+		var serviceError *appconfigurationv1.ServiceError
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.ResourceType).To(Equal(appconfigurationv1.ServiceError_ResourceType_Segment))
+		Expect(serviceError.ResourceID).To(Equal("beta"))
+	})
+
+	It(`Tells conflicts from referenced resources`, func() {
+		status, body = 409, `{"message": "Feature flag with id checkout already exists"}`
+		Expect(errors.Is(getFeature(), appconfigurationv1.ErrConflict)).To(BeTrue())
+
+		status, body = 409, `{"errors": [{"code": "conflict", "message": "Segment is in use by 2 feature flags"}]}`
+		err := deleteSegment()
+		Expect(errors.Is(err, appconfigurationv1.ErrReferencedResource)).To(BeTrue())
+		Expect(errors.Is(err, appconfigurationv1.ErrConflict)).To(BeFalse())
+		var serviceError *appconfigurationv1.ServiceError
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.OperationID).To(Equal("delete_segment"))
+		Expect(serviceError.ResourceID).To(Equal("beta"))
+		Expect(serviceError.Error()).To(Equal("resource is referenced by other resources: segment 'beta': Segment is in use by 2 feature flags"))
+	})
+
+	It(`Reports the fields of validation errors`, func() {
+		status, body = 400, `{"errors": [
+			{"code": "invalid_field", "message": "name is required", "target": {"type": "field", "name": "name"}},
+			{"code": "invalid_field", "message": "rollout_percentage must be at most 100", "target": {"type": "field", "name": "rollout_percentage"}}]}`
+		var serviceError *appconfigurationv1.ServiceError
+		err := getFeature()
+		Expect(errors.Is(err, appconfigurationv1.ErrValidation)).To(BeTrue())
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.Message).To(Equal("name is required; rollout_percentage must be at most 100"))
+		Expect(serviceError.Fields).To(Equal([]appconfigurationv1.FieldError{
+			{Field: "name", Code: "invalid_field", Message: "name is required"},
+			{Field: "rollout_percentage", Code: "invalid_field", Message: "rollout_percentage must be at most 100"},
+		}))
+	})
+
+	It(`Reports how long to wait when rate limited`, func() {
+		status, body = 429, `{"message": "Too many requests"}`
+		headers["Retry-After"] = "7"
+		var serviceError *appconfigurationv1.ServiceError
+		err := getFeature()
+		Expect(errors.Is(err, appconfigurationv1.ErrRateLimited)).To(BeTrue())
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.RetryAfter).To(Equal(7 * time.Second))
+
+		headers["Retry-After"] = time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+		Expect(errors.As(getFeature(), &serviceError)).To(BeTrue())
+		Expect(serviceError.RetryAfter).To(BeNumerically("~", time.Minute, 2*time.Second))
+	})
+
+	It(`Classifies authorization, approval and quota errors`, func() {
+		status, body = 401, `{"message": "Unauthorized"}`
+		Expect(errors.Is(getFeature(), appconfigurationv1.ErrUnauthorized)).To(BeTrue())
+
+		status, body = 428, `{"message": "Precondition required"}`
+		Expect(errors.Is(deleteSegment(), appconfigurationv1.ErrApprovalRequired)).To(BeTrue())
+
+		status, body = 409, `{"errors": [{"code": "conflict", "message": "This change requires a workflow approval"}]}`
+		Expect(errors.Is(deleteSegment(), appconfigurationv1.ErrApprovalRequired)).To(BeTrue())
+
+		status, body = 400, `{"errors": [{"code": "limit_exceeded", "message": "The maximum number of feature flags for the plan was reached"}]}`
+		Expect(errors.Is(getFeature(), appconfigurationv1.ErrQuotaExceeded)).To(BeTrue())
+
+		status, body = 500, `{"message": "Internal error"}`
+		err := getFeature()
+		var serviceError *appconfigurationv1.ServiceError
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.Kind).To(BeNil())
+		Expect(serviceError.Error()).To(Equal("request failed with status code 500: feature 'checkout': Internal error"))
+	})
+
+	It(`Classifies by status code before the message`, func() {
+		status, body = 404, `{"errors": [{"code": "not_found", "message": "Workflow approval config not found"}]}`
+		Expect(errors.Is(getFeature(), appconfigurationv1.ErrNotFound)).To(BeTrue())
+
+		status, body = 404, `{"message": "Environment not found, the maximum number of environments may have been reached"}`
+		Expect(errors.Is(getFeature(), appconfigurationv1.ErrNotFound)).To(BeTrue())
+
+		status, body = 403, `{"errors": [{"code": "forbidden", "message": "Only approvers can approve this change"}]}`
+		Expect(errors.Is(deleteSegment(), appconfigurationv1.ErrUnauthorized)).To(BeTrue())
+
+		status, body = 400, `{"message": "rollout_percentage must be at most 100"}`
+		Expect(errors.Is(getFeature(), appconfigurationv1.ErrValidation)).To(BeTrue())
+	})
+
+	It(`Leaves other errors unchanged`, func() {
+		Expect(appconfigurationv1.ClassifyError(nil)).To(BeNil())
+		_, _, err := appConfigurationService.GetFeature(nil)
+		Expect(appconfigurationv1.ClassifyError(err)).To(BeIdenticalTo(err))
+	})
+})
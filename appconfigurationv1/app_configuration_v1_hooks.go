@@ -0,0 +1,328 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Operation : A mutating service operation about to be sent, as seen by a RequestHook.
+type Operation struct {
+	// The ID of the operation, one of the Operation_ID_* constants, or empty if the request is not recognized.
+	ID string
+
+	// The type of the resource of the operation, one of the ServiceError_ResourceType_* constants.
+	ResourceType string
+
+	// The path parameters of the operation, for example `environment_id` and `feature_id`.
+	PathParams map[string]string
+
+	// The options of the operation, rebuilt from the request, for example *UpdateFeatureOptions for update_feature.
+	// Nil for the operations whose body is a polymorphic model, and for requests that are not recognized.
+	Options interface{}
+
+	// The HTTP request. Hooks must not read or modify its body.
+	Request *http.Request
+
+	// A copy of the client without the request hooks, for hooks that call the service.
+	Client *AppConfigurationV1
+}
+
+// Constants associated with the Operation.ID property.
+const (
+	Operation_ID_CreateEnvironment      = "create_environment"
+	Operation_ID_UpdateEnvironment      = "update_environment"
+	Operation_ID_DeleteEnvironment      = "delete_environment"
+	Operation_ID_CreateCollection       = "create_collection"
+	Operation_ID_UpdateCollection       = "update_collection"
+	Operation_ID_DeleteCollection       = "delete_collection"
+	Operation_ID_CreateFeature          = "create_feature"
+	Operation_ID_UpdateFeature          = "update_feature"
+	Operation_ID_UpdateFeatureValues    = "update_feature_values"
+	Operation_ID_DeleteFeature          = "delete_feature"
+	Operation_ID_ToggleFeature          = "toggle_feature"
+	Operation_ID_StopFeatureRollout     = "stop_feature_rollout"
+	Operation_ID_CreateFeatureRule      = "create_feature_rule"
+	Operation_ID_UpdateFeatureRule      = "update_feature_rule"
+	Operation_ID_DeleteFeatureRule      = "delete_feature_rule"
+	Operation_ID_StopFeatureRuleRollout = "stop_feature_rule_rollout"
+	Operation_ID_UpdateFeatureRuleOrder = "update_feature_rule_order"
+	Operation_ID_CreateProperty         = "create_property"
+	Operation_ID_UpdateProperty         = "update_property"
+	Operation_ID_UpdatePropertyValues   = "update_property_values"
+	Operation_ID_DeleteProperty         = "delete_property"
+	Operation_ID_CreateSegment          = "create_segment"
+	Operation_ID_UpdateSegment          = "update_segment"
+	Operation_ID_DeleteSegment          = "delete_segment"
+	Operation_ID_CreateGitconfig        = "create_gitconfig"
+	Operation_ID_UpdateGitconfig        = "update_gitconfig"
+	Operation_ID_DeleteGitconfig        = "delete_gitconfig"
+	Operation_ID_PromoteGitconfig       = "promote_gitconfig"
+	Operation_ID_RestoreGitconfig       = "restore_gitconfig"
+	Operation_ID_CreateIntegration      = "create_integration"
+	Operation_ID_DeleteIntegration      = "delete_integration"
+	Operation_ID_UpdateOriginconfigs    = "update_originconfigs"
+	Operation_ID_CreateWorkflowconfig   = "create_workflowconfig"
+	Operation_ID_UpdateWorkflowconfig   = "update_workflowconfig"
+	Operation_ID_DeleteWorkflowconfig   = "delete_workflowconfig"
+	Operation_ID_CreateWorkflowConfigs  = "create_workflow_configs"
+	Operation_ID_UpdateWorkflowConfigs  = "update_workflow_configs"
+	Operation_ID_DeleteWorkflowConfigs  = "delete_workflow_configs"
+	Operation_ID_ToggleWorkflowConfig   = "toggle_workflow_config"
+	Operation_ID_TestWorkflowConfig     = "test_workflow_config"
+	Operation_ID_ImportConfig           = "import_config"
+	Operation_ID_PromoteRestoreConfig   = "promote_restore_config"
+)
+
+// RequestHook : Called with each mutating operation before its request is sent. If it returns an error, the request
+// is not sent and the operation fails with the error, which errors.As finds. Otherwise, the returned function, if not
+// nil, is called with the outcome of the request once it is sent, or with the error of a later hook.
+type RequestHook func(ctx context.Context, operation *Operation) (after func(response *http.Response, err error), err error)
+
+// AddRequestHook : Call a hook before each mutating request of the client
+// Hooks run in the order they were added, and the functions they return run in reverse order. Requests made by GET
+// are not passed to hooks. Copies of the client made before the hook is added are not affected. The hook wraps the
+// HTTP client of the service, so retries should be enabled before hooks are added.
+func (appConfiguration *AppConfigurationV1) AddRequestHook(hook RequestHook) error {
+	err := core.ValidateNotNil(hook, "hook cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+
+	client := appConfiguration.Service.Client
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	transport := &hookTransport{next: client.Transport}
+	if hooked, ok := client.Transport.(*hookTransport); ok {
+		transport.next = hooked.next
+		transport.base = hooked.base
+		transport.hooks = append(transport.hooks, hooked.hooks...)
+	} else {
+		transport.base = appConfiguration.Clone()
+		transport.base.Service.Client = client
+	}
+	transport.hooks = append(transport.hooks, hook)
+
+	hookedClient := *client
+	hookedClient.Transport = transport
+	appConfiguration.Service.Client = &hookedClient
+	return nil
+}
+
+// hookTransport runs the request hooks of a client around the requests of its mutating operations.
+type hookTransport struct {
+	next  http.RoundTripper
+	base  *AppConfigurationV1
+	hooks []RequestHook
+}
+
+func (transport *hookTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return next.RoundTrip(req)
+	}
+
+	operation, req, err := newOperation(req, transport.base)
+	if err != nil {
+		return nil, err
+	}
+	afters := []func(*http.Response, error){}
+	finish := func(response *http.Response, err error) {
+		for i := len(afters) - 1; i >= 0; i-- {
+			afters[i](response, err)
+		}
+	}
+	for _, hook := range transport.hooks {
+		after, err := hook(req.Context(), operation)
+		if err != nil {
+			finish(nil, err)
+			return nil, err
+		}
+		if after != nil {
+			afters = append(afters, after)
+		}
+	}
+	response, err := next.RoundTrip(req)
+	finish(response, err)
+	return response, err
+}
+
+// operationRoute : The method and path of an operation, relative to the service URL.
+type operationRoute struct {
+	id           string
+	method       string
+	path         []string
+	resourceType string
+	newOptions   func() interface{}
+}
+
+var operationRoutes = []operationRoute{
+	newOperationRoute(Operation_ID_CreateEnvironment, http.MethodPost, `/environments`, ServiceError_ResourceType_Environment, func() interface{} { return &CreateEnvironmentOptions{} }),
+	newOperationRoute(Operation_ID_UpdateEnvironment, http.MethodPut, `/environments/{environment_id}`, ServiceError_ResourceType_Environment, func() interface{} { return &UpdateEnvironmentOptions{} }),
+	newOperationRoute(Operation_ID_DeleteEnvironment, http.MethodDelete, `/environments/{environment_id}`, ServiceError_ResourceType_Environment, func() interface{} { return &DeleteEnvironmentOptions{} }),
+	newOperationRoute(Operation_ID_CreateCollection, http.MethodPost, `/collections`, ServiceError_ResourceType_Collection, func() interface{} { return &CreateCollectionOptions{} }),
+	newOperationRoute(Operation_ID_UpdateCollection, http.MethodPut, `/collections/{collection_id}`, ServiceError_ResourceType_Collection, func() interface{} { return &UpdateCollectionOptions{} }),
+	newOperationRoute(Operation_ID_DeleteCollection, http.MethodDelete, `/collections/{collection_id}`, ServiceError_ResourceType_Collection, func() interface{} { return &DeleteCollectionOptions{} }),
+	newOperationRoute(Operation_ID_CreateFeature, http.MethodPost, `/environments/{environment_id}/features`, ServiceError_ResourceType_Feature, func() interface{} { return &CreateFeatureOptions{} }),
+	newOperationRoute(Operation_ID_UpdateFeature, http.MethodPut, `/environments/{environment_id}/features/{feature_id}`, ServiceError_ResourceType_Feature, func() interface{} { return &UpdateFeatureOptions{} }),
+	newOperationRoute(Operation_ID_UpdateFeatureValues, http.MethodPatch, `/environments/{environment_id}/features/{feature_id}`, ServiceError_ResourceType_Feature, func() interface{} { return &UpdateFeatureValuesOptions{} }),
+	newOperationRoute(Operation_ID_DeleteFeature, http.MethodDelete, `/environments/{environment_id}/features/{feature_id}`, ServiceError_ResourceType_Feature, func() interface{} { return &DeleteFeatureOptions{} }),
+	newOperationRoute(Operation_ID_ToggleFeature, http.MethodPut, `/environments/{environment_id}/features/{feature_id}/toggle`, ServiceError_ResourceType_Feature, func() interface{} { return &ToggleFeatureOptions{} }),
+	newOperationRoute(Operation_ID_StopFeatureRollout, http.MethodPatch, `/environments/{environment_id}/features/{feature_id}/rollout`, ServiceError_ResourceType_Feature, func() interface{} { return &StopFeatureRolloutOptions{} }),
+	newOperationRoute(Operation_ID_CreateFeatureRule, http.MethodPost, `/environments/{environment_id}/features/{feature_id}/rules`, ServiceError_ResourceType_FeatureRule, func() interface{} { return &CreateFeatureRuleOptions{} }),
+	newOperationRoute(Operation_ID_UpdateFeatureRule, http.MethodPatch, `/environments/{environment_id}/features/{feature_id}/rules/{rule_id}`, ServiceError_ResourceType_FeatureRule, func() interface{} { return &UpdateFeatureRuleOptions{} }),
+	newOperationRoute(Operation_ID_DeleteFeatureRule, http.MethodDelete, `/environments/{environment_id}/features/{feature_id}/rules/{rule_id}`, ServiceError_ResourceType_FeatureRule, func() interface{} { return &DeleteFeatureRuleOptions{} }),
+	newOperationRoute(Operation_ID_StopFeatureRuleRollout, http.MethodPatch, `/environments/{environment_id}/features/{feature_id}/rules/{rule_id}/rollout`, ServiceError_ResourceType_FeatureRule, func() interface{} { return &StopFeatureRuleRolloutOptions{} }),
+	newOperationRoute(Operation_ID_UpdateFeatureRuleOrder, http.MethodPatch, `/environments/{environment_id}/features/{feature_id}/rules_order`, ServiceError_ResourceType_Feature, nil),
+	newOperationRoute(Operation_ID_CreateProperty, http.MethodPost, `/environments/{environment_id}/properties`, ServiceError_ResourceType_Property, func() interface{} { return &CreatePropertyOptions{} }),
+	newOperationRoute(Operation_ID_UpdateProperty, http.MethodPut, `/environments/{environment_id}/properties/{property_id}`, ServiceError_ResourceType_Property, func() interface{} { return &UpdatePropertyOptions{} }),
+	newOperationRoute(Operation_ID_UpdatePropertyValues, http.MethodPatch, `/environments/{environment_id}/properties/{property_id}`, ServiceError_ResourceType_Property, func() interface{} { return &UpdatePropertyValuesOptions{} }),
+	newOperationRoute(Operation_ID_DeleteProperty, http.MethodDelete, `/environments/{environment_id}/properties/{property_id}`, ServiceError_ResourceType_Property, func() interface{} { return &DeletePropertyOptions{} }),
+	newOperationRoute(Operation_ID_CreateSegment, http.MethodPost, `/segments`, ServiceError_ResourceType_Segment, func() interface{} { return &CreateSegmentOptions{} }),
+	newOperationRoute(Operation_ID_UpdateSegment, http.MethodPut, `/segments/{segment_id}`, ServiceError_ResourceType_Segment, func() interface{} { return &UpdateSegmentOptions{} }),
+	newOperationRoute(Operation_ID_DeleteSegment, http.MethodDelete, `/segments/{segment_id}`, ServiceError_ResourceType_Segment, func() interface{} { return &DeleteSegmentOptions{} }),
+	newOperationRoute(Operation_ID_CreateGitconfig, http.MethodPost, `/gitconfigs`, ServiceError_ResourceType_Gitconfig, func() interface{} { return &CreateGitconfigOptions{} }),
+	newOperationRoute(Operation_ID_UpdateGitconfig, http.MethodPut, `/gitconfigs/{git_config_id}`, ServiceError_ResourceType_Gitconfig, func() interface{} { return &UpdateGitconfigOptions{} }),
+	newOperationRoute(Operation_ID_DeleteGitconfig, http.MethodDelete, `/gitconfigs/{git_config_id}`, ServiceError_ResourceType_Gitconfig, func() interface{} { return &DeleteGitconfigOptions{} }),
+	newOperationRoute(Operation_ID_PromoteGitconfig, http.MethodPut, `/gitconfigs/{git_config_id}/promote`, ServiceError_ResourceType_Gitconfig, func() interface{} { return &PromoteGitconfigOptions{} }),
+	newOperationRoute(Operation_ID_RestoreGitconfig, http.MethodPut, `/gitconfigs/{git_config_id}/restore`, ServiceError_ResourceType_Gitconfig, func() interface{} { return &RestoreGitconfigOptions{} }),
+	newOperationRoute(Operation_ID_CreateIntegration, http.MethodPost, `/integrations`, ServiceError_ResourceType_Integration, nil),
+	newOperationRoute(Operation_ID_DeleteIntegration, http.MethodDelete, `/integrations/{integration_id}`, ServiceError_ResourceType_Integration, func() interface{} { return &DeleteIntegrationOptions{} }),
+	newOperationRoute(Operation_ID_UpdateOriginconfigs, http.MethodPut, `/originconfigs`, ServiceError_ResourceType_Originconfig, func() interface{} { return &UpdateOriginconfigsOptions{} }),
+	newOperationRoute(Operation_ID_CreateWorkflowconfig, http.MethodPost, `/environments/{environment_id}/workflowconfigs`, ServiceError_ResourceType_WorkflowConfig, nil),
+	newOperationRoute(Operation_ID_UpdateWorkflowconfig, http.MethodPut, `/environments/{environment_id}/workflowconfigs`, ServiceError_ResourceType_WorkflowConfig, nil),
+	newOperationRoute(Operation_ID_DeleteWorkflowconfig, http.MethodDelete, `/environments/{environment_id}/workflowconfigs`, ServiceError_ResourceType_WorkflowConfig, func() interface{} { return &DeleteWorkflowconfigOptions{} }),
+	newOperationRoute(Operation_ID_CreateWorkflowConfigs, http.MethodPost, `/workflow/configs`, ServiceError_ResourceType_WorkflowConfig, func() interface{} { return &CreateWorkflowConfigsOptions{} }),
+	newOperationRoute(Operation_ID_UpdateWorkflowConfigs, http.MethodPut, `/workflow/configs/{workflow_config_id}`, ServiceError_ResourceType_WorkflowConfig, func() interface{} { return &UpdateWorkflowConfigsOptions{} }),
+	newOperationRoute(Operation_ID_DeleteWorkflowConfigs, http.MethodDelete, `/workflow/configs/{workflow_config_id}`, ServiceError_ResourceType_WorkflowConfig, func() interface{} { return &DeleteWorkflowConfigsOptions{} }),
+	newOperationRoute(Operation_ID_ToggleWorkflowConfig, http.MethodPut, `/workflow/configs/{workflow_config_id}/toggle`, ServiceError_ResourceType_WorkflowConfig, func() interface{} { return &ToggleWorkflowConfigOptions{} }),
+	newOperationRoute(Operation_ID_TestWorkflowConfig, http.MethodPost, `/workflow/configs/{workflow_config_id}/test`, ServiceError_ResourceType_WorkflowConfig, func() interface{} { return &TestWorkflowConfigOptions{} }),
+	newOperationRoute(Operation_ID_ImportConfig, http.MethodPost, `/config`, ServiceError_ResourceType_Instance, func() interface{} { return &ImportConfigOptions{} }),
+	newOperationRoute(Operation_ID_PromoteRestoreConfig, http.MethodPut, `/config`, ServiceError_ResourceType_Instance, func() interface{} { return &PromoteRestoreConfigOptions{} }),
+}
+
+func newOperationRoute(id string, method string, path string, resourceType string, newOptions func() interface{}) operationRoute {
+	return operationRoute{id: id, method: method, path: strings.Split(strings.Trim(path, "/"), "/"), resourceType: resourceType, newOptions: newOptions}
+}
+
+// newOperation describes the operation of a request. The service URL can have a path of its own, so routes are
+// matched against the end of the path of the request, the longest first. The body is read to rebuild the options,
+// and the request is returned with a new body.
+func newOperation(req *http.Request, client *AppConfigurationV1) (*Operation, *http.Request, error) {
+	operation := &Operation{PathParams: map[string]string{}, Client: client}
+	segments := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+	var route *operationRoute
+	for i := range operationRoutes {
+		candidate := &operationRoutes[i]
+		if candidate.method != req.Method || (route != nil && len(route.path) >= len(candidate.path)) {
+			continue
+		}
+		if params, ok := matchRoute(candidate.path, segments); ok {
+			route, operation.PathParams = candidate, params
+		}
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, nil, core.SDKErrorf(err, "", "request-hook-body-error", common.GetComponentInfo())
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	}
+	operation.Request = req
+	if route == nil {
+		return operation, req, nil
+	}
+	operation.ID = route.id
+	operation.ResourceType = route.resourceType
+	if route.newOptions != nil {
+		operation.Options = decodeOperationOptions(route.newOptions(), req, body, operation.PathParams)
+	}
+	return operation, req, nil
+}
+
+func matchRoute(path []string, segments []string) (map[string]string, bool) {
+	if len(segments) < len(path) {
+		return nil, false
+	}
+	params := map[string]string{}
+	tail := segments[len(segments)-len(path):]
+	for i, segment := range path {
+		value, err := url.PathUnescape(tail[i])
+		if err != nil {
+			return nil, false
+		}
+		if strings.HasPrefix(segment, "{") {
+			params[strings.Trim(segment, "{}")] = value
+		} else if segment != value {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// decodeOperationOptions fills options from the body, path and query parameters of a request, which are named after
+// the JSON names of its fields. It returns nil if they cannot be decoded.
+func decodeOperationOptions(options interface{}, req *http.Request, body []byte, pathParams map[string]string) interface{} {
+	fields := map[string]interface{}{}
+	if len(body) > 0 {
+		if req.Header.Get("Content-Encoding") == "gzip" {
+			reader, err := gzip.NewReader(bytes.NewReader(body))
+			if err != nil {
+				return nil
+			}
+			if body, err = io.ReadAll(reader); err != nil {
+				return nil
+			}
+		}
+		if json.Unmarshal(body, &fields) != nil {
+			return nil
+		}
+	}
+	for name, values := range req.URL.Query() {
+		if len(values) > 0 {
+			fields[name] = values[0]
+		}
+	}
+	for name, value := range pathParams {
+		fields[name] = value
+	}
+	content, err := json.Marshal(fields)
+	if err != nil || json.Unmarshal(content, options) != nil {
+		return nil
+	}
+	return options
+}
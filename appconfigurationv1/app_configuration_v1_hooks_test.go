@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 request hooks`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+	var requests []string
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			content, _ := io.ReadAll(req.Body)
+			requests = append(requests, req.Method+" "+req.URL.Path+" "+strings.TrimSpace(string(content)))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true}`)
+		}))
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Describes each mutating operation to the hooks, in order`, func() {
+		var calls []string
+		var operation *appconfigurationv1.Operation
+		Expect(appConfigurationService.AddRequestHook(func(ctx context.Context, op *appconfigurationv1.Operation) (func(*http.Response, error), error) {
+			operation = op
+			calls = append(calls, "first")
+			return func(response *http.Response, err error) {
+				Expect(response.StatusCode).To(Equal(200))
+				calls = append(calls, "first after")
+			}, nil
+		})).To(Succeed())
+		Expect(appConfigurationService.AddRequestHook(func(ctx context.Context, op *appconfigurationv1.Operation) (func(*http.Response, error), error) {
+			calls = append(calls, "second")
+			return func(*http.Response, error) { calls = append(calls, "second after") }, nil
+		})).To(Succeed())
+
+		_, _, err := appConfigurationService.GetFeature(&appconfigurationv1.GetFeatureOptions{
+			EnvironmentID: core.StringPtr("dev"),
+			FeatureID:     core.StringPtr("checkout"),
+		})
+		Expect(err).To(BeNil())
+		Expect(calls).To(BeEmpty())
+
+		_, _, err = appConfigurationService.ToggleFeature(&appconfigurationv1.ToggleFeatureOptions{
+			EnvironmentID: core.StringPtr("dev"),
+			FeatureID:     core.StringPtr("checkout"),
+			Enabled:       core.BoolPtr(true),
+		})
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"first", "second", "second after", "first after"}))
+		Expect(operation.ID).To(Equal(appconfigurationv1.Operation_ID_ToggleFeature))
+		Expect(operation.ResourceType).To(Equal(appconfigurationv1.ServiceError_ResourceType_Feature))
+		Expect(operation.PathParams).To(Equal(map[string]string{"environment_id": "dev", "feature_id": "checkout"}))
+		Expect(operation.Options).To(Equal(&appconfigurationv1.ToggleFeatureOptions{
+			EnvironmentID: core.StringPtr("dev"),
+			FeatureID:     core.StringPtr("checkout"),
+			Enabled:       core.BoolPtr(true),
+		}))
+		// The body was read by the hook and is still sent.
+		Expect(requests[len(requests)-1]).To(Equal(`PUT /environments/dev/features/checkout/toggle {"enabled":true}`))
+	})
+
+	It(`Does not send a request that a hook rejects`, func() {
+		rejected := errors.New("changes are frozen")
+		var afterErr error
+		Expect(appConfigurationService.AddRequestHook(func(ctx context.Context, op *appconfigurationv1.Operation) (func(*http.Response, error), error) {
+			return func(response *http.Response, err error) { afterErr = err }, nil
+		})).To(Succeed())
+		Expect(appConfigurationService.AddRequestHook(func(ctx context.Context, op *appconfigurationv1.Operation) (func(*http.Response, error), error) {
+			return nil, rejected
+		})).To(Succeed())
+
+		_, _, err := appConfigurationService.DeleteSegment(&appconfigurationv1.DeleteSegmentOptions{
+			SegmentID: core.StringPtr("beta"),
+		})
+		Expect(errors.Is(err, rejected)).To(BeTrue())
+		Expect(errors.Is(afterErr, rejected)).To(BeTrue())
+		Expect(requests).To(BeEmpty())
+	})
+})
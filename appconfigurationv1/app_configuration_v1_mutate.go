@@ -0,0 +1,317 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MutateFeature : Change a feature flag with a function
+// Read the feature flag with its collections and rules, call mutate with a copy of it, and write the changes with
+// the narrowest operation: UpdateFeatureValues when only values, rules or descriptive fields changed, ToggleFeature
+// when only Enabled changed, and UpdateFeature otherwise. Collections removed from the copy are removed from the
+// feature flag. Nothing is written if the copy is unchanged, in which case the feature flag and the response of the
+// read are returned. The ID, type and format of the feature flag cannot be changed.
+func (appConfiguration *AppConfigurationV1) MutateFeature(ctx context.Context, environmentID string, featureID string, mutate func(*Feature) error) (result *Feature, response *core.DetailedResponse, err error) {
+	current, response, err := appConfiguration.GetFeatureWithContext(ctx, &GetFeatureOptions{
+		EnvironmentID: &environmentID,
+		FeatureID:     &featureID,
+		Include:       []string{GetFeatureOptions_Include_Collections, GetFeatureOptions_Include_Rules},
+	})
+	if err != nil {
+		return
+	}
+	mutated, err := mutateModel(current, mutate)
+	if err == nil {
+		err = checkImmutable("feature", featureID, map[string][2]interface{}{
+			"ID":     {current.FeatureID, mutated.FeatureID},
+			"type":   {current.Type, mutated.Type},
+			"format": {current.Format, mutated.Format},
+		})
+	}
+	if err != nil {
+		return nil, response, err
+	}
+
+	valuesChanged := jsonDiffers(featureValues(environmentID, featureID, current), featureValues(environmentID, featureID, mutated))
+	enabledChanged := jsonDiffers(current.Enabled, mutated.Enabled)
+	collectionsChanged := jsonDiffers(current.Collections, mutated.Collections)
+	switch {
+	case collectionsChanged || (enabledChanged && valuesChanged):
+		values := featureValues(environmentID, featureID, mutated)
+		return appConfiguration.UpdateFeatureWithContext(ctx, &UpdateFeatureOptions{
+			EnvironmentID:        values.EnvironmentID,
+			FeatureID:            values.FeatureID,
+			Name:                 values.Name,
+			Description:          values.Description,
+			EnabledValue:         values.EnabledValue,
+			DisabledValue:        values.DisabledValue,
+			Enabled:              mutated.Enabled,
+			RolloutPercentage:    values.RolloutPercentage,
+			RolloutType:          values.RolloutType,
+			RolloutConfiguration: values.RolloutConfiguration,
+			Tags:                 values.Tags,
+			SegmentRules:         values.SegmentRules,
+			Collections:          mutatedCollections(mutated.Collections, current.Collections),
+		})
+	case enabledChanged:
+		return appConfiguration.ToggleFeatureWithContext(ctx, &ToggleFeatureOptions{
+			EnvironmentID: &environmentID,
+			FeatureID:     &featureID,
+			Enabled:       core.BoolPtr(mutated.Enabled != nil && *mutated.Enabled),
+		})
+	case valuesChanged:
+		return appConfiguration.UpdateFeatureValuesWithContext(ctx, featureValues(environmentID, featureID, mutated))
+	}
+	return current, response, nil
+}
+
+// MutateProperty : Change a property with a function
+// Read the property with its collections and rules, call mutate with a copy of it, and write the changes with
+// UpdatePropertyValues, or with UpdateProperty if the collections changed. Collections removed from the copy are
+// removed from the property. Nothing is written if the copy is unchanged, in which case the property and the response
+// of the read are returned. The ID, type and format of the property cannot be changed.
+func (appConfiguration *AppConfigurationV1) MutateProperty(ctx context.Context, environmentID string, propertyID string, mutate func(*Property) error) (result *Property, response *core.DetailedResponse, err error) {
+	current, response, err := appConfiguration.GetPropertyWithContext(ctx, &GetPropertyOptions{
+		EnvironmentID: &environmentID,
+		PropertyID:    &propertyID,
+		Include:       []string{GetPropertyOptions_Include_Collections, GetPropertyOptions_Include_Rules},
+	})
+	if err != nil {
+		return
+	}
+	mutated, err := mutateModel(current, mutate)
+	if err == nil {
+		err = checkImmutable("property", propertyID, map[string][2]interface{}{
+			"ID":     {current.PropertyID, mutated.PropertyID},
+			"type":   {current.Type, mutated.Type},
+			"format": {current.Format, mutated.Format},
+		})
+	}
+	if err != nil {
+		return nil, response, err
+	}
+
+	values := propertyValues(environmentID, propertyID, mutated)
+	switch {
+	case jsonDiffers(current.Collections, mutated.Collections):
+		return appConfiguration.UpdatePropertyWithContext(ctx, &UpdatePropertyOptions{
+			EnvironmentID: values.EnvironmentID,
+			PropertyID:    values.PropertyID,
+			Name:          values.Name,
+			Description:   values.Description,
+			Value:         values.Value,
+			Tags:          values.Tags,
+			SegmentRules:  values.SegmentRules,
+			Collections:   mutatedCollections(mutated.Collections, current.Collections),
+		})
+	case jsonDiffers(propertyValues(environmentID, propertyID, current), values):
+		return appConfiguration.UpdatePropertyValuesWithContext(ctx, values)
+	}
+	return current, response, nil
+}
+
+// MutateSegment : Change a segment with a function
+// Read the segment, call mutate with a copy of it, and write the changes with UpdateSegment. Nothing is written if the
+// copy is unchanged, in which case the segment and the response of the read are returned. The ID of the segment cannot
+// be changed.
+func (appConfiguration *AppConfigurationV1) MutateSegment(ctx context.Context, segmentID string, mutate func(*Segment) error) (result *Segment, response *core.DetailedResponse, err error) {
+	current, response, err := appConfiguration.GetSegmentWithContext(ctx, &GetSegmentOptions{
+		SegmentID: &segmentID,
+	})
+	if err != nil {
+		return
+	}
+	mutated, err := mutateModel(current, mutate)
+	if err == nil {
+		err = checkImmutable("segment", segmentID, map[string][2]interface{}{"ID": {current.SegmentID, mutated.SegmentID}})
+	}
+	if err != nil {
+		return nil, response, err
+	}
+
+	update := func(segment *Segment) *UpdateSegmentOptions {
+		return &UpdateSegmentOptions{
+			SegmentID:   &segmentID,
+			Name:        segment.Name,
+			Description: segment.Description,
+			Tags:        segment.Tags,
+			Rules:       segment.Rules,
+		}
+	}
+	if jsonDiffers(update(current), update(mutated)) {
+		return appConfiguration.UpdateSegmentWithContext(ctx, update(mutated))
+	}
+	return current, response, nil
+}
+
+// MutateCollection : Change a collection with a function
+// Read the collection, call mutate with a copy of it, and write the changes with UpdateCollection. Nothing is written
+// if the copy is unchanged, in which case the collection read is returned. The ID of the collection cannot be changed.
+func (appConfiguration *AppConfigurationV1) MutateCollection(ctx context.Context, collectionID string, mutate func(*Collection) error) (result *CollectionLite, response *core.DetailedResponse, err error) {
+	current, response, err := appConfiguration.GetCollectionWithContext(ctx, &GetCollectionOptions{
+		CollectionID: &collectionID,
+	})
+	if err != nil {
+		return
+	}
+	mutated, err := mutateModel(current, mutate)
+	if err == nil {
+		err = checkImmutable("collection", collectionID, map[string][2]interface{}{"ID": {current.CollectionID, mutated.CollectionID}})
+	}
+	if err != nil {
+		return nil, response, err
+	}
+
+	update := func(collection *Collection) *UpdateCollectionOptions {
+		return &UpdateCollectionOptions{
+			CollectionID: &collectionID,
+			Name:         collection.Name,
+			Description:  collection.Description,
+			Tags:         collection.Tags,
+		}
+	}
+	if jsonDiffers(update(current), update(mutated)) {
+		return appConfiguration.UpdateCollectionWithContext(ctx, update(mutated))
+	}
+	return &CollectionLite{
+		Name:         current.Name,
+		CollectionID: current.CollectionID,
+		Description:  current.Description,
+		Tags:         current.Tags,
+		CreatedTime:  current.CreatedTime,
+		UpdatedTime:  current.UpdatedTime,
+		Href:         current.Href,
+	}, response, nil
+}
+
+// MutateEnvironment : Change an environment with a function
+// Read the environment, call mutate with a copy of it, and write the changes with UpdateEnvironment. Nothing is
+// written if the copy is unchanged, in which case the environment and the response of the read are returned. The ID
+// of the environment cannot be changed.
+func (appConfiguration *AppConfigurationV1) MutateEnvironment(ctx context.Context, environmentID string, mutate func(*Environment) error) (result *Environment, response *core.DetailedResponse, err error) {
+	current, response, err := appConfiguration.GetEnvironmentWithContext(ctx, &GetEnvironmentOptions{
+		EnvironmentID: &environmentID,
+	})
+	if err != nil {
+		return
+	}
+	mutated, err := mutateModel(current, mutate)
+	if err == nil {
+		err = checkImmutable("environment", environmentID, map[string][2]interface{}{"ID": {current.EnvironmentID, mutated.EnvironmentID}})
+	}
+	if err != nil {
+		return nil, response, err
+	}
+
+	update := func(environment *Environment) *UpdateEnvironmentOptions {
+		return &UpdateEnvironmentOptions{
+			EnvironmentID: &environmentID,
+			Name:          environment.Name,
+			Description:   environment.Description,
+			Tags:          environment.Tags,
+			ColorCode:     environment.ColorCode,
+		}
+	}
+	if jsonDiffers(update(current), update(mutated)) {
+		return appConfiguration.UpdateEnvironmentWithContext(ctx, update(mutated))
+	}
+	return current, response, nil
+}
+
+// featureValues returns the options of UpdateFeatureValues that give a feature flag the values of feature.
+func featureValues(environmentID string, featureID string, feature *Feature) *UpdateFeatureValuesOptions {
+	return &UpdateFeatureValuesOptions{
+		EnvironmentID:        &environmentID,
+		FeatureID:            &featureID,
+		Name:                 feature.Name,
+		Description:          feature.Description,
+		Tags:                 feature.Tags,
+		EnabledValue:         feature.EnabledValue,
+		DisabledValue:        feature.DisabledValue,
+		RolloutPercentage:    feature.RolloutPercentage,
+		RolloutType:          feature.RolloutType,
+		RolloutConfiguration: feature.RolloutConfiguration,
+		SegmentRules:         feature.SegmentRules,
+	}
+}
+
+// propertyValues returns the options of UpdatePropertyValues that give a property the values of property.
+func propertyValues(environmentID string, propertyID string, property *Property) *UpdatePropertyValuesOptions {
+	return &UpdatePropertyValuesOptions{
+		EnvironmentID: &environmentID,
+		PropertyID:    &propertyID,
+		Name:          property.Name,
+		Description:   property.Description,
+		Tags:          property.Tags,
+		Value:         property.Value,
+		SegmentRules:  property.SegmentRules,
+	}
+}
+
+// mutatedCollections returns the collection changes of an update that makes the collections of a resource the
+// mutated ones, including none.
+func mutatedCollections(mutated []CollectionRef, current []CollectionRef) []CollectionUpdateRef {
+	if mutated == nil {
+		mutated = []CollectionRef{}
+	}
+	return collectionUpdates(mutated, current)
+}
+
+// mutateModel calls mutate with a deep copy of model, and returns the copy.
+func mutateModel[Model any](model *Model, mutate func(*Model) error) (*Model, error) {
+	err := core.ValidateNotNil(mutate, "mutate cannot be nil")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	content, err := json.Marshal(model)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "mutate-copy-error", common.GetComponentInfo())
+	}
+	copied := new(Model)
+	if err = json.Unmarshal(content, copied); err != nil {
+		return nil, core.SDKErrorf(err, "", "mutate-copy-error", common.GetComponentInfo())
+	}
+	if err = mutate(copied); err != nil {
+		return nil, core.SDKErrorf(err, "", "mutate-error", common.GetComponentInfo())
+	}
+	return copied, nil
+}
+
+// checkImmutable returns an error if a mutation changed one of fields, given as their value before and after it.
+func checkImmutable(kind string, id string, fields map[string][2]interface{}) error {
+	for _, field := range []string{"ID", "type", "format"} {
+		if values, ok := fields[field]; ok && jsonDiffers(values[0], values[1]) {
+			return core.SDKErrorf(nil, fmt.Sprintf("the %s of %s '%s' cannot be changed", field, kind, id), "mutate-immutable-field-error", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// jsonDiffers returns true if a and b have different JSON representations, so that values unmarshalled from the
+// service compare equal to copies of them.
+func jsonDiffers(a interface{}, b interface{}) bool {
+	contentA, errA := json.Marshal(a)
+	contentB, errB := json.Marshal(b)
+	return errA != nil || errB != nil || !bytes.Equal(contentA, contentB)
+}
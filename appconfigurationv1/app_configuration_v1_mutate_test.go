@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 mutate operations`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+	var writes []string
+	var bodies []map[string]interface{}
+
+	// The fake service returns a feature flag and a segment on reads, and records the writes.
+	BeforeEach(func() {
+		writes, bodies = nil, nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "GET" && req.URL.Path == "/environments/dev/features/checkout" {
+				Expect(req.URL.Query().Get("include")).To(Equal("collections,rules"))
+				fmt.Fprint(res, `{"name": "Checkout", "feature_id": "checkout", "type": "NUMERIC", "enabled_value": 1, "disabled_value": 0,
+					"enabled": false, "segment_rules": [{"rules": [{"segments": ["beta"]}], "value": 2, "order": 1}],
+					"collections": [{"collection_id": "shop", "name": "Shop"}, {"collection_id": "web", "name": "Web"}],
+					"updated_time": "2026-03-01T09:00:00Z", "href": "https://example.com/checkout"}`)
+				return
+			}
+			if req.Method == "GET" && req.URL.Path == "/segments/beta" {
+				fmt.Fprint(res, `{"name": "Beta", "segment_id": "beta", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com"]}]}`)
+				return
+			}
+			writes = append(writes, req.Method+" "+req.URL.Path)
+			body := map[string]interface{}{}
+			content, _ := io.ReadAll(req.Body)
+			Expect(json.Unmarshal(content, &body)).To(Succeed())
+			bodies = append(bodies, body)
+			res.Write(content)
+		}))
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Patches the values of a feature when only a rule changed`, func() {
+		_, _, err := appConfigurationService.MutateFeature(context.Background(), "dev", "checkout", func(feature *appconfigurationv1.Feature) error {
+			feature.SegmentRules[0].Value = 3
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(writes).To(Equal([]string{"PATCH /environments/dev/features/checkout"}))
+		Expect(bodies[0]["segment_rules"]).To(Equal([]interface{}{map[string]interface{}{
+			"rules": []interface{}{map[string]interface{}{"segments": []interface{}{"beta"}}}, "value": float64(3), "order": float64(1),
+		}}))
+		Expect(bodies[0]["enabled_value"]).To(Equal(float64(1)))
+		Expect(bodies[0]["name"]).To(Equal("Checkout"))
+	})
+
+	It(`Toggles a feature when only its state changed`, func() {
+		_, _, err := appConfigurationService.MutateFeature(context.Background(), "dev", "checkout", func(feature *appconfigurationv1.Feature) error {
+			feature.Enabled = core.BoolPtr(true)
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(writes).To(Equal([]string{"PUT /environments/dev/features/checkout/toggle"}))
+		Expect(bodies[0]).To(Equal(map[string]interface{}{"enabled": true}))
+	})
+
+	It(`Replaces a feature when its collections changed`, func() {
+		_, _, err := appConfigurationService.MutateFeature(context.Background(), "dev", "checkout", func(feature *appconfigurationv1.Feature) error {
+			feature.Collections = feature.Collections[:1]
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(writes).To(Equal([]string{"PUT /environments/dev/features/checkout"}))
+		Expect(bodies[0]["collections"]).To(Equal([]interface{}{
+			map[string]interface{}{"collection_id": "shop"},
+			map[string]interface{}{"collection_id": "web", "deleted": true},
+		}))
+		Expect(bodies[0]["segment_rules"]).To(HaveLen(1))
+		Expect(bodies[0]["enabled"]).To(Equal(false))
+	})
+
+	It(`Writes nothing when nothing changed`, func() {
+		result, response, err := appConfigurationService.MutateFeature(context.Background(), "dev", "checkout", func(feature *appconfigurationv1.Feature) error {
+			feature.Href = core.StringPtr("ignored")
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*result.Href).To(Equal("https://example.com/checkout"))
+		Expect(writes).To(BeEmpty())
+	})
+
+	It(`Rejects invalid mutations`, func() {
+		_, _, err := appConfigurationService.MutateFeature(context.Background(), "dev", "checkout", func(feature *appconfigurationv1.Feature) error {
+			feature.Type = core.StringPtr(appconfigurationv1.Feature_Type_String)
+			return nil
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the type of feature 'checkout' cannot be changed"))
+
+		failure := errors.New("not now")
+		_, _, err = appConfigurationService.MutateFeature(context.Background(), "dev", "checkout", func(feature *appconfigurationv1.Feature) error {
+			return failure
+		})
+		Expect(errors.Is(err, failure)).To(BeTrue())
+		Expect(writes).To(BeEmpty())
+	})
+
+	It(`Updates a segment`, func() {
+		_, _, err := appConfigurationService.MutateSegment(context.Background(), "beta", func(segment *appconfigurationv1.Segment) error {
+			segment.Rules[0].Values = append(segment.Rules[0].Values, "@example.com")
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(writes).To(Equal([]string{"PUT /segments/beta"}))
+		Expect(bodies[0]["rules"]).To(Equal([]interface{}{map[string]interface{}{
+			"attribute_name": "email", "operator": "endsWith", "values": []interface{}{"@ibm.com", "@example.com"},
+		}}))
+	})
+})
@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PrivateParameterizedServiceURL is the parameterized URL used to reach an instance over the IBM Cloud private network.
+const PrivateParameterizedServiceURL = "https://private.{region}.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/{guid}"
+
+// PrivateRegionPrefix can be prepended to a region name (for example "private.us-south") to select the private
+// endpoint of that region.
+const PrivateRegionPrefix = "private."
+
+// supportedRegions lists the regions in which the App Configuration service is available.
+var supportedRegions = []string{
+	"au-syd",
+	"br-sao",
+	"ca-tor",
+	"eu-de",
+	"eu-es",
+	"eu-gb",
+	"jp-osa",
+	"jp-tok",
+	"us-east",
+	"us-south",
+}
+
+var instanceGUIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// configureServiceURLFromRegion sets the service URL from the REGION, GUID and ENDPOINT_TYPE properties of the
+// external configuration (for example APP_CONFIGURATION_REGION and APP_CONFIGURATION_GUID), unless the external
+// configuration already provides a URL.
+func configureServiceURLFromRegion(appConfiguration *AppConfigurationV1, serviceName string) (err error) {
+	props, err := core.GetServiceProperties(serviceName)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-props-error", common.GetComponentInfo())
+		return
+	}
+	if props[core.PROPNAME_SVC_URL] != "" || (props["REGION"] == "" && props["GUID"] == "") {
+		return
+	}
+	if props["REGION"] == "" || props["GUID"] == "" {
+		err = core.SDKErrorf(nil, "both REGION and GUID must be specified in the external configuration", "region-config-error", common.GetComponentInfo())
+		return
+	}
+
+	region := props["REGION"]
+	switch strings.ToLower(props["ENDPOINT_TYPE"]) {
+	case "", "public":
+	case "private":
+		if !strings.HasPrefix(region, PrivateRegionPrefix) {
+			region = PrivateRegionPrefix + region
+		}
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a valid endpoint type, use 'public' or 'private'", props["ENDPOINT_TYPE"]), "endpoint-type-error", common.GetComponentInfo())
+		return
+	}
+
+	serviceURL, err := GetServiceURLForInstance(region, props["GUID"])
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "region-config-error")
+		return
+	}
+	err = appConfiguration.Service.SetServiceURL(serviceURL)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-set-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// SupportedRegions returns the names of the regions in which the service is available.
+func SupportedRegions() []string {
+	return append([]string(nil), supportedRegions...)
+}
+
+// ValidateRegion checks that region names a supported region, optionally prefixed with "private." to select the
+// private endpoint.
+func ValidateRegion(region string) error {
+	name := strings.TrimPrefix(region, PrivateRegionPrefix)
+	for _, supported := range supportedRegions {
+		if name == supported {
+			return nil
+		}
+	}
+	return core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a supported region. Supported regions: %s", region, strings.Join(supportedRegions, ", ")), "invalid-region", common.GetComponentInfo())
+}
+
+// ValidateInstanceGUID checks that guid is a well-formed App Configuration instance GUID.
+func ValidateInstanceGUID(guid string) error {
+	if !instanceGUIDPattern.MatchString(guid) {
+		return core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a valid instance GUID", guid), "invalid-guid", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// serviceURLForRegion returns the URL of the instances of a supported region.
+func serviceURLForRegion(region string) (string, error) {
+	if err := ValidateRegion(region); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s.apprapp.cloud.ibm.com/apprapp/feature/v1/instances", region), nil
+}
+
+// GetServiceURLForInstance returns the service URL of the instance with the specified GUID in the specified region.
+// Prefix the region with "private." to get the private endpoint. The region and GUID are validated.
+func GetServiceURLForInstance(region string, guid string) (string, error) {
+	return ConstructValidatedServiceURL(map[string]string{"region": region, "guid": guid})
+}
+
+// ConstructValidatedServiceURL constructs a service URL like ConstructServiceURL, after checking that the provided
+// "region" is a supported region and the provided "guid" a well-formed instance GUID. A region prefixed with
+// "private." selects the private endpoint. Use ConstructServiceURL for regions or hosts that are not in
+// SupportedRegions.
+func ConstructValidatedServiceURL(providedUrlVariables map[string]string) (string, error) {
+	if region, ok := providedUrlVariables["region"]; ok {
+		if err := ValidateRegion(region); err != nil {
+			return "", err
+		}
+	}
+	if guid, ok := providedUrlVariables["guid"]; ok {
+		if err := ValidateInstanceGUID(guid); err != nil {
+			return "", err
+		}
+	}
+	if strings.HasPrefix(providedUrlVariables["region"], PrivateRegionPrefix) {
+		return ConstructPrivateServiceURL(providedUrlVariables)
+	}
+	return ConstructServiceURL(providedUrlVariables)
+}
+
+// ConstructPrivateServiceURL constructs a private endpoint service URL from the private parameterized URL. A
+// "private." prefix of the provided region is ignored.
+func ConstructPrivateServiceURL(providedUrlVariables map[string]string) (string, error) {
+	urlVariables := make(map[string]string, len(providedUrlVariables))
+	for name, value := range providedUrlVariables {
+		urlVariables[name] = value
+	}
+	if region, ok := urlVariables["region"]; ok {
+		urlVariables["region"] = strings.TrimPrefix(region, PrivateRegionPrefix)
+	}
+	return core.ConstructServiceURL(PrivateParameterizedServiceURL, defaultUrlVariables, urlVariables)
+}
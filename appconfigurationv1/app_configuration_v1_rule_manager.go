@@ -0,0 +1,460 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Actions of UpdateFeatureRuleOrder.
+const (
+	ReorderFeatureRules_Action_Move = "move"
+	ReorderFeatureRules_Action_Swap = "swap"
+)
+
+// FeatureRuleManager : The rules of a feature flag, addressed by name
+// A rule is addressed by its RuleName, or by its RuleID if it has no name. Orders are 1-based, as in the
+// UpdateFeatureRuleOrder operation: the rule of order 1 is evaluated first.
+type FeatureRuleManager struct {
+	client        *AppConfigurationV1
+	environmentID string
+	featureID     string
+}
+
+// FeatureRuleMove : A move of a rule to a new order, as sent to UpdateFeatureRuleOrder.
+type FeatureRuleMove struct {
+	// The ID of the moved rule.
+	RuleID string
+
+	// The order of the rule after the move.
+	Order int64
+}
+
+// NewFeatureRuleManager : Manage the rules of the feature flag featureID in the environment environmentID by name
+func (appConfiguration *AppConfigurationV1) NewFeatureRuleManager(environmentID string, featureID string) *FeatureRuleManager {
+	return &FeatureRuleManager{client: appConfiguration, environmentID: environmentID, featureID: featureID}
+}
+
+// List returns the rules of the feature flag, in order.
+func (manager *FeatureRuleManager) List(ctx context.Context) ([]FeatureSegmentRuleWithRuleID, error) {
+	result, _, err := manager.client.ListFeatureRulesWithContext(ctx, &ListFeatureRulesOptions{
+		EnvironmentID: &manager.environmentID,
+		FeatureID:     &manager.featureID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rules := result.SegmentRules
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Order != nil && (rules[j].Order == nil || *rules[i].Order < *rules[j].Order)
+	})
+	return rules, nil
+}
+
+// Get returns the rule named name.
+func (manager *FeatureRuleManager) Get(ctx context.Context, name string) (*FeatureSegmentRuleWithRuleID, error) {
+	rules, err := manager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, err := manager.find(rules, name)
+	if err != nil {
+		return nil, err
+	}
+	return &rules[index], nil
+}
+
+// InsertBefore creates a rule and moves it before the rule named before. The environment and feature flag of
+// options are set by the manager.
+func (manager *FeatureRuleManager) InsertBefore(ctx context.Context, before string, options *CreateFeatureRuleOptions) (*FeatureSegmentRuleWithRuleID, error) {
+	return manager.insert(ctx, before, 0, options)
+}
+
+// InsertAfter creates a rule and moves it after the rule named after. The environment and feature flag of options
+// are set by the manager.
+func (manager *FeatureRuleManager) InsertAfter(ctx context.Context, after string, options *CreateFeatureRuleOptions) (*FeatureSegmentRuleWithRuleID, error) {
+	return manager.insert(ctx, after, 1, options)
+}
+
+func (manager *FeatureRuleManager) insert(ctx context.Context, anchor string, offset int, options *CreateFeatureRuleOptions) (*FeatureSegmentRuleWithRuleID, error) {
+	err := core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	rules, err := manager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = manager.find(rules, anchor); err != nil {
+		return nil, err
+	}
+
+	created := *options
+	created.EnvironmentID, created.FeatureID = &manager.environmentID, &manager.featureID
+	result, _, err := manager.client.CreateFeatureRuleWithContext(ctx, &created)
+	if err != nil {
+		return nil, err
+	}
+	rules, err = manager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from := indexOf(featureRuleIDs(rules), core.StringNilMapper(result.RuleID))
+	index, err := manager.find(rules, anchor)
+	if err != nil {
+		return nil, err
+	}
+	if from < 0 {
+		err = fmt.Errorf("the created rule '%s' is not listed in feature '%s'", core.StringNilMapper(result.RuleID), manager.featureID)
+		return nil, core.SDKErrorf(err, "", "rule-not-found-error", common.GetComponentInfo())
+	}
+	if index > from {
+		index--
+	}
+	if to := index + offset; to != from {
+		if err = manager.move(ctx, FeatureRuleMove{RuleID: *result.RuleID, Order: int64(to) + 1}); err != nil {
+			return nil, err
+		}
+		result.Order = core.Int64Ptr(int64(to) + 1)
+	}
+	return result, nil
+}
+
+// Update updates the rule named name. The environment, feature flag and rule ID of options are set by the manager.
+func (manager *FeatureRuleManager) Update(ctx context.Context, name string, options *UpdateFeatureRuleOptions) (*FeatureSegmentRuleWithRuleID, error) {
+	err := core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	rule, err := manager.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	updated := *options
+	updated.EnvironmentID, updated.FeatureID, updated.RuleID = &manager.environmentID, &manager.featureID, rule.RuleID
+	result, _, err := manager.client.UpdateFeatureRuleWithContext(ctx, &updated)
+	return result, err
+}
+
+// Delete deletes the rule named name.
+func (manager *FeatureRuleManager) Delete(ctx context.Context, name string) error {
+	rule, err := manager.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	_, _, err = manager.client.DeleteFeatureRuleWithContext(ctx, &DeleteFeatureRuleOptions{
+		EnvironmentID: &manager.environmentID,
+		FeatureID:     &manager.featureID,
+		RuleID:        rule.RuleID,
+	})
+	return err
+}
+
+// StopRollout stops the rollout of the rule named name.
+func (manager *FeatureRuleManager) StopRollout(ctx context.Context, name string) (*FeatureSegmentRuleWithRuleID, error) {
+	rule, err := manager.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	result, _, err := manager.client.StopFeatureRuleRolloutWithContext(ctx, &StopFeatureRuleRolloutOptions{
+		EnvironmentID: &manager.environmentID,
+		FeatureID:     &manager.featureID,
+		RuleID:        rule.RuleID,
+	})
+	return result, err
+}
+
+// MoveToTop moves the rule named name to order 1.
+func (manager *FeatureRuleManager) MoveToTop(ctx context.Context, name string) error {
+	rules, err := manager.List(ctx)
+	if err != nil {
+		return err
+	}
+	index, err := manager.find(rules, name)
+	if err != nil || index == 0 {
+		return err
+	}
+	return manager.move(ctx, FeatureRuleMove{RuleID: *rules[index].RuleID, Order: 1})
+}
+
+// Swap swaps the orders of the rules named first and second.
+func (manager *FeatureRuleManager) Swap(ctx context.Context, first string, second string) error {
+	rules, err := manager.List(ctx)
+	if err != nil {
+		return err
+	}
+	source, err := manager.find(rules, first)
+	if err != nil {
+		return err
+	}
+	target, err := manager.find(rules, second)
+	if err != nil || source == target {
+		return err
+	}
+	_, _, err = manager.client.UpdateFeatureRuleOrderWithContext(ctx, &UpdateFeatureRuleOrderOptions{
+		EnvironmentID: &manager.environmentID,
+		FeatureID:     &manager.featureID,
+		UpdateFeatureRuleOrder: &ReorderFeatureRulesBySwap{
+			Action:       core.StringPtr(ReorderFeatureRules_Action_Swap),
+			SourceRuleID: rules[source].RuleID,
+			TargetRuleID: rules[target].RuleID,
+		},
+	})
+	return err
+}
+
+// Reorder orders the rules as names, which must name every rule of the feature flag once, with the fewest moves, and
+// returns the moves.
+func (manager *FeatureRuleManager) Reorder(ctx context.Context, names []string) ([]FeatureRuleMove, error) {
+	rules, err := manager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target := make([]string, len(names))
+	for i, name := range names {
+		index, err := manager.find(rules, name)
+		if err != nil {
+			return nil, err
+		}
+		target[i] = *rules[index].RuleID
+	}
+	moves, err := PlanFeatureRuleMoves(featureRuleIDs(rules), target)
+	if err != nil {
+		return nil, err
+	}
+	for i, move := range moves {
+		if err = manager.move(ctx, move); err != nil {
+			return moves[:i], err
+		}
+	}
+	return moves, nil
+}
+
+// ReplaceAll makes rules the rules of the feature flag, in this order. Existing rules are matched by name, and updated
+// when they differ; the other rules are created, and the rules that are not in rules are deleted. The environment and
+// feature flag of the options are set by the manager, and the rule ID of a matched rule is kept.
+func (manager *FeatureRuleManager) ReplaceAll(ctx context.Context, rules []*CreateFeatureRuleOptions) error {
+	current, err := manager.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(rules))
+	seen := map[string]bool{}
+	for i, rule := range rules {
+		err = core.ValidateNotNil(rule, "rules cannot contain nil")
+		if err != nil {
+			return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		}
+		names[i] = core.StringNilMapper(rule.RuleName)
+		if names[i] == "" {
+			names[i] = core.StringNilMapper(rule.RuleID)
+		}
+		if seen[names[i]] {
+			err = fmt.Errorf("more than one rule is named '%s'", names[i])
+			return core.SDKErrorf(err, "", "ambiguous-rule-name-error", common.GetComponentInfo())
+		}
+		seen[names[i]] = true
+	}
+
+	matched := map[int]bool{}
+	for i, rule := range rules {
+		index, err := manager.find(current, names[i])
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		if err != nil {
+			created := *rule
+			created.EnvironmentID, created.FeatureID = &manager.environmentID, &manager.featureID
+			if _, _, err = manager.client.CreateFeatureRuleWithContext(ctx, &created); err != nil {
+				return err
+			}
+			continue
+		}
+		matched[index] = true
+		existing := current[index]
+		updated := &UpdateFeatureRuleOptions{
+			EnvironmentID:        &manager.environmentID,
+			FeatureID:            &manager.featureID,
+			RuleID:               existing.RuleID,
+			Rules:                rule.Rules,
+			Value:                rule.Value,
+			RuleName:             rule.RuleName,
+			RolloutPercentage:    rule.RolloutPercentage,
+			RolloutType:          rule.RolloutType,
+			RolloutConfiguration: rule.RolloutConfiguration,
+		}
+		unchanged := !jsonDiffers(
+			[]interface{}{existing.Rules, existing.Value, existing.RuleName, existing.RolloutPercentage, existing.RolloutType, existing.RolloutConfiguration},
+			[]interface{}{updated.Rules, updated.Value, updated.RuleName, updated.RolloutPercentage, updated.RolloutType, updated.RolloutConfiguration})
+		if unchanged {
+			continue
+		}
+		if _, _, err = manager.client.UpdateFeatureRuleWithContext(ctx, updated); err != nil {
+			return err
+		}
+	}
+	for index, rule := range current {
+		if matched[index] {
+			continue
+		}
+		_, _, err = manager.client.DeleteFeatureRuleWithContext(ctx, &DeleteFeatureRuleOptions{
+			EnvironmentID: &manager.environmentID,
+			FeatureID:     &manager.featureID,
+			RuleID:        rule.RuleID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = manager.Reorder(ctx, names)
+	return err
+}
+
+func (manager *FeatureRuleManager) move(ctx context.Context, move FeatureRuleMove) error {
+	_, _, err := manager.client.UpdateFeatureRuleOrderWithContext(ctx, &UpdateFeatureRuleOrderOptions{
+		EnvironmentID: &manager.environmentID,
+		FeatureID:     &manager.featureID,
+		UpdateFeatureRuleOrder: &ReorderFeatureRulesReoderFeatureRulesByMove{
+			Action: core.StringPtr(ReorderFeatureRules_Action_Move),
+			RuleID: core.StringPtr(move.RuleID),
+			Order:  core.Int64Ptr(move.Order),
+		},
+	})
+	return err
+}
+
+// find returns the index of the rule named name. A rule without a name is found by its ID.
+func (manager *FeatureRuleManager) find(rules []FeatureSegmentRuleWithRuleID, name string) (int, error) {
+	found := -1
+	for i, rule := range rules {
+		ruleName := core.StringNilMapper(rule.RuleName)
+		if ruleName == "" {
+			ruleName = core.StringNilMapper(rule.RuleID)
+		}
+		if ruleName != name {
+			continue
+		}
+		if found >= 0 {
+			err := fmt.Errorf("more than one rule of feature '%s' is named '%s'", manager.featureID, name)
+			return -1, core.SDKErrorf(err, "", "ambiguous-rule-name-error", common.GetComponentInfo())
+		}
+		found = i
+	}
+	if found < 0 {
+		err := fmt.Errorf("%w: feature '%s' has no rule named '%s'", ErrNotFound, manager.featureID, name)
+		return -1, core.SDKErrorf(err, "", "rule-not-found-error", common.GetComponentInfo())
+	}
+	return found, nil
+}
+
+// PlanFeatureRuleMoves returns the fewest moves that turn the order of rule IDs current into target. The rules that
+// keep their place form a longest increasing subsequence of the target positions, and every other rule is moved, in
+// target order, right after the rule that precedes it in target.
+func PlanFeatureRuleMoves(current []string, target []string) ([]FeatureRuleMove, error) {
+	positions := make(map[string]int, len(target))
+	for i, ruleID := range target {
+		positions[ruleID] = i
+	}
+	if len(current) != len(target) || len(positions) != len(target) {
+		err := fmt.Errorf("the target order must list each of the %d rules once", len(current))
+		return nil, core.SDKErrorf(err, "", "invalid-rule-order-error", common.GetComponentInfo())
+	}
+	sequence := make([]int, len(current))
+	for i, ruleID := range current {
+		position, ok := positions[ruleID]
+		if !ok {
+			err := fmt.Errorf("the target order does not list rule '%s'", ruleID)
+			return nil, core.SDKErrorf(err, "", "invalid-rule-order-error", common.GetComponentInfo())
+		}
+		sequence[i] = position
+	}
+
+	stays := map[int]bool{}
+	for _, position := range longestIncreasingSubsequence(sequence) {
+		stays[position] = true
+	}
+	order := append([]string{}, current...)
+	moves := []FeatureRuleMove{}
+	for position, ruleID := range target {
+		if stays[position] {
+			continue
+		}
+		from := indexOf(order, ruleID)
+		order = append(order[:from:from], order[from+1:]...)
+		to := 0
+		if position > 0 {
+			to = indexOf(order, target[position-1]) + 1
+		}
+		order = append(order[:to:to], append([]string{ruleID}, order[to:]...)...)
+		moves = append(moves, FeatureRuleMove{RuleID: ruleID, Order: int64(to) + 1})
+	}
+	return moves, nil
+}
+
+// longestIncreasingSubsequence returns the values of a longest strictly increasing subsequence of sequence.
+func longestIncreasingSubsequence(sequence []int) []int {
+	// tails[k] is the index of the smallest last value of an increasing subsequence of length k+1.
+	tails := []int{}
+	previous := make([]int, len(sequence))
+	for i, value := range sequence {
+		k := sort.Search(len(tails), func(k int) bool { return sequence[tails[k]] >= value })
+		previous[i] = -1
+		if k > 0 {
+			previous[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	values := make([]int, len(tails))
+	for k, i := len(tails)-1, -1; k >= 0; k-- {
+		if i < 0 {
+			i = tails[len(tails)-1]
+		} else {
+			i = previous[i]
+		}
+		values[k] = sequence[i]
+	}
+	return values
+}
+
+func featureRuleIDs(rules []FeatureSegmentRuleWithRuleID) []string {
+	ruleIDs := make([]string, len(rules))
+	for i, rule := range rules {
+		ruleIDs[i] = core.StringNilMapper(rule.RuleID)
+	}
+	return ruleIDs
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,254 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// applyFeatureRuleMove moves ruleID to the 1-based order, as the service does.
+func applyFeatureRuleMove(ruleIDs []string, ruleID string, order int64) []string {
+	moved := []string{}
+	for _, id := range ruleIDs {
+		if id != ruleID {
+			moved = append(moved, id)
+		}
+	}
+	return append(moved[:order-1:order-1], append([]string{ruleID}, moved[order-1:]...)...)
+}
+
+var _ = Describe(`AppConfigurationV1 feature rule manager`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+	var rules []map[string]interface{}
+	var writes []string
+
+	ruleNames := func() []string {
+		names := []string{}
+		for _, rule := range rules {
+			names = append(names, rule["rule_name"].(string))
+		}
+		return names
+	}
+
+	// The fake service keeps the rules of the feature flag dev/checkout in order, and applies moves and swaps.
+	BeforeEach(func() {
+		writes = nil
+		rules = nil
+		for _, name := range []string{"a", "b", "c", "d"} {
+			rules = append(rules, map[string]interface{}{"rule_id": "id-" + name, "rule_name": name, "rules": []interface{}{}, "value": true})
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			body := map[string]interface{}{}
+			if content, _ := io.ReadAll(req.Body); len(content) > 0 {
+				Expect(json.Unmarshal(content, &body)).To(Succeed())
+			}
+			if req.Method != http.MethodGet {
+				writes = append(writes, req.Method+" "+strings.TrimPrefix(req.URL.Path, "/environments/dev/features/checkout"))
+			}
+			ruleIDs := []string{}
+			for _, rule := range rules {
+				ruleIDs = append(ruleIDs, rule["rule_id"].(string))
+			}
+			byID := func(id string) map[string]interface{} {
+				for _, rule := range rules {
+					if rule["rule_id"] == id {
+						return rule
+					}
+				}
+				return nil
+			}
+			reorder := func(order []string) {
+				reordered := []map[string]interface{}{}
+				for _, id := range order {
+					reordered = append(reordered, byID(id))
+				}
+				rules = reordered
+			}
+
+			switch {
+			case req.Method == http.MethodGet:
+				for i, rule := range rules {
+					rule["order"] = i + 1
+				}
+				json.NewEncoder(res).Encode(map[string]interface{}{"segment_rules": rules})
+				return
+			case req.Method == http.MethodPost:
+				rules = append(rules, body)
+			case req.URL.Path == "/environments/dev/features/checkout/rules_order" && body["action"] == "move":
+				reorder(applyFeatureRuleMove(ruleIDs, body["rule_id"].(string), int64(body["order"].(float64))))
+				json.NewEncoder(res).Encode("Rules reordered")
+				return
+			case req.URL.Path == "/environments/dev/features/checkout/rules_order":
+				source, target := body["source_rule_id"].(string), body["target_rule_id"].(string)
+				for i, id := range ruleIDs {
+					if id == source {
+						ruleIDs[i] = target
+					} else if id == target {
+						ruleIDs[i] = source
+					}
+				}
+				reorder(ruleIDs)
+				json.NewEncoder(res).Encode("Rules reordered")
+				return
+			case req.Method == http.MethodDelete:
+				id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+				reorder(applyFeatureRuleMove(ruleIDs, id, int64(len(ruleIDs)))[:len(ruleIDs)-1])
+				res.WriteHeader(204)
+				return
+			default:
+				rule := byID(req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])
+				for key, value := range body {
+					rule[key] = value
+				}
+				body = rule
+			}
+			json.NewEncoder(res).Encode(body)
+		}))
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newRule := func(name string) *appconfigurationv1.CreateFeatureRuleOptions {
+		return &appconfigurationv1.CreateFeatureRuleOptions{
+			Rules:    []appconfigurationv1.TargetSegments{{Segments: []string{"beta"}}},
+			Value:    true,
+			RuleID:   core.StringPtr("id-" + name),
+			RuleName: core.StringPtr(name),
+		}
+	}
+
+	It(`Inserts, moves and swaps rules by name`, func() {
+		manager := appConfigurationService.NewFeatureRuleManager("dev", "checkout")
+		ctx := context.Background()
+
+		inserted, err := manager.InsertBefore(ctx, "b", newRule("x"))
+		Expect(err).To(BeNil())
+		Expect(*inserted.Order).To(Equal(int64(2)))
+		Expect(ruleNames()).To(Equal([]string{"a", "x", "b", "c", "d"}))
+
+		_, err = manager.InsertAfter(ctx, "d", newRule("y"))
+		Expect(err).To(BeNil())
+		Expect(ruleNames()).To(Equal([]string{"a", "x", "b", "c", "d", "y"}))
+
+		Expect(manager.MoveToTop(ctx, "c")).To(Succeed())
+		Expect(manager.Swap(ctx, "a", "y")).To(Succeed())
+		Expect(ruleNames()).To(Equal([]string{"c", "y", "x", "b", "d", "a"}))
+		Expect(writes).To(Equal([]string{
+			"POST /rules", "PATCH /rules_order",
+			"POST /rules",
+			"PATCH /rules_order",
+			"PATCH /rules_order",
+		}))
+
+		_, err = manager.Get(ctx, "missing")
+		Expect(errors.Is(err, appconfigurationv1.ErrNotFound)).To(BeTrue())
+	})
+
+	It(`Reorders rules with the fewest moves`, func() {
+		manager := appConfigurationService.NewFeatureRuleManager("dev", "checkout")
+		moves, err := manager.Reorder(context.Background(), []string{"b", "c", "d", "a"})
+		Expect(err).To(BeNil())
+		Expect(moves).To(Equal([]appconfigurationv1.FeatureRuleMove{{RuleID: "id-a", Order: 4}}))
+		Expect(ruleNames()).To(Equal([]string{"b", "c", "d", "a"}))
+
+		_, err = manager.Reorder(context.Background(), []string{"b", "c"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the target order must list each of the 4 rules once"))
+	})
+
+	It(`Replaces all rules in order`, func() {
+		manager := appConfigurationService.NewFeatureRuleManager("dev", "checkout")
+		changed := newRule("c")
+		changed.Value = false
+		unchanged := newRule("a")
+		unchanged.Rules = []appconfigurationv1.TargetSegments{}
+		Expect(manager.ReplaceAll(context.Background(), []*appconfigurationv1.CreateFeatureRuleOptions{changed, newRule("x"), unchanged})).To(Succeed())
+		Expect(ruleNames()).To(Equal([]string{"c", "x", "a"}))
+		Expect(rules[0]["value"]).To(Equal(false))
+		Expect(writes).To(Equal([]string{
+			"PATCH /rules/id-c",
+			"POST /rules",
+			"DELETE /rules/id-b",
+			"DELETE /rules/id-d",
+			"PATCH /rules_order",
+		}))
+	})
+
+	It(`Plans the fewest moves for any order`, func() {
+		random := rand.New(rand.NewSource(7))
+		for trial := 0; trial < 200; trial++ {
+			n := random.Intn(9)
+			current := make([]string, n)
+			for i := range current {
+				current[i] = string(rune('a' + i))
+			}
+			target := append([]string{}, current...)
+			random.Shuffle(n, func(i, j int) { target[i], target[j] = target[j], target[i] })
+
+			moves, err := appconfigurationv1.PlanFeatureRuleMoves(current, target)
+			Expect(err).To(BeNil())
+			order := append([]string{}, current...)
+			for _, move := range moves {
+				order = applyFeatureRuleMove(order, move.RuleID, move.Order)
+			}
+			Expect(order).To(Equal(target))
+
+			// The rules that do not move are a longest increasing subsequence of the target positions.
+			positions := map[string]int{}
+			for i, id := range target {
+				positions[id] = i
+			}
+			longest := make([]int, n)
+			best := 0
+			for i := range current {
+				longest[i] = 1
+				for j := 0; j < i; j++ {
+					if positions[current[j]] < positions[current[i]] && longest[j]+1 > longest[i] {
+						longest[i] = longest[j] + 1
+					}
+				}
+				if longest[i] > best {
+					best = longest[i]
+				}
+			}
+			Expect(moves).To(HaveLen(n - best))
+		}
+	})
+})
@@ -163,6 +163,100 @@ var _ = Describe(`AppConfigurationV1`, func() {
 			Expect(url).To(BeEmpty())
 			Expect(err).ToNot(BeNil())
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+
+			url, err = appconfigurationv1.GetServiceURLForRegion("eu-de")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://eu-de.apprapp.cloud.ibm.com/apprapp/feature/v1/instances"))
+
+			url, err = appconfigurationv1.GetServiceURLForRegion("private.eu-de")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://private.eu-de.apprapp.cloud.ibm.com/apprapp/feature/v1/instances"))
+		})
+		It(`GetServiceURLForInstance(region string, guid string)`, func() {
+			guid := "36401ffc-6280-459a-ba98-456aba10d0c7"
+			for _, region := range appconfigurationv1.SupportedRegions() {
+				url, err := appconfigurationv1.GetServiceURLForInstance(region, guid)
+				Expect(err).To(BeNil())
+				Expect(url).To(Equal("https://" + region + ".apprapp.cloud.ibm.com/apprapp/feature/v1/instances/" + guid))
+
+				url, err = appconfigurationv1.GetServiceURLForInstance("private."+region, guid)
+				Expect(err).To(BeNil())
+				Expect(url).To(Equal("https://private." + region + ".apprapp.cloud.ibm.com/apprapp/feature/v1/instances/" + guid))
+			}
+
+			url, err := appconfigurationv1.GetServiceURLForInstance("mars-north", guid)
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+
+			url, err = appconfigurationv1.GetServiceURLForInstance("us-south", "not-a-guid")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`Service constructor tests using regional external config`, func() {
+		It(`Create service client from region and GUID`, func() {
+			var testEnvironment = map[string]string{
+				"APP_CONFIGURATION_REGION": "eu-gb",
+				"APP_CONFIGURATION_GUID": "36401ffc-6280-459a-ba98-456aba10d0c7",
+				"APP_CONFIGURATION_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			appConfigurationService, serviceErr := appconfigurationv1.NewAppConfigurationV1UsingExternalConfig(&appconfigurationv1.AppConfigurationV1Options{
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).To(BeNil())
+			Expect(appConfigurationService.GetServiceURL()).To(Equal("https://eu-gb.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7"))
+		})
+		It(`Create service client from region and GUID using the private endpoint`, func() {
+			var testEnvironment = map[string]string{
+				"APP_CONFIGURATION_REGION": "us-east",
+				"APP_CONFIGURATION_GUID": "36401ffc-6280-459a-ba98-456aba10d0c7",
+				"APP_CONFIGURATION_ENDPOINT_TYPE": "private",
+				"APP_CONFIGURATION_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			appConfigurationService, serviceErr := appconfigurationv1.NewAppConfigurationV1UsingExternalConfig(&appconfigurationv1.AppConfigurationV1Options{
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).To(BeNil())
+			Expect(appConfigurationService.GetServiceURL()).To(Equal("https://private.us-east.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7"))
+		})
+		It(`Prefer the URL from external config over region and GUID`, func() {
+			var testEnvironment = map[string]string{
+				"APP_CONFIGURATION_URL": "https://appconfigurationv1/api",
+				"APP_CONFIGURATION_REGION": "us-east",
+				"APP_CONFIGURATION_GUID": "36401ffc-6280-459a-ba98-456aba10d0c7",
+				"APP_CONFIGURATION_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			appConfigurationService, serviceErr := appconfigurationv1.NewAppConfigurationV1UsingExternalConfig(&appconfigurationv1.AppConfigurationV1Options{
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).To(BeNil())
+			Expect(appConfigurationService.GetServiceURL()).To(Equal("https://appconfigurationv1/api"))
+		})
+		It(`Instantiate service client with error: Invalid region`, func() {
+			var testEnvironment = map[string]string{
+				"APP_CONFIGURATION_REGION": "mars-north",
+				"APP_CONFIGURATION_GUID": "36401ffc-6280-459a-ba98-456aba10d0c7",
+				"APP_CONFIGURATION_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			_, serviceErr := appconfigurationv1.NewAppConfigurationV1UsingExternalConfig(&appconfigurationv1.AppConfigurationV1Options{
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).ToNot(BeNil())
+		})
+		It(`Instantiate service client with error: Missing GUID`, func() {
+			var testEnvironment = map[string]string{
+				"APP_CONFIGURATION_REGION": "us-south",
+				"APP_CONFIGURATION_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			_, serviceErr := appconfigurationv1.NewAppConfigurationV1UsingExternalConfig(&appconfigurationv1.AppConfigurationV1Options{
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).ToNot(BeNil())
 		})
 	})
 	Describe(`Parameterized URL tests`, func() {
@@ -180,6 +274,42 @@ var _ = Describe(`AppConfigurationV1`, func() {
 			Expect(constructedURL).To(Equal(""))
 			Expect(err).ToNot(BeNil())
 		})
+		It(`Format parameterized URL with provided values`, func() {
+			var providedUrlVariables = map[string]string{
+				"region": "jp-tok",
+				"guid": "36401ffc-6280-459a-ba98-456aba10d0c7",
+			}
+			constructedURL, err := appconfigurationv1.ConstructServiceURL(providedUrlVariables)
+			Expect(err).To(BeNil())
+			Expect(constructedURL).To(Equal("https://jp-tok.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7"))
+
+			constructedURL, err = appconfigurationv1.ConstructPrivateServiceURL(providedUrlVariables)
+			Expect(err).To(BeNil())
+			Expect(constructedURL).To(Equal("https://private.jp-tok.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7"))
+		})
+		It(`Format parameterized URL with a region that is not in the supported list`, func() {
+			constructedURL, err := appconfigurationv1.ConstructServiceURL(map[string]string{"region": "staging.us-south", "guid": "test-instance"})
+			Expect(err).To(BeNil())
+			Expect(constructedURL).To(Equal("https://staging.us-south.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/test-instance"))
+		})
+		It(`Format validated parameterized URL with provided values`, func() {
+			constructedURL, err := appconfigurationv1.ConstructValidatedServiceURL(map[string]string{"region": "eu-es", "guid": "36401ffc-6280-459a-ba98-456aba10d0c7"})
+			Expect(err).To(BeNil())
+			Expect(constructedURL).To(Equal("https://eu-es.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7"))
+
+			constructedURL, err = appconfigurationv1.ConstructValidatedServiceURL(map[string]string{"region": "private.eu-es", "guid": "36401ffc-6280-459a-ba98-456aba10d0c7"})
+			Expect(err).To(BeNil())
+			Expect(constructedURL).To(Equal("https://private.eu-es.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7"))
+		})
+		It(`Return an error if a provided region or guid is invalid`, func() {
+			constructedURL, err := appconfigurationv1.ConstructValidatedServiceURL(map[string]string{"region": "INVALID_REGION"})
+			Expect(constructedURL).To(Equal(""))
+			Expect(err).ToNot(BeNil())
+
+			constructedURL, err = appconfigurationv1.ConstructValidatedServiceURL(map[string]string{"guid": "INVALID_GUID"})
+			Expect(constructedURL).To(Equal(""))
+			Expect(err).ToNot(BeNil())
+		})
 	})
 	Describe(`ListEnvironments(listEnvironmentsOptions *ListEnvironmentsOptions) - Operation response error`, func() {
 		listEnvironmentsPath := "/environments"
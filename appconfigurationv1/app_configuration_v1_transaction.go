@@ -0,0 +1,445 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"context"
+	"fmt"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TransactionCompensation : Undoes a step of a Transaction that was applied.
+type TransactionCompensation func(ctx context.Context, client *AppConfigurationV1) error
+
+// TransactionStep : A single step of a Transaction.
+type TransactionStep struct {
+	// Name identifies the step in the transaction result, for example "create-segment beta".
+	Name string
+
+	// Invoke applies the step with the supplied client. Once it succeeds, it returns the compensation that undoes it,
+	// or nil if there is nothing to undo. The result is the model returned by the underlying service method.
+	Invoke func(ctx context.Context, client *AppConfigurationV1) (result interface{}, compensation TransactionCompensation, response *core.DetailedResponse, err error)
+}
+
+// Transaction : A list of steps that are applied in order, and undone in reverse order if one of them fails.
+// Steps are added with Add or with the methods named after the service operations, and run by Execute. Undoing
+// a step restores what it changed, but not changes made concurrently by other clients.
+type Transaction struct {
+	client *AppConfigurationV1
+	steps  []TransactionStep
+}
+
+// TransactionResult : The outcome of a Transaction.
+type TransactionResult struct {
+	// The final state of the transaction, one of the TransactionResult_State_* constants.
+	State string
+
+	// The outcome of each step, in the order of the steps.
+	Steps []TransactionStepResult
+
+	// The step that failed, or nil if the transaction was committed.
+	FailedStep *TransactionStepResult
+
+	// The errors of the compensations that failed, in the order they were run.
+	CompensationErrors []error
+}
+
+// Constants associated with the TransactionResult.State property.
+const (
+	// Every step was applied.
+	TransactionResult_State_Committed = "committed"
+	// A step failed and the steps applied before it were undone.
+	TransactionResult_State_RolledBack = "rolled_back"
+	// A step failed and some of the steps applied before it could not be undone.
+	TransactionResult_State_RollbackFailed = "rollback_failed"
+)
+
+// TransactionStepResult : The outcome of a single step of a Transaction.
+type TransactionStepResult struct {
+	// Position of the step in the transaction.
+	Index int
+
+	// Name of the step.
+	Name string
+
+	// The status of the step, one of the TransactionStepResult_Status_* constants.
+	Status string
+
+	// The model returned by the service method, if any.
+	Result interface{}
+
+	// The detailed response returned by the service method, if any.
+	Response *core.DetailedResponse
+
+	// Set when the change was not applied directly because a workflow approval was initiated for it. Such a step is
+	// not undone.
+	WorkflowApproval *WorkflowApprovalInitiatedResponse
+
+	// The error returned by the step, if it failed.
+	Err error
+
+	// The error returned by the compensation of the step, if it failed.
+	CompensationErr error
+}
+
+// Constants associated with the TransactionStepResult.Status property.
+const (
+	TransactionStepResult_Status_Applied            = "applied"
+	TransactionStepResult_Status_Compensated        = "compensated"
+	TransactionStepResult_Status_CompensationFailed = "compensation_failed"
+	TransactionStepResult_Status_Failed             = "failed"
+	TransactionStepResult_Status_Skipped            = "skipped"
+)
+
+// NewTransaction : Instantiate an empty Transaction that runs its steps through this client
+func (appConfiguration *AppConfigurationV1) NewTransaction() *Transaction {
+	return &Transaction{client: appConfiguration}
+}
+
+// Add : Add a step to the Transaction
+func (transaction *Transaction) Add(step TransactionStep) *Transaction {
+	transaction.steps = append(transaction.steps, step)
+	return transaction
+}
+
+// Execute : Apply the steps of the Transaction, or undo them if one fails
+// Apply the steps in order. If a step fails, the later steps are skipped and the compensations of the applied steps
+// are run in reverse order; compensations run even if the context was cancelled. The returned error is nil if every
+// step was applied, and otherwise wraps the error of the failed step; the result reports the outcome of every step
+// and compensation.
+func (transaction *Transaction) Execute() (result *TransactionResult, err error) {
+	result, err = transaction.ExecuteWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ExecuteWithContext is an alternate form of the Execute method which supports a Context parameter
+func (transaction *Transaction) ExecuteWithContext(ctx context.Context) (result *TransactionResult, err error) {
+	for i, step := range transaction.steps {
+		if step.Invoke == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("transaction step %d (%s) has no Invoke function", i, step.Name), "transaction-step-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	result = &TransactionResult{Steps: make([]TransactionStepResult, len(transaction.steps))}
+	compensations := make([]TransactionCompensation, len(transaction.steps))
+	for i, step := range transaction.steps {
+		outcome := &result.Steps[i]
+		outcome.Index = i
+		outcome.Name = step.Name
+		if result.FailedStep != nil {
+			outcome.Status = TransactionStepResult_Status_Skipped
+			continue
+		}
+
+		var compensation TransactionCompensation
+		outcome.Result, compensation, outcome.Response, outcome.Err = step.Invoke(ctx, transaction.client)
+		if outcome.Err != nil {
+			outcome.Status = TransactionStepResult_Status_Failed
+			result.FailedStep = outcome
+			continue
+		}
+		outcome.Status = TransactionStepResult_Status_Applied
+		outcome.WorkflowApproval = workflowApprovalFromResult(outcome.Result, outcome.Response)
+		if outcome.WorkflowApproval == nil {
+			compensations[i] = compensation
+		}
+	}
+	if result.FailedStep == nil {
+		result.State = TransactionResult_State_Committed
+		return
+	}
+
+	compensationCtx := context.WithoutCancel(ctx)
+	for i := result.FailedStep.Index - 1; i >= 0; i-- {
+		if compensations[i] == nil {
+			continue
+		}
+		outcome := &result.Steps[i]
+		if outcome.CompensationErr = compensations[i](compensationCtx, transaction.client); outcome.CompensationErr != nil {
+			outcome.Status = TransactionStepResult_Status_CompensationFailed
+			result.CompensationErrors = append(result.CompensationErrors, outcome.CompensationErr)
+		} else {
+			outcome.Status = TransactionStepResult_Status_Compensated
+		}
+	}
+
+	if len(result.CompensationErrors) > 0 {
+		result.State = TransactionResult_State_RollbackFailed
+		err = core.SDKErrorf(result.FailedStep.Err, fmt.Sprintf("transaction step '%s' failed and %d of the applied steps could not be undone", result.FailedStep.Name, len(result.CompensationErrors)), "transaction-rollback-failed", common.GetComponentInfo())
+		return
+	}
+	result.State = TransactionResult_State_RolledBack
+	err = core.SDKErrorf(result.FailedStep.Err, fmt.Sprintf("transaction step '%s' failed and the applied steps were undone", result.FailedStep.Name), "transaction-rolled-back", common.GetComponentInfo())
+	return
+}
+
+// CreateCollection : Add a step that creates a collection, and deletes it when undone
+func (transaction *Transaction) CreateCollection(options *CreateCollectionOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("create-collection", "createCollectionOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("create-collection %s", core.StringNilMapper(options.CollectionID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			result, response, err := client.CreateCollectionWithContext(ctx, options)
+			return result, func(ctx context.Context, client *AppConfigurationV1) error {
+				_, _, err := client.DeleteCollectionWithContext(ctx, &DeleteCollectionOptions{CollectionID: options.CollectionID, Headers: options.Headers})
+				return err
+			}, response, err
+		},
+	})
+}
+
+// CreateSegment : Add a step that creates a segment, and deletes it when undone
+func (transaction *Transaction) CreateSegment(options *CreateSegmentOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("create-segment", "createSegmentOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("create-segment %s", core.StringNilMapper(options.SegmentID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			result, response, err := client.CreateSegmentWithContext(ctx, options)
+			return result, func(ctx context.Context, client *AppConfigurationV1) error {
+				_, _, err := client.DeleteSegmentWithContext(ctx, &DeleteSegmentOptions{SegmentID: options.SegmentID, Headers: options.Headers})
+				return err
+			}, response, err
+		},
+	})
+}
+
+// CreateFeature : Add a step that creates a feature flag, and deletes it when undone
+func (transaction *Transaction) CreateFeature(options *CreateFeatureOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("create-feature", "createFeatureOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("create-feature %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			result, response, err := client.CreateFeatureWithContext(ctx, options)
+			return result, func(ctx context.Context, client *AppConfigurationV1) error {
+				_, _, err := client.DeleteFeatureWithContext(ctx, &DeleteFeatureOptions{EnvironmentID: options.EnvironmentID, FeatureID: options.FeatureID, Headers: options.Headers})
+				return err
+			}, response, err
+		},
+	})
+}
+
+// CreateProperty : Add a step that creates a property, and deletes it when undone
+func (transaction *Transaction) CreateProperty(options *CreatePropertyOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("create-property", "createPropertyOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("create-property %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.PropertyID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			result, response, err := client.CreatePropertyWithContext(ctx, options)
+			return result, func(ctx context.Context, client *AppConfigurationV1) error {
+				_, _, err := client.DeletePropertyWithContext(ctx, &DeletePropertyOptions{EnvironmentID: options.EnvironmentID, PropertyID: options.PropertyID, Headers: options.Headers})
+				return err
+			}, response, err
+		},
+	})
+}
+
+// CreateFeatureRule : Add a step that creates a targeting rule of a feature flag, and deletes it when undone
+func (transaction *Transaction) CreateFeatureRule(options *CreateFeatureRuleOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("create-feature-rule", "createFeatureRuleOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("create-feature-rule %s/%s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID), core.StringNilMapper(options.RuleID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			result, response, err := client.CreateFeatureRuleWithContext(ctx, options)
+			ruleID := options.RuleID
+			if result != nil && result.RuleID != nil {
+				ruleID = result.RuleID
+			}
+			return result, func(ctx context.Context, client *AppConfigurationV1) error {
+				_, _, err := client.DeleteFeatureRuleWithContext(ctx, &DeleteFeatureRuleOptions{EnvironmentID: options.EnvironmentID, FeatureID: options.FeatureID, RuleID: ruleID, Headers: options.Headers})
+				return err
+			}, response, err
+		},
+	})
+}
+
+// UpdateFeature : Add a step that updates a feature flag, and restores its previous state when undone
+func (transaction *Transaction) UpdateFeature(options *UpdateFeatureOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("update-feature", "updateFeatureOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("update-feature %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: featureUpdateInvoke(options.EnvironmentID, options.FeatureID, options.Headers, func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateFeatureWithContext(ctx, options)
+		}),
+	})
+}
+
+// UpdateFeatureValues : Add a step that updates the values of a feature flag, and restores its previous state when undone
+func (transaction *Transaction) UpdateFeatureValues(options *UpdateFeatureValuesOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("update-feature-values", "updateFeatureValuesOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("update-feature-values %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: featureUpdateInvoke(options.EnvironmentID, options.FeatureID, options.Headers, func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateFeatureValuesWithContext(ctx, options)
+		}),
+	})
+}
+
+// ToggleFeature : Add a step that toggles a feature flag, and toggles it back when undone
+func (transaction *Transaction) ToggleFeature(options *ToggleFeatureOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("toggle-feature", "toggleFeatureOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("toggle-feature %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.FeatureID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			prior, response, err := client.GetFeatureWithContext(ctx, &GetFeatureOptions{EnvironmentID: options.EnvironmentID, FeatureID: options.FeatureID, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, response, err
+			}
+			result, response, err := client.ToggleFeatureWithContext(ctx, options)
+			return result, func(ctx context.Context, client *AppConfigurationV1) error {
+				_, _, err := client.ToggleFeatureWithContext(ctx, &ToggleFeatureOptions{
+					EnvironmentID: options.EnvironmentID,
+					FeatureID:     options.FeatureID,
+					Enabled:       core.BoolPtr(prior.Enabled != nil && *prior.Enabled),
+					Headers:       options.Headers,
+				})
+				return err
+			}, response, err
+		},
+	})
+}
+
+// UpdateProperty : Add a step that updates a property, and restores its previous state when undone
+func (transaction *Transaction) UpdateProperty(options *UpdatePropertyOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("update-property", "updatePropertyOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("update-property %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.PropertyID)),
+		Invoke: propertyUpdateInvoke(options.EnvironmentID, options.PropertyID, options.Headers, func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdatePropertyWithContext(ctx, options)
+		}),
+	})
+}
+
+// UpdatePropertyValues : Add a step that updates the values of a property, and restores its previous state when undone
+func (transaction *Transaction) UpdatePropertyValues(options *UpdatePropertyValuesOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("update-property-values", "updatePropertyValuesOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("update-property-values %s/%s", core.StringNilMapper(options.EnvironmentID), core.StringNilMapper(options.PropertyID)),
+		Invoke: propertyUpdateInvoke(options.EnvironmentID, options.PropertyID, options.Headers, func(ctx context.Context, client *AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdatePropertyValuesWithContext(ctx, options)
+		}),
+	})
+}
+
+// UpdateSegment : Add a step that updates a segment, and restores its previous state when undone
+func (transaction *Transaction) UpdateSegment(options *UpdateSegmentOptions) *Transaction {
+	if options == nil {
+		return transaction.Add(nilOptionsStep("update-segment", "updateSegmentOptions"))
+	}
+	return transaction.Add(TransactionStep{
+		Name: fmt.Sprintf("update-segment %s", core.StringNilMapper(options.SegmentID)),
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			prior, response, err := client.GetSegmentWithContext(ctx, &GetSegmentOptions{SegmentID: options.SegmentID, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, response, err
+			}
+			result, response, err := client.UpdateSegmentWithContext(ctx, options)
+			return result, func(ctx context.Context, client *AppConfigurationV1) error {
+				_, _, err := client.MutateSegment(ctx, *options.SegmentID, func(segment *Segment) error {
+					segment.Name, segment.Description, segment.Tags, segment.Rules = prior.Name, prior.Description, prior.Tags, prior.Rules
+					return nil
+				})
+				return err
+			}, response, err
+		},
+	})
+}
+
+// nilOptionsStep returns a step that fails like the service method does when it is called with nil options.
+func nilOptionsStep(name string, optionsName string) TransactionStep {
+	return TransactionStep{
+		Name: name,
+		Invoke: func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+			return nil, nil, nil, core.SDKErrorf(nil, fmt.Sprintf("%s cannot be nil", optionsName), "unexpected-nil-param", common.GetComponentInfo())
+		},
+	}
+}
+
+// featureUpdateInvoke returns the Invoke function of a step that reads a feature flag, then changes it with update,
+// and whose compensation restores what was read.
+func featureUpdateInvoke(environmentID *string, featureID *string, headers map[string]string, update func(context.Context, *AppConfigurationV1) (interface{}, *core.DetailedResponse, error)) func(context.Context, *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+	return func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+		prior, response, err := client.GetFeatureWithContext(ctx, &GetFeatureOptions{
+			EnvironmentID: environmentID,
+			FeatureID:     featureID,
+			Include:       []string{GetFeatureOptions_Include_Collections, GetFeatureOptions_Include_Rules},
+			Headers:       headers,
+		})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		result, response, err := update(ctx, client)
+		return result, func(ctx context.Context, client *AppConfigurationV1) error {
+			_, _, err := client.MutateFeature(ctx, *environmentID, *featureID, func(feature *Feature) error {
+				feature.Name, feature.Description, feature.Tags = prior.Name, prior.Description, prior.Tags
+				feature.EnabledValue, feature.DisabledValue, feature.Enabled = prior.EnabledValue, prior.DisabledValue, prior.Enabled
+				feature.RolloutPercentage, feature.RolloutType, feature.RolloutConfiguration = prior.RolloutPercentage, prior.RolloutType, prior.RolloutConfiguration
+				feature.SegmentRules, feature.Collections = prior.SegmentRules, prior.Collections
+				return nil
+			})
+			return err
+		}, response, err
+	}
+}
+
+// propertyUpdateInvoke returns the Invoke function of a step that reads a property, then changes it with update,
+// and whose compensation restores what was read.
+func propertyUpdateInvoke(environmentID *string, propertyID *string, headers map[string]string, update func(context.Context, *AppConfigurationV1) (interface{}, *core.DetailedResponse, error)) func(context.Context, *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+	return func(ctx context.Context, client *AppConfigurationV1) (interface{}, TransactionCompensation, *core.DetailedResponse, error) {
+		prior, response, err := client.GetPropertyWithContext(ctx, &GetPropertyOptions{
+			EnvironmentID: environmentID,
+			PropertyID:    propertyID,
+			Include:       []string{GetPropertyOptions_Include_Collections, GetPropertyOptions_Include_Rules},
+			Headers:       headers,
+		})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		result, response, err := update(ctx, client)
+		return result, func(ctx context.Context, client *AppConfigurationV1) error {
+			_, _, err := client.MutateProperty(ctx, *environmentID, *propertyID, func(property *Property) error {
+				property.Name, property.Description, property.Tags = prior.Name, prior.Description, prior.Tags
+				property.Value, property.SegmentRules, property.Collections = prior.Value, prior.SegmentRules, prior.Collections
+				return nil
+			})
+			return err
+		}, response, err
+	}
+}
@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 transactions`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+	var requests []string
+	var failures map[string]int
+
+	// The fake service answers reads with a disabled feature flag, echoes writes, and fails the requests in failures.
+	BeforeEach(func() {
+		requests = nil
+		failures = map[string]int{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			request := req.Method + " " + req.URL.Path
+			requests = append(requests, request)
+			res.Header().Set("Content-type", "application/json")
+			if status, ok := failures[request]; ok {
+				res.WriteHeader(status)
+				fmt.Fprint(res, `{"errors": [{"code": "bad_request", "message": "Request failed"}]}`)
+				return
+			}
+			switch req.Method {
+			case "GET":
+				fmt.Fprint(res, `{"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false}`)
+			case "DELETE":
+				res.WriteHeader(204)
+			default:
+				content, _ := io.ReadAll(req.Body)
+				res.WriteHeader(201)
+				res.Write(content)
+			}
+		}))
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	release := func() *appconfigurationv1.Transaction {
+		return appConfigurationService.NewTransaction().
+			CreateSegment(&appconfigurationv1.CreateSegmentOptions{
+				Name:      core.StringPtr("Beta"),
+				SegmentID: core.StringPtr("beta"),
+				Rules:     []appconfigurationv1.Rule{{AttributeName: core.StringPtr("email"), Operator: core.StringPtr("endsWith"), Values: []string{"@ibm.com"}}},
+			}).
+			CreateProperty(&appconfigurationv1.CreatePropertyOptions{
+				EnvironmentID: core.StringPtr("dev"),
+				Name:          core.StringPtr("Checkout URL"),
+				PropertyID:    core.StringPtr("checkout-url"),
+				Type:          core.StringPtr(appconfigurationv1.CreatePropertyOptions_Type_String),
+				Value:         "https://example.com/checkout",
+			}).
+			ToggleFeature(&appconfigurationv1.ToggleFeatureOptions{
+				EnvironmentID: core.StringPtr("dev"),
+				FeatureID:     core.StringPtr("checkout"),
+				Enabled:       core.BoolPtr(true),
+			}).
+			CreateFeatureRule(&appconfigurationv1.CreateFeatureRuleOptions{
+				EnvironmentID: core.StringPtr("dev"),
+				FeatureID:     core.StringPtr("checkout"),
+				Rules:         []appconfigurationv1.TargetSegments{{Segments: []string{"beta"}}},
+				Value:         true,
+				RuleID:        core.StringPtr("beta-rule"),
+			})
+	}
+
+	It(`Commits when every step succeeds`, func() {
+		result, err := release().Execute()
+		Expect(err).To(BeNil())
+		Expect(result.State).To(Equal(appconfigurationv1.TransactionResult_State_Committed))
+		Expect(result.FailedStep).To(BeNil())
+		for _, step := range result.Steps {
+			Expect(step.Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_Applied))
+		}
+		Expect(requests).ToNot(ContainElement(HavePrefix("DELETE")))
+	})
+
+	It(`Undoes the applied steps in reverse order when a step fails`, func() {
+		failures["POST /environments/dev/features/checkout/rules"] = 400
+		result, err := release().Execute()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("transaction step 'create-feature-rule dev/checkout/beta-rule' failed and the applied steps were undone"))
+		Expect(errors.Is(result.FailedStep.Err, appconfigurationv1.ErrValidation)).To(BeTrue())
+		Expect(result.State).To(Equal(appconfigurationv1.TransactionResult_State_RolledBack))
+		Expect(result.FailedStep.Index).To(Equal(3))
+		Expect(requests).To(Equal([]string{
+			"POST /segments",
+			"POST /environments/dev/properties",
+			"GET /environments/dev/features/checkout",
+			"PUT /environments/dev/features/checkout/toggle",
+			"POST /environments/dev/features/checkout/rules",
+			"PUT /environments/dev/features/checkout/toggle",
+			"DELETE /environments/dev/properties/checkout-url",
+			"DELETE /segments/beta",
+		}))
+		Expect(result.Steps[0].Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_Compensated))
+		Expect(result.Steps[3].Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_Failed))
+	})
+
+	It(`Reports the compensations that fail`, func() {
+		failures["PUT /environments/dev/features/checkout/toggle"] = 500
+		failures["DELETE /environments/dev/properties/checkout-url"] = 409
+		transaction := release()
+		transaction.Add(appconfigurationv1.TransactionStep{Name: "never run", Invoke: func(context.Context, *appconfigurationv1.AppConfigurationV1) (interface{}, appconfigurationv1.TransactionCompensation, *core.DetailedResponse, error) {
+			Fail("the step after the failed step was run")
+			return nil, nil, nil, nil
+		}})
+		result, err := transaction.ExecuteWithContext(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(result.State).To(Equal(appconfigurationv1.TransactionResult_State_RollbackFailed))
+		Expect(result.CompensationErrors).To(HaveLen(1))
+		Expect(result.Steps[1].Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_CompensationFailed))
+		Expect(result.Steps[1].CompensationErr).To(Equal(result.CompensationErrors[0]))
+		Expect(result.Steps[0].Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_Compensated))
+		Expect(result.Steps[3].Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_Skipped))
+		Expect(result.Steps[4].Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_Skipped))
+	})
+
+	It(`Fails a step added with nil options instead of panicking`, func() {
+		var transaction *appconfigurationv1.Transaction
+		Expect(func() {
+			transaction = release().UpdateSegment(nil).CreateCollection(nil)
+		}).ToNot(Panic())
+		result, err := transaction.Execute()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("transaction step 'update-segment' failed and the applied steps were undone"))
+		Expect(result.FailedStep.Index).To(Equal(4))
+		Expect(result.FailedStep.Err.Error()).To(ContainSubstring("updateSegmentOptions cannot be nil"))
+		Expect(result.State).To(Equal(appconfigurationv1.TransactionResult_State_RolledBack))
+		Expect(result.Steps[5].Status).To(Equal(appconfigurationv1.TransactionStepResult_Status_Skipped))
+	})
+
+	It(`Restores a feature flag that was updated`, func() {
+		failures["POST /segments"] = 400
+		transaction := appConfigurationService.NewTransaction().
+			UpdateFeatureValues(&appconfigurationv1.UpdateFeatureValuesOptions{
+				EnvironmentID: core.StringPtr("dev"),
+				FeatureID:     core.StringPtr("checkout"),
+				Name:          core.StringPtr("Checkout v2"),
+			}).
+			CreateSegment(&appconfigurationv1.CreateSegmentOptions{
+				Name:      core.StringPtr("Beta"),
+				SegmentID: core.StringPtr("beta"),
+				Rules:     []appconfigurationv1.Rule{{AttributeName: core.StringPtr("email"), Operator: core.StringPtr("endsWith"), Values: []string{"@ibm.com"}}},
+			})
+		_, err := transaction.Execute()
+		Expect(err).ToNot(BeNil())
+		// The fake service still returns the original feature flag, so restoring it writes nothing.
+		Expect(requests).To(Equal([]string{
+			"GET /environments/dev/features/checkout",
+			"PATCH /environments/dev/features/checkout",
+			"POST /segments",
+			"GET /environments/dev/features/checkout",
+		}))
+	})
+})
@@ -0,0 +1,403 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The outcomes of the Upsert* methods.
+const (
+	UpsertOutcome_Created   = "created"
+	UpsertOutcome_Updated   = "updated"
+	UpsertOutcome_Unchanged = "unchanged"
+)
+
+// UpsertEnvironment : Create an environment, or update it if it exists
+// Create the environment and, if it already exists, update it unless it already has the attributes of the options.
+// The outcome is one of the UpsertOutcome_* constants, and the response is that of the last request made.
+func (appConfiguration *AppConfigurationV1) UpsertEnvironment(createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, outcome string, response *core.DetailedResponse, err error) {
+	result, outcome, response, err = appConfiguration.UpsertEnvironmentWithContext(context.Background(), createEnvironmentOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpsertEnvironmentWithContext is an alternate form of the UpsertEnvironment method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpsertEnvironmentWithContext(ctx context.Context, createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, outcome string, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.CreateEnvironmentWithContext(ctx, createEnvironmentOptions)
+	if !isConflict(err) {
+		return result, createdOutcome(err), response, err
+	}
+	existing, response, err := appConfiguration.GetEnvironmentWithContext(ctx, &GetEnvironmentOptions{
+		EnvironmentID: createEnvironmentOptions.EnvironmentID,
+		Headers:       createEnvironmentOptions.Headers,
+	})
+	if err != nil {
+		return nil, "", response, err
+	}
+	if upsertUnchanged(ConfigChange_Kind_Environment, existing, createEnvironmentOptions) {
+		return existing, UpsertOutcome_Unchanged, response, nil
+	}
+	result, response, err = appConfiguration.UpdateEnvironmentWithContext(ctx, &UpdateEnvironmentOptions{
+		EnvironmentID: createEnvironmentOptions.EnvironmentID,
+		Name:          createEnvironmentOptions.Name,
+		Description:   createEnvironmentOptions.Description,
+		Tags:          createEnvironmentOptions.Tags,
+		ColorCode:     createEnvironmentOptions.ColorCode,
+		Headers:       createEnvironmentOptions.Headers,
+	})
+	return result, updatedOutcome(err), response, err
+}
+
+// UpsertCollection : Create a collection, or update it if it exists
+// Create the collection and, if it already exists, update it unless it already has the attributes of the options.
+// The outcome is one of the UpsertOutcome_* constants, and the response is that of the last request made.
+func (appConfiguration *AppConfigurationV1) UpsertCollection(createCollectionOptions *CreateCollectionOptions) (result *CollectionLite, outcome string, response *core.DetailedResponse, err error) {
+	result, outcome, response, err = appConfiguration.UpsertCollectionWithContext(context.Background(), createCollectionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpsertCollectionWithContext is an alternate form of the UpsertCollection method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpsertCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *CollectionLite, outcome string, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.CreateCollectionWithContext(ctx, createCollectionOptions)
+	if !isConflict(err) {
+		return result, createdOutcome(err), response, err
+	}
+	existing, response, err := appConfiguration.GetCollectionWithContext(ctx, &GetCollectionOptions{
+		CollectionID: createCollectionOptions.CollectionID,
+		Headers:      createCollectionOptions.Headers,
+	})
+	if err != nil {
+		return nil, "", response, err
+	}
+	if upsertUnchanged(ConfigChange_Kind_Collection, existing, createCollectionOptions) {
+		return &CollectionLite{
+			Name:         existing.Name,
+			CollectionID: existing.CollectionID,
+			Description:  existing.Description,
+			Tags:         existing.Tags,
+			CreatedTime:  existing.CreatedTime,
+			UpdatedTime:  existing.UpdatedTime,
+			Href:         existing.Href,
+		}, UpsertOutcome_Unchanged, response, nil
+	}
+	result, response, err = appConfiguration.UpdateCollectionWithContext(ctx, &UpdateCollectionOptions{
+		CollectionID: createCollectionOptions.CollectionID,
+		Name:         createCollectionOptions.Name,
+		Description:  createCollectionOptions.Description,
+		Tags:         createCollectionOptions.Tags,
+		Headers:      createCollectionOptions.Headers,
+	})
+	return result, updatedOutcome(err), response, err
+}
+
+// UpsertSegment : Create a segment, or update it if it exists
+// Create the segment and, if it already exists, update it unless it already has the attributes of the options.
+// The outcome is one of the UpsertOutcome_* constants, and the response is that of the last request made.
+func (appConfiguration *AppConfigurationV1) UpsertSegment(createSegmentOptions *CreateSegmentOptions) (result *Segment, outcome string, response *core.DetailedResponse, err error) {
+	result, outcome, response, err = appConfiguration.UpsertSegmentWithContext(context.Background(), createSegmentOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpsertSegmentWithContext is an alternate form of the UpsertSegment method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpsertSegmentWithContext(ctx context.Context, createSegmentOptions *CreateSegmentOptions) (result *Segment, outcome string, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.CreateSegmentWithContext(ctx, createSegmentOptions)
+	if !isConflict(err) {
+		return result, createdOutcome(err), response, err
+	}
+	existing, response, err := appConfiguration.GetSegmentWithContext(ctx, &GetSegmentOptions{
+		SegmentID: createSegmentOptions.SegmentID,
+		Headers:   createSegmentOptions.Headers,
+	})
+	if err != nil {
+		return nil, "", response, err
+	}
+	if upsertUnchanged(ConfigChange_Kind_Segment, existing, createSegmentOptions) {
+		return existing, UpsertOutcome_Unchanged, response, nil
+	}
+	result, response, err = appConfiguration.UpdateSegmentWithContext(ctx, &UpdateSegmentOptions{
+		SegmentID:   createSegmentOptions.SegmentID,
+		Name:        createSegmentOptions.Name,
+		Description: createSegmentOptions.Description,
+		Tags:        createSegmentOptions.Tags,
+		Rules:       createSegmentOptions.Rules,
+		Headers:     createSegmentOptions.Headers,
+	})
+	return result, updatedOutcome(err), response, err
+}
+
+// UpsertFeature : Create a feature flag, or update it if it exists
+// Create the feature flag and, if it already exists, update it unless it already has the attributes of the options.
+// The collections of the feature become those of the options, if they are set. The type and format of an existing
+// feature flag cannot be changed. The outcome is one of the UpsertOutcome_* constants, and the response is that of the
+// last request made.
+func (appConfiguration *AppConfigurationV1) UpsertFeature(createFeatureOptions *CreateFeatureOptions) (result *Feature, outcome string, response *core.DetailedResponse, err error) {
+	result, outcome, response, err = appConfiguration.UpsertFeatureWithContext(context.Background(), createFeatureOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpsertFeatureWithContext is an alternate form of the UpsertFeature method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpsertFeatureWithContext(ctx context.Context, createFeatureOptions *CreateFeatureOptions) (result *Feature, outcome string, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.CreateFeatureWithContext(ctx, createFeatureOptions)
+	if !isConflict(err) {
+		return result, createdOutcome(err), response, err
+	}
+	existing, response, err := appConfiguration.GetFeatureWithContext(ctx, &GetFeatureOptions{
+		EnvironmentID: createFeatureOptions.EnvironmentID,
+		FeatureID:     createFeatureOptions.FeatureID,
+		Include:       []string{GetFeatureOptions_Include_Collections, GetFeatureOptions_Include_Rules},
+		Headers:       createFeatureOptions.Headers,
+	})
+	if err != nil {
+		return nil, "", response, err
+	}
+	if upsertUnchanged(ConfigChange_Kind_Feature, existing, createFeatureOptions) {
+		return existing, UpsertOutcome_Unchanged, response, nil
+	}
+	if err = checkReplaceable("feature", createFeatureOptions.FeatureID, existing.Type, createFeatureOptions.Type, existing.Format, createFeatureOptions.Format); err != nil {
+		return nil, "", response, err
+	}
+	result, response, err = appConfiguration.UpdateFeatureWithContext(ctx, &UpdateFeatureOptions{
+		EnvironmentID:        createFeatureOptions.EnvironmentID,
+		FeatureID:            createFeatureOptions.FeatureID,
+		Name:                 createFeatureOptions.Name,
+		Description:          createFeatureOptions.Description,
+		EnabledValue:         createFeatureOptions.EnabledValue,
+		DisabledValue:        createFeatureOptions.DisabledValue,
+		Enabled:              createFeatureOptions.Enabled,
+		RolloutPercentage:    createFeatureOptions.RolloutPercentage,
+		RolloutType:          createFeatureOptions.RolloutType,
+		RolloutConfiguration: createFeatureOptions.RolloutConfiguration,
+		Tags:                 createFeatureOptions.Tags,
+		SegmentRules:         createFeatureOptions.SegmentRules,
+		Collections:          collectionUpdates(createFeatureOptions.Collections, existing.Collections),
+		Headers:              createFeatureOptions.Headers,
+	})
+	return result, updatedOutcome(err), response, err
+}
+
+// UpsertProperty : Create a property, or update it if it exists
+// Create the property and, if it already exists, update it unless it already has the attributes of the options. The
+// collections of the property become those of the options, if they are set. The type and format of an existing
+// property cannot be changed. The outcome is one of the UpsertOutcome_* constants, and the response is that of the
+// last request made.
+func (appConfiguration *AppConfigurationV1) UpsertProperty(createPropertyOptions *CreatePropertyOptions) (result *Property, outcome string, response *core.DetailedResponse, err error) {
+	result, outcome, response, err = appConfiguration.UpsertPropertyWithContext(context.Background(), createPropertyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpsertPropertyWithContext is an alternate form of the UpsertProperty method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpsertPropertyWithContext(ctx context.Context, createPropertyOptions *CreatePropertyOptions) (result *Property, outcome string, response *core.DetailedResponse, err error) {
+	result, response, err = appConfiguration.CreatePropertyWithContext(ctx, createPropertyOptions)
+	if !isConflict(err) {
+		return result, createdOutcome(err), response, err
+	}
+	existing, response, err := appConfiguration.GetPropertyWithContext(ctx, &GetPropertyOptions{
+		EnvironmentID: createPropertyOptions.EnvironmentID,
+		PropertyID:    createPropertyOptions.PropertyID,
+		Include:       []string{GetPropertyOptions_Include_Collections, GetPropertyOptions_Include_Rules},
+		Headers:       createPropertyOptions.Headers,
+	})
+	if err != nil {
+		return nil, "", response, err
+	}
+	if upsertUnchanged(ConfigChange_Kind_Property, existing, createPropertyOptions) {
+		return existing, UpsertOutcome_Unchanged, response, nil
+	}
+	if err = checkReplaceable("property", createPropertyOptions.PropertyID, existing.Type, createPropertyOptions.Type, existing.Format, createPropertyOptions.Format); err != nil {
+		return nil, "", response, err
+	}
+	result, response, err = appConfiguration.UpdatePropertyWithContext(ctx, &UpdatePropertyOptions{
+		EnvironmentID: createPropertyOptions.EnvironmentID,
+		PropertyID:    createPropertyOptions.PropertyID,
+		Name:          createPropertyOptions.Name,
+		Description:   createPropertyOptions.Description,
+		Value:         createPropertyOptions.Value,
+		Tags:          createPropertyOptions.Tags,
+		SegmentRules:  createPropertyOptions.SegmentRules,
+		Collections:   collectionUpdates(createPropertyOptions.Collections, existing.Collections),
+		Headers:       createPropertyOptions.Headers,
+	})
+	return result, updatedOutcome(err), response, err
+}
+
+// UpsertFeatureRule : Create a targeting rule of a feature flag, or update the rule with the same name
+// Rules are matched by RuleName, which is required: if the feature flag has a rule with that name, it is updated
+// unless it already has the attributes of the options, and RuleID is ignored. Otherwise the rule is created with
+// RuleID. The outcome is one of the UpsertOutcome_* constants, and the response is that of the last request made.
+func (appConfiguration *AppConfigurationV1) UpsertFeatureRule(createFeatureRuleOptions *CreateFeatureRuleOptions) (result *FeatureSegmentRuleWithRuleID, outcome string, response *core.DetailedResponse, err error) {
+	result, outcome, response, err = appConfiguration.UpsertFeatureRuleWithContext(context.Background(), createFeatureRuleOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpsertFeatureRuleWithContext is an alternate form of the UpsertFeatureRule method which supports a Context parameter
+func (appConfiguration *AppConfigurationV1) UpsertFeatureRuleWithContext(ctx context.Context, createFeatureRuleOptions *CreateFeatureRuleOptions) (result *FeatureSegmentRuleWithRuleID, outcome string, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createFeatureRuleOptions, "createFeatureRuleOptions cannot be nil")
+	if err == nil && (createFeatureRuleOptions.RuleName == nil || *createFeatureRuleOptions.RuleName == "") {
+		err = fmt.Errorf("the rule name is required to upsert a feature rule")
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "upsert-feature-rule-error", common.GetComponentInfo())
+		return
+	}
+
+	rules, response, err := appConfiguration.ListFeatureRulesWithContext(ctx, &ListFeatureRulesOptions{
+		EnvironmentID: createFeatureRuleOptions.EnvironmentID,
+		FeatureID:     createFeatureRuleOptions.FeatureID,
+		Headers:       createFeatureRuleOptions.Headers,
+	})
+	if err != nil {
+		return nil, "", response, err
+	}
+	var existing *FeatureSegmentRuleWithRuleID
+	for i := range rules.SegmentRules {
+		if rules.SegmentRules[i].RuleName != nil && *rules.SegmentRules[i].RuleName == *createFeatureRuleOptions.RuleName {
+			existing = &rules.SegmentRules[i]
+			break
+		}
+	}
+	if existing == nil {
+		result, response, err = appConfiguration.CreateFeatureRuleWithContext(ctx, createFeatureRuleOptions)
+		return result, createdOutcome(err), response, err
+	}
+
+	desired := *createFeatureRuleOptions
+	desired.RuleID = existing.RuleID
+	if upsertUnchanged("", existing, &desired) {
+		return existing, UpsertOutcome_Unchanged, response, nil
+	}
+	result, response, err = appConfiguration.UpdateFeatureRuleWithContext(ctx, &UpdateFeatureRuleOptions{
+		EnvironmentID:        createFeatureRuleOptions.EnvironmentID,
+		FeatureID:            createFeatureRuleOptions.FeatureID,
+		RuleID:               existing.RuleID,
+		Rules:                createFeatureRuleOptions.Rules,
+		Value:                createFeatureRuleOptions.Value,
+		RuleName:             createFeatureRuleOptions.RuleName,
+		RolloutPercentage:    createFeatureRuleOptions.RolloutPercentage,
+		RolloutType:          createFeatureRuleOptions.RolloutType,
+		RolloutConfiguration: createFeatureRuleOptions.RolloutConfiguration,
+		Headers:              createFeatureRuleOptions.Headers,
+	})
+	return result, updatedOutcome(err), response, err
+}
+
+func isConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+func createdOutcome(err error) string {
+	if err != nil {
+		return ""
+	}
+	return UpsertOutcome_Created
+}
+
+func updatedOutcome(err error) string {
+	if err != nil {
+		return ""
+	}
+	return UpsertOutcome_Updated
+}
+
+// upsertUnchanged returns true if the existing resource already has every attribute set in the create options.
+// Attributes that the options do not set, and those that only the server sets, are not compared.
+func upsertUnchanged(kind string, existing interface{}, createOptions interface{}) bool {
+	desired := normalizeForDiff(kind, createOptions)
+	delete(desired, "Headers")
+	if kind == ConfigChange_Kind_Feature || kind == ConfigChange_Kind_Property || kind == "" {
+		// Path parameters of the options, not attributes of the resource.
+		delete(desired, "environment_id")
+	}
+	if kind == "" {
+		delete(desired, "feature_id")
+	}
+	return containsJSON(normalizeForDiff(kind, existing), desired)
+}
+
+// containsJSON returns true if every attribute of desired, recursively, has the same value in existing. Arrays must
+// have the same length.
+func containsJSON(existing interface{}, desired interface{}) bool {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		existingValue, ok := existing.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range desiredValue {
+			if !containsJSON(existingValue[key], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		existingValue, ok := existing.([]interface{})
+		if !ok || len(existingValue) != len(desiredValue) {
+			return false
+		}
+		for i := range desiredValue {
+			if !containsJSON(existingValue[i], desiredValue[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(existing, desired)
+}
+
+// checkReplaceable returns an error if the type or format of a feature or property would have to change, which an
+// update cannot do.
+func checkReplaceable(kind string, id *string, existingType *string, desiredType *string, existingFormat *string, desiredFormat *string) error {
+	if (desiredType != nil && (existingType == nil || *existingType != *desiredType)) ||
+		(desiredFormat != nil && (existingFormat == nil || *existingFormat != *desiredFormat)) {
+		return core.SDKErrorf(nil, fmt.Sprintf("the type or format of %s '%s' cannot be changed by an update", kind, *id), "upsert-replace-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// collectionUpdates returns the collection changes of an update that makes the collections of a resource the desired
+// ones: the desired collections, and the existing ones marked as deleted. It returns nil if desired is nil, to leave
+// the collections as they are.
+func collectionUpdates(desired []CollectionRef, existing []CollectionRef) []CollectionUpdateRef {
+	if desired == nil {
+		return nil
+	}
+	updates := []CollectionUpdateRef{}
+	kept := map[string]bool{}
+	for _, collection := range desired {
+		if collection.CollectionID != nil {
+			kept[*collection.CollectionID] = true
+			updates = append(updates, CollectionUpdateRef{CollectionID: collection.CollectionID})
+		}
+	}
+	for _, collection := range existing {
+		if collection.CollectionID != nil && !kept[*collection.CollectionID] {
+			updates = append(updates, CollectionUpdateRef{CollectionID: collection.CollectionID, Deleted: core.BoolPtr(true)})
+		}
+	}
+	return updates
+}
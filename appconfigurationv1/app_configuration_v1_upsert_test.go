@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appconfigurationv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppConfigurationV1 upsert operations`, func() {
+	var testServer *httptest.Server
+	var appConfigurationService *appconfigurationv1.AppConfigurationV1
+	var resources map[string]map[string]interface{}
+	var requests []string
+
+	// The fake service stores the resources by path. Creates conflict with existing resources, and updates merge the
+	// attributes of the request into the stored resource.
+	BeforeEach(func() {
+		resources = map[string]map[string]interface{}{}
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			body := map[string]interface{}{}
+			if req.Body != nil {
+				content, _ := io.ReadAll(req.Body)
+				if len(content) > 0 {
+					Expect(json.Unmarshal(content, &body)).To(Succeed())
+				}
+			}
+
+			switch {
+			case req.Method == "POST" && strings.HasSuffix(req.URL.Path, "/rules"):
+				path := req.URL.Path + "/" + body["rule_id"].(string)
+				resources[path] = body
+				res.WriteHeader(201)
+				json.NewEncoder(res).Encode(body)
+			case req.Method == "POST":
+				path := req.URL.Path + "/" + body["feature_id"].(string)
+				if _, ok := resources[path]; ok {
+					res.WriteHeader(409)
+					fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "Feature flag already exists"}]}`)
+					return
+				}
+				resources[path] = body
+				res.WriteHeader(201)
+				json.NewEncoder(res).Encode(body)
+			case req.Method == "GET" && strings.HasSuffix(req.URL.Path, "/rules"):
+				rules := []interface{}{}
+				for path, rule := range resources {
+					if strings.HasPrefix(path, req.URL.Path+"/") {
+						rules = append(rules, rule)
+					}
+				}
+				json.NewEncoder(res).Encode(map[string]interface{}{"segment_rules": rules, "total_count": len(rules)})
+			case req.Method == "GET":
+				json.NewEncoder(res).Encode(resources[req.URL.Path])
+			case req.Method == "PUT" || req.Method == "PATCH":
+				for key, value := range body {
+					resources[req.URL.Path][key] = value
+				}
+				json.NewEncoder(res).Encode(resources[req.URL.Path])
+			}
+		}))
+		var serviceErr error
+		appConfigurationService, serviceErr = appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	createFeatureOptions := func() *appconfigurationv1.CreateFeatureOptions {
+		return &appconfigurationv1.CreateFeatureOptions{
+			EnvironmentID: core.StringPtr("dev"),
+			Name:          core.StringPtr("Checkout"),
+			FeatureID:     core.StringPtr("checkout"),
+			Type:          core.StringPtr(appconfigurationv1.CreateFeatureOptions_Type_Boolean),
+			EnabledValue:  true,
+			DisabledValue: false,
+			Enabled:       core.BoolPtr(false),
+			Collections:   []appconfigurationv1.CollectionRef{{CollectionID: core.StringPtr("shop")}},
+		}
+	}
+
+	It(`Creates, then leaves unchanged, then updates a feature`, func() {
+		result, outcome, _, err := appConfigurationService.UpsertFeature(createFeatureOptions())
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(appconfigurationv1.UpsertOutcome_Created))
+		Expect(*result.FeatureID).To(Equal("checkout"))
+
+		// The service adds attributes, which are not compared.
+		resources["/environments/dev/features/checkout"]["href"] = "https://example.com/checkout"
+		resources["/environments/dev/features/checkout"]["collections"] = []interface{}{map[string]interface{}{"collection_id": "shop", "name": "Shop"}}
+		requests = nil
+		_, outcome, _, err = appConfigurationService.UpsertFeature(createFeatureOptions())
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(appconfigurationv1.UpsertOutcome_Unchanged))
+		Expect(requests).To(Equal([]string{"POST /environments/dev/features", "GET /environments/dev/features/checkout"}))
+
+		options := createFeatureOptions()
+		options.Enabled = core.BoolPtr(true)
+		options.Collections = []appconfigurationv1.CollectionRef{{CollectionID: core.StringPtr("web")}}
+		result, outcome, _, err = appConfigurationService.UpsertFeature(options)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(appconfigurationv1.UpsertOutcome_Updated))
+		Expect(*result.Enabled).To(BeTrue())
+		Expect(resources["/environments/dev/features/checkout"]["collections"]).To(Equal([]interface{}{
+			map[string]interface{}{"collection_id": "web"},
+			map[string]interface{}{"collection_id": "shop", "deleted": true},
+		}))
+	})
+
+	It(`Refuses to change the type of a feature`, func() {
+		_, _, _, err := appConfigurationService.UpsertFeature(createFeatureOptions())
+		Expect(err).To(BeNil())
+		options := createFeatureOptions()
+		options.Type = core.StringPtr(appconfigurationv1.CreateFeatureOptions_Type_String)
+		options.EnabledValue, options.DisabledValue = "on", "off"
+		_, outcome, _, err := appConfigurationService.UpsertFeature(options)
+		Expect(err).ToNot(BeNil())
+		Expect(outcome).To(BeEmpty())
+		Expect(err.Error()).To(ContainSubstring("cannot be changed"))
+	})
+
+	It(`Matches feature rules by name`, func() {
+		createRuleOptions := func(value interface{}) *appconfigurationv1.CreateFeatureRuleOptions {
+			return &appconfigurationv1.CreateFeatureRuleOptions{
+				EnvironmentID: core.StringPtr("dev"),
+				FeatureID:     core.StringPtr("checkout"),
+				Rules:         []appconfigurationv1.TargetSegments{{Segments: []string{"beta"}}},
+				Value:         value,
+				RuleID:        core.StringPtr("rule-1"),
+				RuleName:      core.StringPtr("beta users"),
+			}
+		}
+		_, outcome, _, err := appConfigurationService.UpsertFeatureRule(createRuleOptions(true))
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(appconfigurationv1.UpsertOutcome_Created))
+
+		// The rule ID of the options is ignored once a rule has the name.
+		options := createRuleOptions(true)
+		options.RuleID = core.StringPtr("rule-2")
+		_, outcome, _, err = appConfigurationService.UpsertFeatureRule(options)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(appconfigurationv1.UpsertOutcome_Unchanged))
+
+		options.Value = false
+		result, outcome, _, err := appConfigurationService.UpsertFeatureRule(options)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(appconfigurationv1.UpsertOutcome_Updated))
+		Expect(*result.RuleID).To(Equal("rule-1"))
+		Expect(requests[len(requests)-1]).To(Equal("PATCH /environments/dev/features/checkout/rules/rule-1"))
+
+		options.RuleName = nil
+		_, _, _, err = appConfigurationService.UpsertFeatureRule(options)
+		Expect(err).ToNot(BeNil())
+	})
+})
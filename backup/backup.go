@@ -0,0 +1,351 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package backup : Local snapshot backups of App Configuration instances
+// A Manager saves timestamped, gzip-compressed snapshots of the instance configuration returned by
+// ListInstanceConfig to a Store, prunes them according to a RetentionPolicy, compares them, and restores them
+// through ImportConfig.
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Snapshot names are made of this prefix, the UTC time of the snapshot in this layout and this suffix, for example
+// `appconfig-20261019T101500Z.json.gz`. Later snapshots of the same second have a sequence number after the time, for
+// example `appconfig-20261019T101500Z-2.json.gz`.
+const (
+	SnapshotPrefix     = "appconfig-"
+	SnapshotTimeLayout = "20060102T150405Z"
+	SnapshotSuffix     = ".json.gz"
+)
+
+// RestoreMode : How Restore treats the configuration that is already in the instance. There is no default: the zero
+// value is rejected, so that callers always make the choice.
+type RestoreMode int
+
+const (
+	// RestoreMerge imports the snapshot on top of the current configuration (`clean=false`). Resources that are not
+	// in the snapshot are kept.
+	RestoreMerge RestoreMode = iota + 1
+
+	// RestoreClean wipes the current configuration before importing the snapshot (`clean=true`).
+	RestoreClean
+)
+
+// Snapshot : A snapshot in a store.
+type Snapshot struct {
+	// The name of the snapshot in the store.
+	Name string
+
+	// When the snapshot was taken.
+	Time time.Time
+}
+
+// RetentionPolicy : Which snapshots Prune keeps. A snapshot is kept if any rule keeps it.
+type RetentionPolicy struct {
+	// Keep the most recent Last snapshots.
+	Last int
+
+	// Keep the most recent snapshot of each of the last Daily days that have snapshots.
+	Daily int
+
+	// Keep the most recent snapshot of each of the last Weekly ISO weeks that have snapshots.
+	Weekly int
+}
+
+// Manager : Saves, lists, compares, prunes and restores the snapshots of an instance.
+type Manager struct {
+	client *appconfigurationv1.AppConfigurationV1
+	store  Store
+	now    func() time.Time
+}
+
+// NewManager returns a manager for the snapshots of the instance of client, kept in store. client may be nil if
+// only stored snapshots are used (List, Load, Diff and Prune).
+func NewManager(client *appconfigurationv1.AppConfigurationV1, store Store) (*Manager, error) {
+	if store == nil {
+		return nil, core.SDKErrorf(nil, "a store is required", "missing-store", common.GetComponentInfo())
+	}
+	return &Manager{client: client, store: store, now: time.Now}, nil
+}
+
+// Save takes a snapshot of the instance configuration.
+func (manager *Manager) Save() (*Snapshot, error) {
+	return manager.SaveWithContext(context.Background())
+}
+
+// SaveWithContext is an alternate form of the Save method which supports a Context parameter
+func (manager *Manager) SaveWithContext(ctx context.Context) (*Snapshot, error) {
+	if err := manager.requireClient(); err != nil {
+		return nil, err
+	}
+	config, _, err := manager.client.ListInstanceConfigWithContext(ctx, &appconfigurationv1.ListInstanceConfigOptions{})
+	if err != nil {
+		return nil, core.SDKErrorf(err, "unable to read the instance configuration", "read-config-error", common.GetComponentInfo())
+	}
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	err = json.NewEncoder(writer).Encode(config)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "encode-snapshot-error", common.GetComponentInfo())
+	}
+
+	snapshotTime := manager.now().UTC().Truncate(time.Second)
+	names, err := manager.store.List()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{Name: snapshotName(snapshotTime, names), Time: snapshotTime}
+	if err := manager.store.Put(snapshot.Name, buffer.Bytes()); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// snapshotName returns the name of a snapshot taken at snapshotTime. Snapshots taken in the same second as an
+// existing one get a "-2", "-3"... suffix after the timestamp.
+func snapshotName(snapshotTime time.Time, existing []string) string {
+	base := SnapshotPrefix + snapshotTime.Format(SnapshotTimeLayout)
+	name := base + SnapshotSuffix
+	for sequence := 2; slices.Contains(existing, name); sequence++ {
+		name = fmt.Sprintf("%s-%d%s", base, sequence, SnapshotSuffix)
+	}
+	return name
+}
+
+// List returns the snapshots of the store, oldest first. Entries that are not named like snapshots are ignored.
+func (manager *Manager) List() ([]Snapshot, error) {
+	names, err := manager.store.List()
+	if err != nil {
+		return nil, err
+	}
+	snapshots := []Snapshot{}
+	sequences := map[string]int{}
+	for _, name := range names {
+		if snapshot, sequence, ok := parseSnapshotName(name); ok {
+			snapshots = append(snapshots, snapshot)
+			sequences[name] = sequence
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		if !snapshots[i].Time.Equal(snapshots[j].Time) {
+			return snapshots[i].Time.Before(snapshots[j].Time)
+		}
+		return sequences[snapshots[i].Name] < sequences[snapshots[j].Name]
+	})
+	return snapshots, nil
+}
+
+// Latest returns the most recent snapshot, or nil if there is none.
+func (manager *Manager) Latest() (*Snapshot, error) {
+	snapshots, err := manager.List()
+	if err != nil || len(snapshots) == 0 {
+		return nil, err
+	}
+	return &snapshots[len(snapshots)-1], nil
+}
+
+// Load reads the instance configuration saved in a snapshot.
+func (manager *Manager) Load(name string) (*appconfigurationv1.ImportConfig, error) {
+	data, err := manager.store.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("snapshot '%s' is not compressed", name), "decode-snapshot-error", common.GetComponentInfo())
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("snapshot '%s' is corrupted", name), "decode-snapshot-error", common.GetComponentInfo())
+	}
+
+	var rawConfig map[string]json.RawMessage
+	config := &appconfigurationv1.ImportConfig{}
+	err = json.Unmarshal(content, &rawConfig)
+	if err == nil {
+		err = core.UnmarshalModel(rawConfig, "", &config, appconfigurationv1.UnmarshalImportConfig)
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("snapshot '%s' is not an instance configuration", name), "decode-snapshot-error", common.GetComponentInfo())
+	}
+	return config, nil
+}
+
+// Diff returns the changes that turn the configuration of snapshot `from` into that of snapshot `to`.
+func (manager *Manager) Diff(from string, to string) (appconfigurationv1.ConfigDiff, error) {
+	fromConfig, err := manager.Load(from)
+	if err != nil {
+		return nil, err
+	}
+	toConfig, err := manager.Load(to)
+	if err != nil {
+		return nil, err
+	}
+	return appconfigurationv1.DiffConfig(fromConfig, toConfig), nil
+}
+
+// Restore imports a snapshot into the instance with ImportConfig. mode must be RestoreMerge or RestoreClean.
+func (manager *Manager) Restore(name string, mode RestoreMode) (*appconfigurationv1.InstanceConfigAcceptedResponse, *core.DetailedResponse, error) {
+	return manager.RestoreWithContext(context.Background(), name, mode)
+}
+
+// RestoreWithContext is an alternate form of the Restore method which supports a Context parameter
+func (manager *Manager) RestoreWithContext(ctx context.Context, name string, mode RestoreMode) (*appconfigurationv1.InstanceConfigAcceptedResponse, *core.DetailedResponse, error) {
+	var clean string
+	switch mode {
+	case RestoreMerge:
+		clean = "false"
+	case RestoreClean:
+		clean = "true"
+	default:
+		return nil, nil, core.SDKErrorf(nil, "the restore mode must be RestoreMerge or RestoreClean", "invalid-restore-mode", common.GetComponentInfo())
+	}
+	if err := manager.requireClient(); err != nil {
+		return nil, nil, err
+	}
+	config, err := manager.Load(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, response, err := manager.client.ImportConfigWithContext(ctx, &appconfigurationv1.ImportConfigOptions{
+		Environments: config.Environments,
+		Collections:  config.Collections,
+		Segments:     config.Segments,
+		Clean:        core.StringPtr(clean),
+	})
+	if err != nil {
+		return nil, response, core.SDKErrorf(err, fmt.Sprintf("unable to restore snapshot '%s'", name), "restore-error", common.GetComponentInfo())
+	}
+	return result, response, nil
+}
+
+// Prune deletes the snapshots that the policy does not keep, and returns them. A policy that keeps nothing is
+// rejected, so that Prune never deletes every snapshot.
+func (manager *Manager) Prune(policy RetentionPolicy) (deleted []Snapshot, err error) {
+	if policy.Last <= 0 && policy.Daily <= 0 && policy.Weekly <= 0 {
+		return nil, core.SDKErrorf(nil, "the retention policy must keep at least one snapshot", "invalid-retention-policy", common.GetComponentInfo())
+	}
+	snapshots, err := manager.List()
+	if err != nil {
+		return nil, err
+	}
+	keep := policy.keep(snapshots)
+	for _, snapshot := range snapshots {
+		if keep[snapshot.Name] {
+			continue
+		}
+		if err = manager.store.Delete(snapshot.Name); err != nil {
+			return
+		}
+		deleted = append(deleted, snapshot)
+	}
+	return
+}
+
+// Run saves a snapshot every interval, pruning with the policy after each one if it is not nil, until ctx is done.
+// Errors do not stop the schedule; they are passed to onError, which may be nil.
+func (manager *Manager) Run(ctx context.Context, interval time.Duration, policy *RetentionPolicy, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := manager.SaveWithContext(ctx)
+			if err == nil && policy != nil {
+				_, err = manager.Prune(*policy)
+			}
+			if err != nil && onError != nil && ctx.Err() == nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// keep returns the names of the snapshots kept by the policy. snapshots must be sorted oldest first.
+func (policy RetentionPolicy) keep(snapshots []Snapshot) map[string]bool {
+	keep := map[string]bool{}
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		snapshot := snapshots[i]
+		if len(snapshots)-i <= policy.Last {
+			keep[snapshot.Name] = true
+		}
+		day := snapshot.Time.Format("2006-01-02")
+		if !days[day] && len(days) < policy.Daily {
+			days[day] = true
+			keep[snapshot.Name] = true
+		}
+		year, week := snapshot.Time.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+		if !weeks[weekKey] && len(weeks) < policy.Weekly {
+			weeks[weekKey] = true
+			keep[snapshot.Name] = true
+		}
+	}
+	return keep
+}
+
+func (manager *Manager) requireClient() error {
+	if manager.client == nil {
+		return core.SDKErrorf(nil, "the manager has no client", "missing-client", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func parseSnapshotName(name string) (snapshot Snapshot, sequence int, ok bool) {
+	if !strings.HasPrefix(name, SnapshotPrefix) || !strings.HasSuffix(name, SnapshotSuffix) {
+		return Snapshot{}, 0, false
+	}
+	timestamp := strings.TrimSuffix(strings.TrimPrefix(name, SnapshotPrefix), SnapshotSuffix)
+	sequence = 1
+	if index := strings.LastIndex(timestamp, "-"); index >= 0 {
+		var err error
+		if sequence, err = strconv.Atoi(timestamp[index+1:]); err != nil || sequence < 2 {
+			return Snapshot{}, 0, false
+		}
+		timestamp = timestamp[:index]
+	}
+	snapshotTime, err := time.Parse(SnapshotTimeLayout, timestamp)
+	if err != nil {
+		return Snapshot{}, 0, false
+	}
+	return Snapshot{Name: name, Time: snapshotTime}, sequence, true
+}
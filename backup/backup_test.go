@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeInstance struct {
+	config      string
+	importQuery string
+	importBody  map[string]interface{}
+}
+
+func newTestManager(t *testing.T) (*Manager, *fakeInstance, *DirStore) {
+	instance := &fakeInstance{config: `{"environments": [{"name": "Dev", "environment_id": "dev", "features": [{"name": "f1", "feature_id": "f1", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false}]}]}`}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		if req.Method == http.MethodGet {
+			fmt.Fprint(res, instance.config)
+			return
+		}
+		instance.importQuery = req.URL.RawQuery
+		content, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(content, &instance.importBody)
+		res.WriteHeader(202)
+		fmt.Fprint(res, `{"environments": [], "segments": [], "collections": []}`)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	store, err := NewDirStore(t.TempDir())
+	require.Nil(t, err)
+	manager, err := NewManager(client, store)
+	require.Nil(t, err)
+	return manager, instance, store
+}
+
+func TestSaveListAndDiff(t *testing.T) {
+	manager, instance, _ := newTestManager(t)
+	manager.now = func() time.Time { return time.Date(2026, 10, 19, 10, 15, 0, 0, time.UTC) }
+	first, err := manager.Save()
+	require.Nil(t, err)
+	assert.Equal(t, "appconfig-20261019T101500Z.json.gz", first.Name)
+
+	instance.config = `{"environments": [{"name": "Dev", "environment_id": "dev", "features": [{"name": "f1", "feature_id": "f1", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true}]}]}`
+	manager.now = func() time.Time { return time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC) }
+	second, err := manager.Save()
+	require.Nil(t, err)
+
+	snapshots, err := manager.List()
+	require.Nil(t, err)
+	assert.Equal(t, []Snapshot{*first, *second}, snapshots)
+	latest, err := manager.Latest()
+	require.Nil(t, err)
+	assert.Equal(t, second, latest)
+
+	config, err := manager.Load(first.Name)
+	require.Nil(t, err)
+	assert.False(t, *config.Environments[0].Features[0].Enabled)
+
+	diff, err := manager.Diff(first.Name, second.Name)
+	require.Nil(t, err)
+	assert.Equal(t, "update feature dev/f1 (enabled)", diff.String())
+}
+
+func TestSaveTwiceInTheSameSecond(t *testing.T) {
+	manager, instance, store := newTestManager(t)
+	manager.now = func() time.Time { return time.Date(2026, 10, 19, 10, 15, 0, 100, time.UTC) }
+	first, err := manager.Save()
+	require.Nil(t, err)
+	instance.config = `{"environments": [{"name": "Prod", "environment_id": "prod"}]}`
+	manager.now = func() time.Time { return time.Date(2026, 10, 19, 10, 15, 0, 900, time.UTC) }
+	second, err := manager.Save()
+	require.Nil(t, err)
+	assert.Equal(t, "appconfig-20261019T101500Z-2.json.gz", second.Name)
+
+	snapshots, err := manager.List()
+	require.Nil(t, err)
+	assert.Equal(t, []Snapshot{*first, *second}, snapshots)
+	config, err := manager.Load(first.Name)
+	require.Nil(t, err)
+	assert.Equal(t, "dev", *config.Environments[0].EnvironmentID)
+
+	err = store.Put(first.Name, []byte("overwritten"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+}
+
+func TestDirStorePutStaysInTheDirectory(t *testing.T) {
+	parent := t.TempDir()
+	store, err := NewDirStore(filepath.Join(parent, "backups"))
+	require.Nil(t, err)
+	require.Nil(t, store.Put("../outside.json.gz", []byte("data")))
+	_, err = os.Stat(filepath.Join(parent, "outside.json.gz"))
+	assert.True(t, os.IsNotExist(err))
+	data, err := store.Get("outside.json.gz")
+	require.Nil(t, err)
+	assert.Equal(t, "data", string(data))
+	entries, err := store.List()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"outside.json.gz"}, entries)
+}
+
+func TestRestore(t *testing.T) {
+	manager, instance, _ := newTestManager(t)
+	snapshot, err := manager.Save()
+	require.Nil(t, err)
+
+	_, _, err = manager.Restore(snapshot.Name, 0)
+	assert.NotNil(t, err)
+	assert.Empty(t, instance.importQuery)
+
+	_, response, err := manager.Restore(snapshot.Name, RestoreMerge)
+	require.Nil(t, err)
+	assert.Equal(t, 202, response.StatusCode)
+	assert.Equal(t, "clean=false", instance.importQuery)
+	assert.Len(t, instance.importBody["environments"], 1)
+
+	_, _, err = manager.Restore(snapshot.Name, RestoreClean)
+	require.Nil(t, err)
+	assert.Equal(t, "clean=true", instance.importQuery)
+}
+
+func TestPrune(t *testing.T) {
+	manager, _, store := newTestManager(t)
+	// Two snapshots a day, from Monday 2026-10-05 to Monday 2026-10-19.
+	start := time.Date(2026, 10, 5, 6, 0, 0, 0, time.UTC)
+	for day := 0; day <= 14; day++ {
+		for _, hour := range []int{0, 12} {
+			snapshotTime := start.AddDate(0, 0, day).Add(time.Duration(hour) * time.Hour)
+			require.Nil(t, store.Put(SnapshotPrefix+snapshotTime.Format(SnapshotTimeLayout)+SnapshotSuffix, []byte{}))
+		}
+	}
+	require.Nil(t, store.Put("notes.txt", []byte("not a snapshot")))
+
+	_, err := manager.Prune(RetentionPolicy{})
+	assert.NotNil(t, err)
+
+	deleted, err := manager.Prune(RetentionPolicy{Last: 1, Daily: 2, Weekly: 3})
+	require.Nil(t, err)
+	assert.Len(t, deleted, 30-3)
+
+	snapshots, err := manager.List()
+	require.Nil(t, err)
+	names := []string{}
+	for _, snapshot := range snapshots {
+		names = append(names, snapshot.Name)
+	}
+	// The latest of 2026-10-18 is kept as daily and weekly, the latest of the week ending on 2026-10-11 as weekly, and
+	// the latest of 2026-10-19 by every rule.
+	assert.Equal(t, []string{
+		"appconfig-20261011T180000Z.json.gz",
+		"appconfig-20261018T180000Z.json.gz",
+		"appconfig-20261019T180000Z.json.gz",
+	}, names)
+
+	entries, err := store.List()
+	require.Nil(t, err)
+	assert.Contains(t, entries, "notes.txt")
+}
+
+func TestFSStore(t *testing.T) {
+	manager, _, store := newTestManager(t)
+	snapshot, err := manager.Save()
+	require.Nil(t, err)
+	data, err := store.Get(snapshot.Name)
+	require.Nil(t, err)
+
+	readOnly, err := NewManager(nil, NewFSStore(fstest.MapFS{snapshot.Name: &fstest.MapFile{Data: data}}))
+	require.Nil(t, err)
+	snapshots, err := readOnly.List()
+	require.Nil(t, err)
+	assert.Len(t, snapshots, 1)
+	_, err = readOnly.Load(snapshot.Name)
+	assert.Nil(t, err)
+	_, err = readOnly.Save()
+	assert.NotNil(t, err)
+	_, err = readOnly.Prune(RetentionPolicy{Last: 0, Daily: 0, Weekly: 1})
+	assert.Nil(t, err)
+
+	require.Nil(t, os.WriteFile(filepath.Join(store.dir, SnapshotPrefix+"broken"+SnapshotSuffix), []byte("x"), 0600))
+	_, err = manager.Load(SnapshotPrefix + "broken" + SnapshotSuffix)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Store : Where snapshots are kept. Names are plain file names without directories.
+type Store interface {
+	// Put writes a snapshot. It fails if the store already has a snapshot with the same name.
+	Put(name string, data []byte) error
+
+	// Get reads a snapshot.
+	Get(name string) ([]byte, error)
+
+	// List returns the names of all the entries of the store, in any order.
+	List() ([]string, error)
+
+	// Delete removes a snapshot.
+	Delete(name string) error
+}
+
+// DirStore : A Store backed by a directory of the local file system.
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore returns a store writing to dir, which is created if needed.
+func NewDirStore(dir string) (*DirStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, core.SDKErrorf(err, "", "create-backup-dir-error", common.GetComponentInfo())
+	}
+	return &DirStore{dir: dir}, nil
+}
+
+// Put writes the snapshot to a temporary file first, so that a partially written snapshot is never listed, then
+// links it under its name, which fails if a snapshot with that name exists.
+func (store *DirStore) Put(name string, data []byte) error {
+	name = filepath.Base(name)
+	file, err := os.CreateTemp(store.dir, ".tmp-"+name)
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-snapshot-error", common.GetComponentInfo())
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Link(file.Name(), filepath.Join(store.dir, name))
+	}
+	if errors.Is(err, fs.ErrExist) {
+		return core.SDKErrorf(err, fmt.Sprintf("snapshot '%s' already exists", name), "snapshot-exists", common.GetComponentInfo())
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-snapshot-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Get reads a snapshot.
+func (store *DirStore) Get(name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(store.dir, filepath.Base(name)))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "read-snapshot-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+// List returns the names of the regular files of the directory.
+func (store *DirStore) List() ([]string, error) {
+	return listFS(os.DirFS(store.dir))
+}
+
+// Delete removes a snapshot.
+func (store *DirStore) Delete(name string) error {
+	if err := os.Remove(filepath.Join(store.dir, filepath.Base(name))); err != nil {
+		return core.SDKErrorf(err, "", "delete-snapshot-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// FSStore : A read-only Store backed by an fs.FS, for example an embedded file system or a mounted archive.
+// Snapshots can be listed, compared and restored, but not saved or pruned.
+type FSStore struct {
+	fsys fs.FS
+}
+
+// NewFSStore returns a read-only store reading the top-level files of fsys.
+func NewFSStore(fsys fs.FS) *FSStore {
+	return &FSStore{fsys: fsys}
+}
+
+// Put always fails, the store is read-only.
+func (store *FSStore) Put(name string, data []byte) error {
+	return core.SDKErrorf(nil, "the store is read-only", "read-only-store", common.GetComponentInfo())
+}
+
+// Get reads a snapshot.
+func (store *FSStore) Get(name string) ([]byte, error) {
+	data, err := fs.ReadFile(store.fsys, name)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "read-snapshot-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+// List returns the names of the top-level regular files.
+func (store *FSStore) List() ([]string, error) {
+	return listFS(store.fsys)
+}
+
+// Delete always fails, the store is read-only.
+func (store *FSStore) Delete(name string) error {
+	return core.SDKErrorf(nil, "the store is read-only", "read-only-store", common.GetComponentInfo())
+}
+
+func listFS(fsys fs.FS) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-snapshots-error", common.GetComponentInfo())
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,348 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package codegen : Typed Go accessors for the features and properties of an instance
+// Generate writes a Go package with a constant for the ID of each feature and property of an instance configuration,
+// and a Flags type whose methods return their values with a Go type derived from their type and format: bool for
+// BOOLEAN, float64 for NUMERIC, string for TEXT and YAML strings, and generated structs for JSON strings, inferred from
+// the values of every environment. The generated package only depends on the standard library: the values come from
+// a Source, such as a SnapshotSource of this package or an adapter of the App Configuration runtime SDK.
+package codegen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Options : The options of the generated package.
+type Options struct {
+	// The name of the generated package, for example `flags`.
+	PackageName string
+}
+
+// accessor : A feature or property and the Go names of its accessor.
+type accessor struct {
+	kind        string
+	id          string
+	name        string
+	description string
+	valueType   string
+	format      string
+
+	// The values of every environment, from which JSON structs are inferred.
+	samples []interface{}
+
+	constant string
+	method   string
+	goType   string
+}
+
+// GenerateFromInstance reads the configuration of the instance with GetLiveInstanceConfig and generates its package.
+func GenerateFromInstance(client *appconfigurationv1.AppConfigurationV1, options *Options) ([]byte, error) {
+	return GenerateFromInstanceWithContext(context.Background(), client, options)
+}
+
+// GenerateFromInstanceWithContext is an alternate form of the GenerateFromInstance method which supports a Context
+// parameter
+func GenerateFromInstanceWithContext(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, options *Options) ([]byte, error) {
+	config, err := client.GetLiveInstanceConfigWithContext(ctx)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "unable to read the instance configuration", "read-config-error", common.GetComponentInfo())
+	}
+	return Generate(config, options)
+}
+
+// GenerateFromFile reads an instance configuration exported by ListInstanceConfig, or a snapshot of the backup
+// package, and generates its package.
+func GenerateFromFile(path string, options *Options) ([]byte, error) {
+	config, err := evaluator.LoadConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Generate(config, options)
+}
+
+// Generate returns the formatted source of the package of the features and properties of config.
+func Generate(config *appconfigurationv1.ImportConfig, options *Options) ([]byte, error) {
+	if options == nil || !token.IsIdentifier(options.PackageName) {
+		return nil, core.SDKErrorf(nil, "a valid package name is required", "invalid-package-name", common.GetComponentInfo())
+	}
+	features, properties := collect(config)
+	generator := &generator{names: names{}, methods: names{}}
+	for _, name := range []string{"Source", "Flags", "New", "decode"} {
+		generator.names[name] = true
+	}
+	for _, accessor := range features {
+		generator.name(accessor, "Feature")
+	}
+	for _, accessor := range properties {
+		generator.name(accessor, "Property")
+	}
+
+	source := generator.write(options.PackageName, features, properties)
+	formatted, err := format.Source(source)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "the generated code is invalid", "format-error", common.GetComponentInfo())
+	}
+	return formatted, nil
+}
+
+// WriteFile generates the package of config and writes it to path.
+func WriteFile(path string, config *appconfigurationv1.ImportConfig, options *Options) error {
+	source, err := Generate(config, options)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(path, source, 0644); err != nil {
+		return core.SDKErrorf(err, "", "write-file-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// collect returns the features and properties of every environment of config, sorted by ID. The name, type and
+// format of a resource are taken from the first environment that has it.
+func collect(config *appconfigurationv1.ImportConfig) (features []*accessor, properties []*accessor) {
+	byID := map[string]map[string]*accessor{"feature": {}, "property": {}}
+	add := func(kind string, id *string, name *string, description *string, valueType *string, format *string, samples ...interface{}) {
+		if id == nil {
+			return
+		}
+		found := byID[kind][*id]
+		if found == nil {
+			found = &accessor{kind: kind, id: *id, name: stringValue(name), description: stringValue(description), valueType: stringValue(valueType), format: stringValue(format)}
+			byID[kind][*id] = found
+		}
+		found.samples = append(found.samples, samples...)
+	}
+	for _, environment := range config.Environments {
+		for _, feature := range environment.Features {
+			samples := []interface{}{feature.EnabledValue, feature.DisabledValue}
+			for _, rule := range feature.SegmentRules {
+				samples = append(samples, rule.Value)
+			}
+			add("feature", feature.FeatureID, feature.Name, feature.Description, feature.Type, feature.Format, samples...)
+		}
+		for _, property := range environment.Properties {
+			samples := []interface{}{property.Value}
+			for _, rule := range property.SegmentRules {
+				samples = append(samples, rule.Value)
+			}
+			add("property", property.PropertyID, property.Name, property.Description, property.Type, property.Format, samples...)
+		}
+	}
+	sorted := func(accessors map[string]*accessor) []*accessor {
+		list := make([]*accessor, 0, len(accessors))
+		for _, accessor := range accessors {
+			list = append(list, accessor)
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].id < list[j].id })
+		return list
+	}
+	return sorted(byID["feature"]), sorted(byID["property"])
+}
+
+// names : The Go identifiers in use in a scope.
+type names map[string]bool
+
+// allocate returns the first identifier of base, base2, base3... that is not in use, and marks it as used.
+func (names names) allocate(base string) string {
+	name := base
+	for i := 2; names[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	names[name] = true
+	return name
+}
+
+type generator struct {
+	// The top-level identifiers and the methods of Flags.
+	names   names
+	methods names
+
+	// The declarations of the generated structs.
+	types []string
+}
+
+// name assigns the Go names and type of accessor.
+func (generator *generator) name(accessor *accessor, prefix string) {
+	base := goName(accessor.id)
+	accessor.constant = generator.names.allocate(prefix + base + "ID")
+	method := base
+	if prefix == "Property" && generator.methods[method] {
+		method += "Property"
+	}
+	accessor.method = generator.methods.allocate(method)
+	switch {
+	case accessor.valueType == appconfigurationv1.ImportFeatureRequestBody_Type_Boolean:
+		accessor.goType = "bool"
+	case accessor.valueType == appconfigurationv1.ImportFeatureRequestBody_Type_Numeric:
+		accessor.goType = "float64"
+	case accessor.valueType == appconfigurationv1.ImportPropertyRequestBody_Type_Secretref:
+		accessor.goType = "map[string]interface{}"
+	case accessor.valueType == appconfigurationv1.ImportFeatureRequestBody_Type_String && accessor.format == appconfigurationv1.ImportFeatureRequestBody_Format_JSON:
+		var inferred *jsonType
+		for _, sample := range accessor.samples {
+			if text, ok := sample.(string); ok {
+				if text == "$default" || json.Unmarshal([]byte(text), &sample) != nil {
+					continue
+				}
+			}
+			inferred = merge(inferred, infer(sample))
+		}
+		accessor.goType = generator.goType(inferred, base+"Value")
+	case accessor.valueType == appconfigurationv1.ImportFeatureRequestBody_Type_String:
+		accessor.goType = "string"
+	default:
+		accessor.goType = "interface{}"
+	}
+}
+
+func (generator *generator) write(packageName string, features []*accessor, properties []*accessor) []byte {
+	var builder strings.Builder
+	builder.WriteString("// Code generated by the codegen package of the App Configuration Go admin SDK. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&builder, "// Package %s : Typed accessors for the features and properties of App Configuration\n", packageName)
+	fmt.Fprintf(&builder, "package %s\n\n", packageName)
+	builder.WriteString(`import (
+	"encoding/json"
+	"fmt"
+)
+
+// Source : Evaluates the features and properties of an environment for an entity.
+type Source interface {
+	FeatureValue(featureID string, entityID string, attributes map[string]interface{}) (interface{}, error)
+	PropertyValue(propertyID string, entityID string, attributes map[string]interface{}) (interface{}, error)
+}
+
+`)
+	for _, group := range []struct {
+		title     string
+		accessors []*accessor
+	}{{"features", features}, {"properties", properties}} {
+		if len(group.accessors) == 0 {
+			continue
+		}
+		fmt.Fprintf(&builder, "// The IDs of the %s.\nconst (\n", group.title)
+		for _, accessor := range group.accessors {
+			fmt.Fprintf(&builder, "\t// %s is the ID of the %s %s.\n\t%s = %q\n", accessor.constant, accessor.kind, comment(accessor.name, accessor.description), accessor.constant, accessor.id)
+		}
+		builder.WriteString(")\n\n")
+	}
+	for _, declaration := range generator.types {
+		builder.WriteString(declaration)
+	}
+
+	builder.WriteString(`// Flags : Typed accessors for the features and properties of a Source.
+type Flags struct {
+	source Source
+}
+
+// New returns the accessors of the features and properties evaluated by source.
+func New(source Source) *Flags {
+	return &Flags{source: source}
+}
+
+`)
+	for _, accessor := range append(append([]*accessor{}, features...), properties...) {
+		valueMethod := "FeatureValue"
+		if accessor.kind == "property" {
+			valueMethod = "PropertyValue"
+		}
+		fmt.Fprintf(&builder, `// %s returns the value of the %s %s for an entity.
+func (flags *Flags) %s(entityID string, attributes map[string]interface{}) (%s, error) {
+	var result %s
+	value, err := flags.source.%s(%s, entityID, attributes)
+	if err == nil {
+		err = decode(%q, %s, value, &result)
+	}
+	return result, err
+}
+
+`, accessor.method, accessor.kind, comment(accessor.name, accessor.description), accessor.method, accessor.goType, accessor.goType, valueMethod, accessor.constant, accessor.kind, accessor.constant)
+	}
+
+	builder.WriteString(`// decode converts the value of a feature or property to the type of result. JSON values may be objects or text.
+func decode(kind string, id string, value interface{}, result interface{}) error {
+	if text, ok := value.(string); ok {
+		if target, ok := result.(*string); ok {
+			*target = text
+			return nil
+		}
+		if json.Unmarshal([]byte(text), result) == nil {
+			return nil
+		}
+	}
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(data, result)
+	}
+	if err != nil {
+		return fmt.Errorf("%s '%s': cannot decode the %T value: %w", kind, id, value, err)
+	}
+	return nil
+}
+`)
+	return []byte(builder.String())
+}
+
+// comment returns the name of a resource, and its description if any, on a single line.
+func comment(name string, description string) string {
+	text := strings.Join(strings.Fields(name), " ")
+	if description = strings.Join(strings.Fields(description), " "); description != "" {
+		text += " (" + description + ")"
+	}
+	return text
+}
+
+// goName converts an ID or a JSON key to an exported Go identifier, for example `checkout-v2` to `CheckoutV2`.
+func goName(id string) string {
+	var builder strings.Builder
+	upper := true
+	for _, r := range id {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		builder.WriteRune(r)
+	}
+	name := builder.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
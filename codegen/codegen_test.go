@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `{
+	"environments": [{
+		"name": "Dev", "environment_id": "dev",
+		"features": [
+			{"name": "Checkout", "feature_id": "checkout", "description": "New checkout\nflow", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true},
+			{"name": "Banner", "feature_id": "banner-config", "type": "STRING", "format": "JSON", "enabled": true,
+				"enabled_value": {"title": "Hi", "colors": [{"name": "red", "hex": "#f00"}], "max-width": 3},
+				"disabled_value": "{\"title\": \"\", \"colors\": [], \"dismissible\": true}",
+				"segment_rules": [{"rules": [{"segments": ["beta"]}], "value": "$default", "order": 1}]},
+			{"name": "Timeout", "feature_id": "timeout", "type": "NUMERIC", "enabled_value": 5, "disabled_value": 1, "enabled": false}
+		],
+		"properties": [
+			{"name": "Timeout", "property_id": "timeout", "type": "STRING", "format": "YAML", "value": "a: 1"},
+			{"name": "Token", "property_id": "1token", "type": "SECRETREF", "value": {"id": "secret"}}
+		]
+	}, {
+		"name": "Prod", "environment_id": "prod",
+		"features": [
+			{"name": "Banner", "feature_id": "banner-config", "type": "STRING", "format": "JSON", "enabled": true,
+				"enabled_value": {"title": "Hello", "footer": null}, "disabled_value": {}}
+		]
+	}]
+}`
+
+func loadTestConfig(t *testing.T) *appconfigurationv1.ImportConfig {
+	config, err := evaluator.LoadConfig(strings.NewReader(testConfig))
+	require.Nil(t, err)
+	return config
+}
+
+// typeCheck parses and type-checks a generated package.
+func typeCheck(t *testing.T, source []byte) *types.Package {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "flags.go", source, parser.ParseComments)
+	require.Nil(t, err)
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := config.Check("flags", fset, []*ast.File{file}, nil)
+	require.Nil(t, err)
+	return pkg
+}
+
+func TestGenerate(t *testing.T) {
+	source, err := Generate(loadTestConfig(t), &Options{PackageName: "flags"})
+	require.Nil(t, err)
+	code := string(source)
+
+	assert.True(t, strings.HasPrefix(code, "// Code generated by the codegen package"))
+	assert.Contains(t, code, "package flags\n")
+	assert.Contains(t, code, "// FeatureCheckoutID is the ID of the feature Checkout (New checkout flow).\n")
+	assert.Contains(t, code, `FeatureBannerConfigID = "banner-config"`)
+	assert.Contains(t, code, `PropertyX1tokenID = "1token"`)
+	assert.Contains(t, code, "func (flags *Flags) Checkout(entityID string, attributes map[string]interface{}) (bool, error)")
+	assert.Contains(t, code, "func (flags *Flags) Timeout(entityID string, attributes map[string]interface{}) (float64, error)")
+	assert.Contains(t, code, "func (flags *Flags) TimeoutProperty(entityID string, attributes map[string]interface{}) (string, error)")
+	assert.Contains(t, code, "func (flags *Flags) X1token(entityID string, attributes map[string]interface{}) (map[string]interface{}, error)")
+	assert.Contains(t, code, "func (flags *Flags) BannerConfig(entityID string, attributes map[string]interface{}) (BannerConfigValue, error)")
+
+	pkg := typeCheck(t, source)
+	banner := pkg.Scope().Lookup("BannerConfigValue").Type().Underlying().(*types.Struct)
+	fields := map[string]string{}
+	for i := 0; i < banner.NumFields(); i++ {
+		fields[banner.Field(i).Name()] = banner.Field(i).Type().String()
+		assert.Contains(t, banner.Tag(i), ",omitempty")
+	}
+	assert.Equal(t, map[string]string{
+		"Colors":      "[]flags.BannerConfigValueColorsItem",
+		"Dismissible": "bool",
+		"Footer":      "interface{}",
+		"MaxWidth":    "float64",
+		"Title":       "string",
+	}, fields)
+	assert.NotNil(t, pkg.Scope().Lookup("BannerConfigValueColorsItem"))
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	first, err := Generate(loadTestConfig(t), &Options{PackageName: "flags"})
+	require.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		source, err := Generate(loadTestConfig(t), &Options{PackageName: "flags"})
+		require.Nil(t, err)
+		assert.Equal(t, string(first), string(source))
+	}
+}
+
+func TestGenerateInvalidPackageName(t *testing.T) {
+	for _, options := range []*Options{nil, {}, {PackageName: "my-flags"}, {PackageName: "1flags"}} {
+		_, err := Generate(loadTestConfig(t), options)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "a valid package name is required")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	assert.Equal(t, "CheckoutV2", goName("checkout-v2"))
+	assert.Equal(t, "MaxWidth", goName("max_width"))
+	assert.Equal(t, "X2fa", goName("2fa"))
+	assert.Equal(t, "X", goName("--"))
+}
+
+func TestGenerateFromFileAndWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	require.Nil(t, os.WriteFile(configPath, []byte(testConfig), 0644))
+	source, err := GenerateFromFile(configPath, &Options{PackageName: "flags"})
+	require.Nil(t, err)
+
+	outputPath := filepath.Join(dir, "flags.go")
+	require.Nil(t, WriteFile(outputPath, loadTestConfig(t), &Options{PackageName: "flags"}))
+	written, err := os.ReadFile(outputPath)
+	require.Nil(t, err)
+	assert.Equal(t, string(source), string(written))
+
+	_, err = GenerateFromFile(filepath.Join(dir, "missing.json"), &Options{PackageName: "flags"})
+	assert.NotNil(t, err)
+}
+
+func TestGenerateFromInstance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.Path {
+		case "/collections":
+			fmt.Fprint(res, `{"collections": [], "total_count": 0}`)
+		case "/segments":
+			fmt.Fprint(res, `{"segments": [], "total_count": 0}`)
+		case "/environments":
+			fmt.Fprint(res, `{"environments": [{"name": "Dev", "environment_id": "dev"}], "total_count": 1}`)
+		case "/environments/dev/features":
+			fmt.Fprint(res, `{"features": [{"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false}], "total_count": 1}`)
+		case "/environments/dev/properties":
+			fmt.Fprint(res, `{"properties": [{"name": "Limit", "property_id": "limit", "type": "NUMERIC", "value": 10}], "total_count": 1}`)
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	source, err := GenerateFromInstance(client, &Options{PackageName: "flags"})
+	require.Nil(t, err)
+	assert.Contains(t, string(source), "func (flags *Flags) Checkout(entityID string, attributes map[string]interface{}) (bool, error)")
+	assert.Contains(t, string(source), "func (flags *Flags) Limit(entityID string, attributes map[string]interface{}) (float64, error)")
+	typeCheck(t, source)
+}
+
+func TestSnapshotSource(t *testing.T) {
+	snapshot, err := evaluator.NewSnapshot(loadTestConfig(t), "dev")
+	require.Nil(t, err)
+	source := SnapshotSource{Snapshot: snapshot}
+
+	value, err := source.FeatureValue("checkout", "user-1", nil)
+	require.Nil(t, err)
+	assert.Equal(t, true, value)
+	value, err = source.FeatureValue("timeout", "user-1", nil)
+	require.Nil(t, err)
+	assert.Equal(t, float64(1), value)
+	value, err = source.PropertyValue("timeout", "user-1", nil)
+	require.Nil(t, err)
+	assert.Equal(t, "a: 1", value)
+
+	_, err = source.FeatureValue("missing", "user-1", nil)
+	assert.NotNil(t, err)
+}
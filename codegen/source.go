@@ -0,0 +1,39 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen
+
+import (
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+)
+
+// SnapshotSource : The Source of generated packages evaluating the features and properties of an environment
+// snapshot locally.
+type SnapshotSource struct {
+	Snapshot *evaluator.Snapshot
+}
+
+// FeatureValue returns the value of the feature featureID for an entity.
+func (source SnapshotSource) FeatureValue(featureID string, entityID string, attributes map[string]interface{}) (interface{}, error) {
+	result, err := source.Snapshot.EvaluateFeature(featureID, evaluator.Entity{ID: entityID, Attributes: attributes})
+	return result.Value, err
+}
+
+// PropertyValue returns the value of the property propertyID for an entity.
+func (source SnapshotSource) PropertyValue(propertyID string, entityID string, attributes map[string]interface{}) (interface{}, error) {
+	result, err := source.Snapshot.EvaluateProperty(propertyID, evaluator.Entity{ID: entityID, Attributes: attributes})
+	return result.Value, err
+}
@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The kinds of JSON values.
+const (
+	jsonNull   = "null"
+	jsonBool   = "bool"
+	jsonNumber = "number"
+	jsonString = "string"
+	jsonArray  = "array"
+	jsonObject = "object"
+	jsonAny    = "any"
+)
+
+// jsonType : The type inferred from JSON values.
+type jsonType struct {
+	kind string
+
+	// The type of the elements of an array.
+	element *jsonType
+
+	// The types of the fields of an object, and the number of values of the object that have each field.
+	fields map[string]*jsonType
+}
+
+// infer returns the type of a value decoded by encoding/json.
+func infer(value interface{}) *jsonType {
+	switch value := value.(type) {
+	case nil:
+		return &jsonType{kind: jsonNull}
+	case bool:
+		return &jsonType{kind: jsonBool}
+	case float64, int64, int:
+		return &jsonType{kind: jsonNumber}
+	case string:
+		return &jsonType{kind: jsonString}
+	case []interface{}:
+		var element *jsonType
+		for _, item := range value {
+			element = merge(element, infer(item))
+		}
+		return &jsonType{kind: jsonArray, element: element}
+	case map[string]interface{}:
+		fields := map[string]*jsonType{}
+		for key, item := range value {
+			fields[key] = infer(item)
+		}
+		return &jsonType{kind: jsonObject, fields: fields}
+	}
+	return &jsonType{kind: jsonAny}
+}
+
+// merge returns a type for the values of both a and b, either of which may be nil. Null values take the type of the
+// other values, and values of different kinds are of any type.
+func merge(a *jsonType, b *jsonType) *jsonType {
+	switch {
+	case a == nil || a.kind == jsonNull:
+		return b
+	case b == nil || b.kind == jsonNull:
+		return a
+	case a.kind != b.kind:
+		return &jsonType{kind: jsonAny}
+	case a.kind == jsonArray:
+		return &jsonType{kind: jsonArray, element: merge(a.element, b.element)}
+	case a.kind == jsonObject:
+		fields := map[string]*jsonType{}
+		for key, field := range a.fields {
+			fields[key] = field
+		}
+		for key, field := range b.fields {
+			fields[key] = merge(fields[key], field)
+		}
+		return &jsonType{kind: jsonObject, fields: fields}
+	}
+	return a
+}
+
+// goType returns the Go type of inferred, declaring the structs of its objects under names derived from name.
+func (generator *generator) goType(inferred *jsonType, name string) string {
+	if inferred == nil {
+		return "interface{}"
+	}
+	switch inferred.kind {
+	case jsonBool:
+		return "bool"
+	case jsonNumber:
+		return "float64"
+	case jsonString:
+		return "string"
+	case jsonArray:
+		return "[]" + generator.goType(inferred.element, name+"Item")
+	case jsonObject:
+		return generator.declareStruct(inferred, name)
+	}
+	return "interface{}"
+}
+
+// declareStruct declares a struct for an object type, with a field for each key, and returns its name.
+func (generator *generator) declareStruct(inferred *jsonType, name string) string {
+	name = generator.names.allocate(name)
+	keys := make([]string, 0, len(inferred.fields))
+	for key := range inferred.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := names{}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "// %s : A JSON value.\ntype %s struct {\n", name, name)
+	for _, key := range keys {
+		field := fields.allocate(goName(key))
+		fieldType := generator.goType(inferred.fields[key], name+field)
+		fmt.Fprintf(&builder, "\t%s %s `json:%q`\n", field, fieldType, key+",omitempty")
+	}
+	builder.WriteString("}\n\n")
+	generator.types = append(generator.types, builder.String())
+	return name
+}
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package coderefs
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTree = map[string]string{
+	"cmd/server/main.go": `package main
+
+const checkoutFlag = "checkout"
+
+func main() {
+	feature, _ := appConfiguration.GetFeature(checkoutFlag)
+	property, _ := appConfiguration.GetProperty("timeout")
+	enabled, _ := client.BooleanValue(ctx, "dark-mode", false, evaluationContext)
+	fmt.Println(GetFeature(variable), feature, property, enabled, GetFeature("removed-flag"))
+}
+`,
+	"cmd/broken.go":                 "package main\n\nfunc main() { GetFeature(\"broken\"\n",
+	"web/src/app.ts":                "const banner = appConfigClient.getFeature('banner');\nconst limit = appConfigClient.getProperty(\"timeout\").getCurrentValue(entity);\n",
+	"jobs/report.py":                "feature = app_config.get_feature(\"checkout\")\n",
+	"README.md":                     "Call getFeature('checkout') to read the flag.\n",
+	"web/node_modules/lib/index.js": "getFeature('vendored')\n",
+	"assets/logo.js":                "getFeature('binary')\x00\n",
+}
+
+func writeTestTree(t *testing.T) string {
+	root := t.TempDir()
+	for name, content := range testTree {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.Nil(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return root
+}
+
+func TestScanFindsReferences(t *testing.T) {
+	scanner, err := NewScanner(nil)
+	require.Nil(t, err)
+	references, err := scanner.Scan(writeTestTree(t))
+	require.Nil(t, err)
+
+	found := []string{}
+	for _, reference := range references {
+		found = append(found, reference.String())
+	}
+	assert.Equal(t, []string{
+		"cmd/server/main.go:6:44: feature 'checkout'",
+		"cmd/server/main.go:7:46: property 'timeout'",
+		"cmd/server/main.go:8:41: flag 'dark-mode'",
+		"cmd/server/main.go:9:75: feature 'removed-flag'",
+		"jobs/report.py:1:35: feature 'checkout'",
+		"web/src/app.ts:1:44: feature 'banner'",
+		"web/src/app.ts:2:44: property 'timeout'",
+	}, found)
+	assert.Equal(t, "feature, _ := appConfiguration.GetFeature(checkoutFlag)", references[0].Text)
+}
+
+func TestScanWithCustomOptions(t *testing.T) {
+	scanner, err := NewScanner(&Options{
+		GoCalls:     []GoCall{{Function: "IsEnabled", Argument: 0, Kind: KindFeature}},
+		Patterns:    []Pattern{{Expression: `getFeature\('([a-z]+)'\)`, Kind: KindFeature}},
+		ExcludeDirs: []string{"web"},
+	})
+	require.Nil(t, err)
+	references, err := scanner.Scan(writeTestTree(t))
+	require.Nil(t, err)
+	found := []string{}
+	for _, reference := range references {
+		found = append(found, reference.String())
+	}
+	assert.Equal(t, []string{"README.md:1:18: feature 'checkout'"}, found)
+
+	_, err = NewScanner(&Options{Patterns: []Pattern{{Expression: `getFeature`}}})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "has no group matching the ID")
+}
+
+func TestCrossReference(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.Path {
+		case "/environments":
+			fmt.Fprint(res, `{"environments": [{"name": "Dev", "environment_id": "dev"}, {"name": "Prod", "environment_id": "prod"}], "total_count": 2}`)
+		case "/environments/dev/features":
+			fmt.Fprint(res, `{"features": [{"name": "Checkout", "feature_id": "checkout"}, {"name": "Dark mode", "feature_id": "dark-mode"}, {"name": "Legacy", "feature_id": "legacy"}], "total_count": 3}`)
+		case "/environments/prod/features":
+			fmt.Fprint(res, `{"features": [{"name": "Checkout", "feature_id": "checkout"}], "total_count": 1}`)
+		case "/environments/dev/properties", "/environments/prod/properties":
+			fmt.Fprint(res, `{"properties": [{"name": "Timeout", "property_id": "timeout"}, {"name": "Dark mode", "property_id": "dark-mode"}], "total_count": 2}`)
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	scanner, err := NewScanner(nil)
+	require.Nil(t, err)
+	references, err := scanner.Scan(writeTestTree(t))
+	require.Nil(t, err)
+	report, err := CrossReference(client, references)
+	require.Nil(t, err)
+
+	summary := []string{}
+	for _, resource := range report.Resources {
+		summary = append(summary, fmt.Sprintf("%s %s %v %v", resource.Kind, resource.ID, resource.Environments, resource.Files()))
+	}
+	assert.Equal(t, []string{
+		"feature checkout [dev prod] [cmd/server/main.go jobs/report.py]",
+		"feature dark-mode [dev] [cmd/server/main.go]",
+		"feature legacy [dev] []",
+		"property dark-mode [dev prod] []",
+		"property timeout [dev prod] [cmd/server/main.go web/src/app.ts]",
+	}, summary)
+	assert.Equal(t, []Resource{
+		{Kind: KindFeature, ID: "legacy", Name: "Legacy", Environments: []string{"dev"}},
+		{Kind: KindProperty, ID: "dark-mode", Name: "Dark mode", Environments: []string{"dev", "prod"}},
+	}, report.Unreferenced())
+
+	missing := []string{}
+	for _, reference := range report.Missing {
+		missing = append(missing, reference.String())
+	}
+	assert.Equal(t, []string{"cmd/server/main.go:9:75: feature 'removed-flag'", "web/src/app.ts:1:44: feature 'banner'"}, missing)
+}
@@ -0,0 +1,194 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package coderefs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Resource : A feature or property of an instance.
+type Resource struct {
+	Kind Kind   `json:"kind"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// The environments that have the resource, sorted.
+	Environments []string `json:"environments"`
+}
+
+// ResourceReferences : A resource and the references to it.
+type ResourceReferences struct {
+	Resource
+	References []Reference `json:"references"`
+}
+
+// Report : The references to the features and properties of an instance.
+type Report struct {
+	// Every feature, then every property, sorted by ID, with their references.
+	Resources []ResourceReferences `json:"resources"`
+
+	// The references to IDs that are neither features nor properties of the instance.
+	Missing []Reference `json:"missing"`
+}
+
+// Unreferenced returns the resources of the report that no code references.
+func (report *Report) Unreferenced() []Resource {
+	unreferenced := []Resource{}
+	for _, resource := range report.Resources {
+		if len(resource.References) == 0 {
+			unreferenced = append(unreferenced, resource.Resource)
+		}
+	}
+	return unreferenced
+}
+
+// Files returns the files that reference the resource, sorted and without duplicates.
+func (resource *ResourceReferences) Files() []string {
+	files := []string{}
+	for _, reference := range resource.References {
+		if !slices.Contains(files, reference.File) {
+			files = append(files, reference.File)
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// CrossReference lists the features and properties of every environment of the instance of client and compares them
+// with references.
+func CrossReference(client *appconfigurationv1.AppConfigurationV1, references []Reference) (*Report, error) {
+	return CrossReferenceWithContext(context.Background(), client, references)
+}
+
+// CrossReferenceWithContext is an alternate form of the CrossReference method which supports a Context parameter
+func CrossReferenceWithContext(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, references []Reference) (*Report, error) {
+	resources, err := ListResources(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return NewReport(resources, references), nil
+}
+
+// ListResources returns the features and properties of every environment of the instance of client, each once.
+func ListResources(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) ([]Resource, error) {
+	environmentsPager, err := client.NewEnvironmentsPager(&appconfigurationv1.ListEnvironmentsOptions{Expand: core.BoolPtr(true)})
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-environments-error", common.GetComponentInfo())
+	}
+	environments, err := environmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-environments-error", common.GetComponentInfo())
+	}
+
+	resources := map[Kind]map[string]*Resource{KindFeature: {}, KindProperty: {}}
+	add := func(kind Kind, id *string, name *string, environmentID string) {
+		if id == nil {
+			return
+		}
+		resource := resources[kind][*id]
+		if resource == nil {
+			resource = &Resource{Kind: kind, ID: *id, Environments: []string{}}
+			if name != nil {
+				resource.Name = *name
+			}
+			resources[kind][*id] = resource
+		}
+		resource.Environments = append(resource.Environments, environmentID)
+	}
+	for _, environment := range environments {
+		environmentID := *environment.EnvironmentID
+		featuresPager, err := client.NewFeaturesPager(&appconfigurationv1.ListFeaturesOptions{EnvironmentID: environment.EnvironmentID})
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "list-features-error", common.GetComponentInfo())
+		}
+		features, err := featuresPager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("could not list the features of environment '%s'", environmentID), "list-features-error", common.GetComponentInfo())
+		}
+		for _, feature := range features {
+			add(KindFeature, feature.FeatureID, feature.Name, environmentID)
+		}
+
+		propertiesPager, err := client.NewPropertiesPager(&appconfigurationv1.ListPropertiesOptions{EnvironmentID: environment.EnvironmentID})
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "list-properties-error", common.GetComponentInfo())
+		}
+		properties, err := propertiesPager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("could not list the properties of environment '%s'", environmentID), "list-properties-error", common.GetComponentInfo())
+		}
+		for _, property := range properties {
+			add(KindProperty, property.PropertyID, property.Name, environmentID)
+		}
+	}
+
+	list := []Resource{}
+	for _, kind := range []Kind{KindFeature, KindProperty} {
+		for _, resource := range resources[kind] {
+			sort.Strings(resource.Environments)
+			list = append(list, *resource)
+		}
+	}
+	return list, nil
+}
+
+// NewReport compares references with resources. A reference of KindAny is to the feature with its ID or, if there
+// is none, to the property with its ID.
+func NewReport(resources []Resource, references []Reference) *Report {
+	report := &Report{Resources: []ResourceReferences{}, Missing: []Reference{}}
+	indexes := map[Kind]map[string]int{KindFeature: {}, KindProperty: {}}
+	sorted := slices.Clone(resources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Kind != sorted[j].Kind {
+			return sorted[i].Kind == KindFeature
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	for _, resource := range sorted {
+		if _, ok := indexes[resource.Kind]; !ok {
+			continue
+		}
+		indexes[resource.Kind][resource.ID] = len(report.Resources)
+		report.Resources = append(report.Resources, ResourceReferences{Resource: resource, References: []Reference{}})
+	}
+
+	for _, reference := range references {
+		kinds := []Kind{reference.Kind}
+		if reference.Kind == KindAny {
+			kinds = []Kind{KindFeature, KindProperty}
+		}
+		found := false
+		for _, kind := range kinds {
+			if index, ok := indexes[kind][reference.ID]; ok {
+				report.Resources[index].References = append(report.Resources[index].References, reference)
+				found = true
+				break
+			}
+		}
+		if !found {
+			report.Missing = append(report.Missing, reference)
+		}
+	}
+	return report
+}
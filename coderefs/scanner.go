@@ -0,0 +1,346 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package coderefs : References to feature flags and properties in source code
+// A Scanner walks a source tree and finds the feature and property IDs passed to the App Configuration runtime SDKs:
+// Go files are parsed, and the string literals and constants passed to the configured functions are reported, while
+// other files are matched with regular expressions. CrossReference compares the references with the features and
+// properties of an instance, to find the flags that no code uses before deleting them, and the code that uses flags
+// that do not exist.
+package coderefs
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Kind : The kind of resource a reference is to.
+type Kind string
+
+const (
+	// KindFeature is a reference to a feature flag.
+	KindFeature Kind = "feature"
+
+	// KindProperty is a reference to a property.
+	KindProperty Kind = "property"
+
+	// KindAny is a reference to a feature flag or, if there is no feature with its ID, to a property, for example the
+	// flag key of an OpenFeature evaluation.
+	KindAny Kind = ""
+)
+
+// GoCall : A Go function or method that takes the ID of a feature or property as a string argument.
+type GoCall struct {
+	// The name of the function or method, whatever its package or receiver.
+	Function string
+
+	// The index of the argument holding the ID.
+	Argument int
+
+	Kind Kind
+}
+
+// Pattern : A regular expression finding the IDs of features or properties in the files that are not Go files, which
+// are scanned with the GoCalls only.
+type Pattern struct {
+	// The expression, whose first group matches the ID.
+	Expression string
+
+	// The extensions of the files the expression applies to, for example ".js". All files if empty.
+	Extensions []string
+
+	Kind Kind
+}
+
+// DefaultGoCalls are the calls of the App Configuration Go SDK that read features and properties, and the flag
+// evaluations of OpenFeature clients and providers.
+var DefaultGoCalls = []GoCall{
+	{Function: "GetFeature", Argument: 0, Kind: KindFeature},
+	{Function: "GetProperty", Argument: 0, Kind: KindProperty},
+	{Function: "BooleanValue", Argument: 1},
+	{Function: "StringValue", Argument: 1},
+	{Function: "IntValue", Argument: 1},
+	{Function: "FloatValue", Argument: 1},
+	{Function: "ObjectValue", Argument: 1},
+	{Function: "BooleanValueDetails", Argument: 1},
+	{Function: "StringValueDetails", Argument: 1},
+	{Function: "IntValueDetails", Argument: 1},
+	{Function: "FloatValueDetails", Argument: 1},
+	{Function: "ObjectValueDetails", Argument: 1},
+	{Function: "BooleanEvaluation", Argument: 1},
+	{Function: "StringEvaluation", Argument: 1},
+	{Function: "IntEvaluation", Argument: 1},
+	{Function: "FloatEvaluation", Argument: 1},
+	{Function: "ObjectEvaluation", Argument: 1},
+}
+
+var sourceExtensions = []string{".js", ".jsx", ".ts", ".tsx", ".mjs", ".cjs", ".java", ".kt", ".py", ".swift"}
+
+// DefaultPatterns are the calls of the App Configuration runtime SDKs for Node.js, browsers, Java, Kotlin, Python,
+// and Swift that read features and properties.
+var DefaultPatterns = []Pattern{
+	{Expression: `\b(?:getFeature|get_feature)\(\s*["']([A-Za-z0-9_.-]+)["']`, Extensions: sourceExtensions, Kind: KindFeature},
+	{Expression: `\b(?:getProperty|get_property)\(\s*["']([A-Za-z0-9_.-]+)["']`, Extensions: sourceExtensions, Kind: KindProperty},
+}
+
+// DefaultExcludeDirs are the directories that are not scanned by default.
+var DefaultExcludeDirs = []string{".git", ".hg", ".svn", "node_modules", "vendor", "dist", "build", "target", "__pycache__"}
+
+// maxFileSize is the size above which files are not scanned, as they are unlikely to be source code.
+const maxFileSize = 2 << 20
+
+// Options : The options of a Scanner. The defaults apply to the nil fields.
+type Options struct {
+	GoCalls     []GoCall
+	Patterns    []Pattern
+	ExcludeDirs []string
+}
+
+// Reference : A reference to a feature or property in a file.
+type Reference struct {
+	Kind Kind   `json:"kind,omitempty"`
+	ID   string `json:"id"`
+
+	// The path of the file, relative to the scanned directory and with slashes.
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+
+	// The line of the reference, without its indentation.
+	Text string `json:"text"`
+}
+
+// String returns the location and ID of the reference, for example `pkg/checkout.go:12:5: feature 'checkout'`.
+func (reference Reference) String() string {
+	kind := reference.Kind
+	if kind == KindAny {
+		kind = "flag"
+	}
+	return fmt.Sprintf("%s:%d:%d: %s '%s'", reference.File, reference.Line, reference.Column, kind, reference.ID)
+}
+
+type compiledPattern struct {
+	Pattern
+	expression *regexp.Regexp
+}
+
+// Scanner : Finds the references to features and properties in source trees.
+type Scanner struct {
+	goCalls     map[string][]GoCall
+	patterns    []compiledPattern
+	excludeDirs []string
+}
+
+// NewScanner returns a scanner with options, which may be nil for the defaults.
+func NewScanner(options *Options) (*Scanner, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	scanner := &Scanner{goCalls: map[string][]GoCall{}, excludeDirs: options.ExcludeDirs}
+	goCalls, patterns := options.GoCalls, options.Patterns
+	if goCalls == nil {
+		goCalls = DefaultGoCalls
+	}
+	if patterns == nil {
+		patterns = DefaultPatterns
+	}
+	if scanner.excludeDirs == nil {
+		scanner.excludeDirs = DefaultExcludeDirs
+	}
+	for _, call := range goCalls {
+		if call.Function == "" || call.Argument < 0 {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid Go call '%s' (argument %d)", call.Function, call.Argument), "invalid-go-call", common.GetComponentInfo())
+		}
+		scanner.goCalls[call.Function] = append(scanner.goCalls[call.Function], call)
+	}
+	for _, pattern := range patterns {
+		expression, err := regexp.Compile(pattern.Expression)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("invalid pattern '%s'", pattern.Expression), "invalid-pattern", common.GetComponentInfo())
+		}
+		if expression.NumSubexp() < 1 {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("the pattern '%s' has no group matching the ID", pattern.Expression), "invalid-pattern", common.GetComponentInfo())
+		}
+		scanner.patterns = append(scanner.patterns, compiledPattern{Pattern: pattern, expression: expression})
+	}
+	return scanner, nil
+}
+
+// Scan walks the tree at root and returns its references, sorted by file and position. Go files that cannot be
+// parsed are skipped.
+func (scanner *Scanner) Scan(root string) ([]Reference, error) {
+	references := []Reference{}
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != root && slices.Contains(scanner.excludeDirs, entry.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil || info.Size() > maxFileSize {
+			return err
+		}
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		found, err := scanner.scanFile(path, filepath.ToSlash(relative))
+		references = append(references, found...)
+		return err
+	})
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "scan-error", common.GetComponentInfo())
+	}
+	sort.SliceStable(references, func(i, j int) bool {
+		a, b := references[i], references[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return references, nil
+}
+
+func (scanner *Scanner) scanFile(path string, name string) ([]Reference, error) {
+	extension := filepath.Ext(path)
+	if extension != ".go" && !slices.ContainsFunc(scanner.patterns, func(pattern compiledPattern) bool { return pattern.applies(extension) }) {
+		return nil, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.IndexByte(content, 0) >= 0 {
+		return nil, nil
+	}
+	lines := strings.Split(string(content), "\n")
+	reference := func(kind Kind, id string, line int, column int) Reference {
+		return Reference{Kind: kind, ID: id, File: name, Line: line, Column: column, Text: strings.TrimSpace(lines[line-1])}
+	}
+
+	references := []Reference{}
+	if extension == ".go" {
+		return scanner.scanGo(content, reference), nil
+	}
+	for _, pattern := range scanner.patterns {
+		if !pattern.applies(extension) {
+			continue
+		}
+		for i, line := range lines {
+			for _, match := range pattern.expression.FindAllStringSubmatchIndex(line, -1) {
+				if match[2] >= 0 {
+					references = append(references, reference(pattern.Kind, line[match[2]:match[3]], i+1, match[2]+1))
+				}
+			}
+		}
+	}
+	return references, nil
+}
+
+func (pattern compiledPattern) applies(extension string) bool {
+	return len(pattern.Extensions) == 0 || slices.Contains(pattern.Extensions, extension)
+}
+
+// scanGo returns the string literals and string constants of the file passed to the Go calls of the scanner.
+func (scanner *Scanner) scanGo(content []byte, reference func(Kind, string, int, int) Reference) []Reference {
+	files := token.NewFileSet()
+	file, err := parser.ParseFile(files, "", content, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	// The string constants declared in the file, at any level.
+	constants := map[string]string{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if declaration, ok := node.(*ast.GenDecl); ok && declaration.Tok == token.CONST {
+			for _, spec := range declaration.Specs {
+				spec := spec.(*ast.ValueSpec)
+				for i, name := range spec.Names {
+					if i < len(spec.Values) {
+						if value, ok := stringLiteral(spec.Values[i]); ok {
+							constants[name.Name] = value
+						}
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	references := []Reference{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var function string
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			function = fun.Sel.Name
+		case *ast.Ident:
+			function = fun.Name
+		}
+		for _, goCall := range scanner.goCalls[function] {
+			if goCall.Argument >= len(call.Args) {
+				continue
+			}
+			argument := call.Args[goCall.Argument]
+			id, ok := stringLiteral(argument)
+			if identifier, isIdentifier := argument.(*ast.Ident); isIdentifier {
+				id, ok = constants[identifier.Name]
+			}
+			if ok && id != "" {
+				position := files.Position(argument.Pos())
+				references = append(references, reference(goCall.Kind, id, position.Line, position.Column))
+			}
+		}
+		return true
+	})
+	return references
+}
+
+func stringLiteral(expression ast.Expr) (string, bool) {
+	literal, ok := expression.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(literal.Value)
+	return value, err == nil
+}
@@ -0,0 +1,316 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package evaluator : Local evaluation of feature flags and properties
+// The evaluator applies the same rules as the App Configuration runtime to the Feature, Property and Segment models
+// of this SDK: segment targeting in rule order, `$default` values, and percentage rollout bucketed on a hash of the
+// entity ID and the feature ID. It lets tests and local development run without the runtime service.
+package evaluator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/spaolacci/murmur3"
+)
+
+// DefaultValue is the segment rule value that stands for the value of the feature or property itself.
+const DefaultValue = "$default"
+
+// ErrEntityIDRequired is returned when a feature or property uses segment targeting or percentage rollout and the
+// entity has no ID.
+var ErrEntityIDRequired = errors.New("an entity ID is required to evaluate targeting rules")
+
+// Constants associated with the Result.Reason property.
+const (
+	// The feature is disabled.
+	ReasonDisabled = "DISABLED"
+
+	// The value of a segment rule matching the entity was used.
+	ReasonTargetingMatch = "TARGETING_MATCH"
+
+	// The value was chosen by percentage rollout.
+	ReasonSplit = "SPLIT"
+
+	// The feature or property has no targeting, or no rule matched the entity.
+	ReasonStatic = "STATIC"
+)
+
+// Constants associated with the Result.Variant property, besides the rule variants (see Result.Variant).
+const (
+	VariantEnabled  = "enabled"
+	VariantDisabled = "disabled"
+	VariantValue    = "value"
+)
+
+// Entity : The subject of an evaluation, for example a user or a device.
+type Entity struct {
+	// Unique identifier of the entity, used for percentage rollout.
+	ID string
+
+	// Attributes of the entity, matched against the segment rules.
+	Attributes map[string]interface{}
+}
+
+// Result : The outcome of an evaluation.
+type Result struct {
+	// The value of the feature or property for the entity.
+	Value interface{}
+
+	// Why this value was chosen, one of the Reason* constants.
+	Reason string
+
+	// `enabled`, `disabled` or `value` (for properties), or `rule:<name>` when a segment rule matched. The name is the
+	// rule name, or its order when the rule is not named.
+	Variant string
+}
+
+// Evaluator : Evaluates features and properties against a set of segments.
+type Evaluator struct {
+	segments map[string]appconfigurationv1.Segment
+}
+
+// New returns an evaluator that resolves segment IDs among segments.
+func New(segments []appconfigurationv1.Segment) *Evaluator {
+	evaluator := &Evaluator{segments: map[string]appconfigurationv1.Segment{}}
+	for _, segment := range segments {
+		if segment.SegmentID != nil {
+			evaluator.segments[*segment.SegmentID] = segment
+		}
+	}
+	return evaluator
+}
+
+// EvaluateFeature returns the value of a feature for an entity.
+func (evaluator *Evaluator) EvaluateFeature(feature *appconfigurationv1.Feature, entity Entity) (Result, error) {
+	if feature.Enabled == nil || !*feature.Enabled {
+		return Result{Value: feature.DisabledValue, Reason: ReasonDisabled, Variant: VariantDisabled}, nil
+	}
+	featureID := stringValue(feature.FeatureID)
+
+	rules := append([]appconfigurationv1.FeatureSegmentRule{}, feature.SegmentRules...)
+	sort.SliceStable(rules, func(i, j int) bool { return int64Value(rules[i].Order) < int64Value(rules[j].Order) })
+	for _, rule := range rules {
+		matched, err := evaluator.matchesAny(rule.Rules, entity)
+		if err != nil {
+			return Result{}, err
+		}
+		if !matched {
+			continue
+		}
+		variant := ruleVariant(rule.RuleName, rule.Order)
+		value := rule.Value
+		if value == DefaultValue {
+			value = feature.EnabledValue
+		}
+		percentage := feature.RolloutPercentage
+		if rule.RolloutPercentage != nil {
+			percentage = rule.RolloutPercentage
+		}
+		if percentage == nil || *percentage >= 100 {
+			return Result{Value: value, Reason: ReasonTargetingMatch, Variant: variant}, nil
+		}
+		if Bucket(entity.ID, featureID) < *percentage {
+			return Result{Value: value, Reason: ReasonSplit, Variant: variant}, nil
+		}
+		return Result{Value: feature.DisabledValue, Reason: ReasonSplit, Variant: VariantDisabled}, nil
+	}
+
+	if feature.RolloutPercentage == nil || *feature.RolloutPercentage >= 100 {
+		return Result{Value: feature.EnabledValue, Reason: ReasonStatic, Variant: VariantEnabled}, nil
+	}
+	if entity.ID == "" {
+		return Result{}, ErrEntityIDRequired
+	}
+	if Bucket(entity.ID, featureID) < *feature.RolloutPercentage {
+		return Result{Value: feature.EnabledValue, Reason: ReasonSplit, Variant: VariantEnabled}, nil
+	}
+	return Result{Value: feature.DisabledValue, Reason: ReasonSplit, Variant: VariantDisabled}, nil
+}
+
+// EvaluateProperty returns the value of a property for an entity.
+func (evaluator *Evaluator) EvaluateProperty(property *appconfigurationv1.Property, entity Entity) (Result, error) {
+	rules := append([]appconfigurationv1.SegmentRule{}, property.SegmentRules...)
+	sort.SliceStable(rules, func(i, j int) bool { return int64Value(rules[i].Order) < int64Value(rules[j].Order) })
+	for _, rule := range rules {
+		matched, err := evaluator.matchesAny(rule.Rules, entity)
+		if err != nil {
+			return Result{}, err
+		}
+		if matched {
+			value := rule.Value
+			if value == DefaultValue {
+				value = property.Value
+			}
+			return Result{Value: value, Reason: ReasonTargetingMatch, Variant: ruleVariant(nil, rule.Order)}, nil
+		}
+	}
+	return Result{Value: property.Value, Reason: ReasonStatic, Variant: VariantValue}, nil
+}
+
+// MatchesSegment reports whether an entity belongs to a segment: every rule of the segment must match.
+func MatchesSegment(segment *appconfigurationv1.Segment, entity Entity) bool {
+	for _, rule := range segment.Rules {
+		if !MatchesRule(rule, entity) {
+			return false
+		}
+	}
+	return len(segment.Rules) > 0
+}
+
+// MatchesRule reports whether an attribute of an entity satisfies a segment rule. Positive operators match if any of
+// the rule values matches; negative operators (isNot, notContains, notStartsWith, notEndsWith) match if none does.
+// A missing attribute never matches.
+func MatchesRule(rule appconfigurationv1.Rule, entity Entity) bool {
+	attribute, ok := entity.Attributes[stringValue(rule.AttributeName)]
+	if !ok || attribute == nil {
+		return false
+	}
+	operator := stringValue(rule.Operator)
+	positive, negated := negatedOperators[operator]
+	if negated {
+		operator = positive
+	}
+
+	for _, value := range rule.Values {
+		if matchesValue(operator, attribute, value) {
+			return !negated
+		}
+	}
+	return negated
+}
+
+// negatedOperators maps the negative operators to the positive operator they negate.
+var negatedOperators = map[string]string{
+	"isNot":         "is",
+	"notContains":   "contains",
+	"notStartsWith": "startsWith",
+	"notEndsWith":   "endsWith",
+}
+
+func matchesValue(operator string, attribute interface{}, value string) bool {
+	text := fmt.Sprint(attribute)
+	switch operator {
+	case "is":
+		if number, ok := toFloat(attribute); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				return number == parsed
+			}
+		}
+		return text == value
+	case "contains":
+		return strings.Contains(text, value)
+	case "startsWith":
+		return strings.HasPrefix(text, value)
+	case "endsWith":
+		return strings.HasSuffix(text, value)
+	case "greaterThan", "lesserThan", "greaterThanEquals", "lesserThanEquals":
+		number, ok := toFloat(attribute)
+		if !ok {
+			parsed, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return false
+			}
+			number = parsed
+		}
+		limit, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false
+		}
+		switch operator {
+		case "greaterThan":
+			return number > limit
+		case "lesserThan":
+			return number < limit
+		case "greaterThanEquals":
+			return number >= limit
+		default:
+			return number <= limit
+		}
+	}
+	return false
+}
+
+// Bucket returns the rollout bucket, from 0 to 99, of an entity for a feature. An entity is in a rollout of
+// percentage p if its bucket is lower than p.
+func Bucket(entityID string, featureID string) int64 {
+	hash := murmur3.Sum32WithSeed([]byte(entityID+":"+featureID), 0)
+	return int64(float64(hash) / math.Pow(2, 32) * 100)
+}
+
+// matchesAny reports whether the entity belongs to any segment listed in the targeting rules.
+func (evaluator *Evaluator) matchesAny(rules []appconfigurationv1.TargetSegments, entity Entity) (bool, error) {
+	if entity.ID == "" {
+		return false, ErrEntityIDRequired
+	}
+	for _, rule := range rules {
+		for _, segmentID := range rule.Segments {
+			segment, ok := evaluator.segments[segmentID]
+			if ok && MatchesSegment(&segment, entity) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func ruleVariant(name *string, order *int64) string {
+	if name != nil && *name != "" {
+		return "rule:" + *name
+	}
+	return fmt.Sprintf("rule:%d", int64Value(order))
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch number := value.(type) {
+	case float64:
+		return number, true
+	case float32:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case int32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case uint:
+		return float64(number), true
+	case uint32:
+		return float64(number), true
+	case uint64:
+		return float64(number), true
+	}
+	return 0, false
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `{
+	"environments": [{"name": "Dev", "environment_id": "dev",
+		"features": [
+			{"name": "checkout", "feature_id": "checkout", "type": "STRING", "format": "TEXT", "enabled_value": "new", "disabled_value": "old", "enabled": true,
+			 "segment_rules": [
+				{"rules": [{"segments": ["ibmers"]}], "value": "$default", "order": 2, "rule_name": "ibmers"},
+				{"rules": [{"segments": ["beta", "india"]}], "value": "beta", "order": 1}
+			 ]},
+			{"name": "banner", "feature_id": "banner", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true, "rollout_percentage": 50},
+			{"name": "off", "feature_id": "off", "type": "NUMERIC", "enabled_value": 1, "disabled_value": 0, "enabled": false}
+		],
+		"properties": [
+			{"name": "limit", "property_id": "limit", "type": "NUMERIC", "value": 10,
+			 "segment_rules": [{"rules": [{"segments": ["beta"]}], "value": 100, "order": 1}]}
+		]
+	}],
+	"segments": [
+		{"name": "ibmers", "segment_id": "ibmers", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com", "@in.ibm.com"]}]},
+		{"name": "beta", "segment_id": "beta", "rules": [
+			{"attribute_name": "plan", "operator": "isNot", "values": ["free", "trial"]},
+			{"attribute_name": "age", "operator": "greaterThanEquals", "values": ["18"]}
+		]},
+		{"name": "india", "segment_id": "india", "rules": [{"attribute_name": "country", "operator": "is", "values": ["IN"]}]}
+	]
+}`
+
+func newTestSnapshot(t *testing.T) *Snapshot {
+	config, err := LoadConfig(strings.NewReader(testConfig))
+	require.Nil(t, err)
+	snapshot, err := NewSnapshot(config, "dev")
+	require.Nil(t, err)
+	return snapshot
+}
+
+func TestEvaluateFeatureTargeting(t *testing.T) {
+	snapshot := newTestSnapshot(t)
+
+	result, err := snapshot.EvaluateFeature("checkout", Entity{ID: "u1", Attributes: map[string]interface{}{"email": "jane@ibm.com"}})
+	require.Nil(t, err)
+	assert.Equal(t, Result{Value: "new", Reason: ReasonTargetingMatch, Variant: "rule:ibmers"}, result)
+
+	// Rules are evaluated in order, whatever their position in the list.
+	result, err = snapshot.EvaluateFeature("checkout", Entity{ID: "u1", Attributes: map[string]interface{}{"email": "jane@ibm.com", "country": "IN"}})
+	require.Nil(t, err)
+	assert.Equal(t, Result{Value: "beta", Reason: ReasonTargetingMatch, Variant: "rule:1"}, result)
+
+	result, err = snapshot.EvaluateFeature("checkout", Entity{ID: "u1", Attributes: map[string]interface{}{"email": "jane@example.com"}})
+	require.Nil(t, err)
+	assert.Equal(t, Result{Value: "new", Reason: ReasonStatic, Variant: VariantEnabled}, result)
+
+	_, err = snapshot.EvaluateFeature("checkout", Entity{})
+	assert.True(t, errors.Is(err, ErrEntityIDRequired))
+
+	result, err = snapshot.EvaluateFeature("off", Entity{})
+	require.Nil(t, err)
+	assert.Equal(t, Result{Value: float64(0), Reason: ReasonDisabled, Variant: VariantDisabled}, result)
+
+	_, err = snapshot.EvaluateFeature("missing", Entity{ID: "u1"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestEvaluateFeatureRollout(t *testing.T) {
+	snapshot := newTestSnapshot(t)
+	assert.Equal(t, int64(24), Bucket("user1", "f1"))
+
+	enabled := 0
+	for _, id := range []string{"user1", "user2", "user3", "user4", "user5", "user6", "user7", "user8"} {
+		result, err := snapshot.EvaluateFeature("banner", Entity{ID: id})
+		require.Nil(t, err)
+		assert.Equal(t, ReasonSplit, result.Reason)
+		assert.Equal(t, Bucket(id, "banner") < 50, result.Value)
+		if result.Value == true {
+			enabled++
+		}
+		again, _ := snapshot.EvaluateFeature("banner", Entity{ID: id})
+		assert.Equal(t, result, again)
+	}
+	assert.True(t, enabled > 0 && enabled < 8)
+}
+
+func TestEvaluateProperty(t *testing.T) {
+	snapshot := newTestSnapshot(t)
+
+	result, err := snapshot.EvaluateProperty("limit", Entity{ID: "u1", Attributes: map[string]interface{}{"plan": "pro", "age": 30}})
+	require.Nil(t, err)
+	assert.Equal(t, Result{Value: float64(100), Reason: ReasonTargetingMatch, Variant: "rule:1"}, result)
+
+	result, err = snapshot.EvaluateProperty("limit", Entity{ID: "u1", Attributes: map[string]interface{}{"plan": "trial", "age": 30}})
+	require.Nil(t, err)
+	assert.Equal(t, Result{Value: float64(10), Reason: ReasonStatic, Variant: VariantValue}, result)
+}
+
+func TestMatchesRule(t *testing.T) {
+	entity := Entity{ID: "u1", Attributes: map[string]interface{}{"email": "jane@ibm.com", "age": 42, "score": "7.5"}}
+	cases := []struct {
+		attribute string
+		operator  string
+		values    []string
+		expected  bool
+	}{
+		{"email", "is", []string{"jane@ibm.com"}, true},
+		{"email", "isNot", []string{"john@ibm.com"}, true},
+		{"email", "contains", []string{"@ibm"}, true},
+		{"email", "notContains", []string{"@ibm", "@example"}, false},
+		{"email", "startsWith", []string{"john", "jane"}, true},
+		{"email", "notStartsWith", []string{"john"}, true},
+		{"email", "endsWith", []string{".org"}, false},
+		{"email", "notEndsWith", []string{".org"}, true},
+		{"age", "is", []string{"42.0"}, true},
+		{"age", "greaterThan", []string{"42"}, false},
+		{"age", "greaterThanEquals", []string{"42"}, true},
+		{"age", "lesserThan", []string{"50"}, true},
+		{"score", "lesserThanEquals", []string{"7.5"}, true},
+		{"email", "greaterThan", []string{"1"}, false},
+		{"missing", "isNot", []string{"x"}, false},
+	}
+	for _, c := range cases {
+		rule := appconfigurationv1.Rule{AttributeName: core.StringPtr(c.attribute), Operator: core.StringPtr(c.operator), Values: c.values}
+		assert.Equal(t, c.expected, MatchesRule(rule, entity), "%s %s %v", c.attribute, c.operator, c.values)
+	}
+}
+
+func TestLoadConfigCompressed(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	_, err := writer.Write([]byte(testConfig))
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	config, err := LoadConfig(&buffer)
+	require.Nil(t, err)
+	assert.Len(t, config.Segments, 3)
+
+	_, err = NewSnapshot(config, "prod")
+	assert.NotNil(t, err)
+	_, err = LoadConfig(strings.NewReader("not json"))
+	assert.NotNil(t, err)
+}
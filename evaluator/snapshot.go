@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrNotFound is returned when a snapshot has no feature or property with the requested ID.
+var ErrNotFound = errors.New("not found")
+
+// Snapshot : The features, properties and segments of one environment of an instance configuration.
+type Snapshot struct {
+	evaluator  *Evaluator
+	features   map[string]*appconfigurationv1.Feature
+	properties map[string]*appconfigurationv1.Property
+}
+
+// NewSnapshot indexes the environment environmentID of config, as returned by ListInstanceConfig.
+func NewSnapshot(config *appconfigurationv1.ImportConfig, environmentID string) (*Snapshot, error) {
+	var environment *appconfigurationv1.ImportEnvironmentSchema
+	for i := range config.Environments {
+		if config.Environments[i].EnvironmentID != nil && *config.Environments[i].EnvironmentID == environmentID {
+			environment = &config.Environments[i]
+		}
+	}
+	if environment == nil {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("environment '%s' is not in the configuration", environmentID), "unknown-environment", common.GetComponentInfo())
+	}
+
+	segments := make([]appconfigurationv1.Segment, len(config.Segments))
+	for i, segment := range config.Segments {
+		segments[i] = appconfigurationv1.Segment{
+			SegmentID:   segment.SegmentID,
+			Name:        segment.Name,
+			Description: segment.Description,
+			Tags:        segment.Tags,
+			Rules:       segment.Rules,
+		}
+	}
+	snapshot := &Snapshot{
+		evaluator:  New(segments),
+		features:   map[string]*appconfigurationv1.Feature{},
+		properties: map[string]*appconfigurationv1.Property{},
+	}
+	for _, feature := range environment.Features {
+		snapshot.features[*feature.FeatureID] = &appconfigurationv1.Feature{
+			FeatureID:            feature.FeatureID,
+			Name:                 feature.Name,
+			Description:          feature.Description,
+			Type:                 feature.Type,
+			Format:               feature.Format,
+			EnabledValue:         feature.EnabledValue,
+			DisabledValue:        feature.DisabledValue,
+			Enabled:              feature.Enabled,
+			RolloutPercentage:    feature.RolloutPercentage,
+			RolloutType:          feature.RolloutType,
+			RolloutConfiguration: feature.RolloutConfiguration,
+			Tags:                 feature.Tags,
+			SegmentRules:         feature.SegmentRules,
+			Collections:          feature.Collections,
+		}
+	}
+	for _, property := range environment.Properties {
+		snapshot.properties[*property.PropertyID] = &appconfigurationv1.Property{
+			PropertyID:   property.PropertyID,
+			Name:         property.Name,
+			Description:  property.Description,
+			Type:         property.Type,
+			Format:       property.Format,
+			Value:        property.Value,
+			Tags:         property.Tags,
+			SegmentRules: property.SegmentRules,
+			Collections:  property.Collections,
+		}
+	}
+	return snapshot, nil
+}
+
+// Feature returns the feature with the given ID.
+func (snapshot *Snapshot) Feature(featureID string) (*appconfigurationv1.Feature, bool) {
+	feature, ok := snapshot.features[featureID]
+	return feature, ok
+}
+
+// Property returns the property with the given ID.
+func (snapshot *Snapshot) Property(propertyID string) (*appconfigurationv1.Property, bool) {
+	property, ok := snapshot.properties[propertyID]
+	return property, ok
+}
+
+// EvaluateFeature returns the value of a feature of the snapshot for an entity.
+func (snapshot *Snapshot) EvaluateFeature(featureID string, entity Entity) (Result, error) {
+	feature, ok := snapshot.features[featureID]
+	if !ok {
+		return Result{}, fmt.Errorf("feature '%s': %w", featureID, ErrNotFound)
+	}
+	return snapshot.evaluator.EvaluateFeature(feature, entity)
+}
+
+// EvaluateProperty returns the value of a property of the snapshot for an entity.
+func (snapshot *Snapshot) EvaluateProperty(propertyID string, entity Entity) (Result, error) {
+	property, ok := snapshot.properties[propertyID]
+	if !ok {
+		return Result{}, fmt.Errorf("property '%s': %w", propertyID, ErrNotFound)
+	}
+	return snapshot.evaluator.EvaluateProperty(property, entity)
+}
+
+// LoadConfig reads an instance configuration in the JSON form returned by ListInstanceConfig. Gzip-compressed input,
+// such as the snapshots of the backup package, is decompressed.
+func LoadConfig(reader io.Reader) (*appconfigurationv1.ImportConfig, error) {
+	buffered := bufio.NewReader(reader)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "decompress-config-error", common.GetComponentInfo())
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	} else {
+		reader = buffered
+	}
+
+	var rawConfig map[string]json.RawMessage
+	if err := json.NewDecoder(reader).Decode(&rawConfig); err != nil {
+		return nil, core.SDKErrorf(err, "", "decode-config-error", common.GetComponentInfo())
+	}
+	config := &appconfigurationv1.ImportConfig{}
+	if err := core.UnmarshalModel(rawConfig, "", &config, appconfigurationv1.UnmarshalImportConfig); err != nil {
+		return nil, core.SDKErrorf(err, "", "decode-config-error", common.GetComponentInfo())
+	}
+	return config, nil
+}
+
+// LoadConfigFile reads an instance configuration from a file, see LoadConfig.
+func LoadConfigFile(path string) (*appconfigurationv1.ImportConfig, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "open-config-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	return LoadConfig(file)
+}
@@ -18,62 +18,36 @@ package main
 
 import (
 	"fmt"
-	"sync"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/appconfiguration-go-admin-sdk/profiles"
 	"github.com/IBM/go-sdk-core/v5/core"
 )
 
 var appConfigurationServiceInstance *appconfigurationv1.AppConfigurationV1
 
-func initAndReturnSingletonInstanceWithAPIKey(authToken string, guid string, region string) *appconfigurationv1.AppConfigurationV1 {
-
-	instanceURL := "https://" + region + ".apprapp.cloud.ibm.com/apprapp/feature/v1/instances/" + guid
-	var once sync.Once
-	if appConfigurationServiceInstance == nil {
-		once.Do(func() {
-			if appConfigurationServiceInstance == nil {
-				authenticator := &core.IamAuthenticator{
-					ApiKey: authToken,
-				}
-				options := &appconfigurationv1.AppConfigurationV1Options{
-					Authenticator: authenticator,
-					URL:           instanceURL,
-				}
-				var error error
-				appConfigurationServiceInstance, error = appconfigurationv1.NewAppConfigurationV1(options)
-				if error != nil {
-					fmt.Println("Error: " + error.Error())
-					return
-				}
-			}
-		})
-	}
-	return appConfigurationServiceInstance
-}
+// The registry builds one client per profile on first use and is safe for concurrent use. Profiles can also be
+// loaded from a file, see profiles.NewRegistryFromFile.
+var registry = profiles.NewRegistry(nil)
+
+func initAndReturnInstance(profileName string, authType string, authToken string, guid string, region string) *appconfigurationv1.AppConfigurationV1 {
 
-func initAndReturnSingletonInstanceWithBearerToken(authToken string, guid string, region string) *appconfigurationv1.AppConfigurationV1 {
-
-	instanceURL := "https://" + region + ".apprapp.cloud.ibm.com/apprapp/feature/v1/instances/" + guid
-	var once sync.Once
-	if appConfigurationServiceInstance == nil {
-		once.Do(func() {
-			if appConfigurationServiceInstance == nil {
-				authenticator := &core.BearerTokenAuthenticator{
-					BearerToken: authToken,
-				}
-				options := &appconfigurationv1.AppConfigurationV1Options{
-					Authenticator: authenticator,
-					URL:           instanceURL,
-				}
-				var error error
-				appConfigurationServiceInstance, error = appconfigurationv1.NewAppConfigurationV1(options)
-				if error != nil {
-					fmt.Println("Error: " + error.Error())
-					return
-				}
-			}
-		})
+	err := registry.Register(profileName, &profiles.Profile{
+		Region: region,
+		GUID:   guid,
+		Authenticator: profiles.AuthenticatorConfig{
+			Type:        authType,
+			Credentials: profiles.CredentialsSource{Value: authToken},
+		},
+	})
+	if err != nil {
+		fmt.Println("Error: " + err.Error())
+		return nil
+	}
+	appConfigurationServiceInstance, err = registry.Client(profileName)
+	if err != nil {
+		fmt.Println("Error: " + err.Error())
+		return nil
 	}
 	return appConfigurationServiceInstance
 }
@@ -84,7 +58,8 @@ func main() {
 	guid := "<guid>"
 	region := "<region>"
 
-	initAndReturnSingletonInstanceWithAPIKey(authToken, guid, region)
+	// Use profiles.AuthTypeBearerToken to authenticate with a bearer token instead of an API key.
+	initAndReturnInstance(profiles.DefaultProfileName, profiles.AuthTypeIam, authToken, guid, region)
 
 	createEnvironment("environmentId", "environmentName", "desc", "tags", "#FDD13A")
 	createCollection("collectionId", "collectionName", "desc", "tags")
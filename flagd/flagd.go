@@ -0,0 +1,359 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package flagd : Conversion of App Configuration exports to OpenFeature flagd flag definitions
+// Each environment of an instance configuration becomes a flagd document. Segments become shared `$evaluators`
+// (JsonLogic), the segment rules of a feature become an `if` chain in rule order, rollout percentages become
+// `fractional` splits, and the enabled, disabled and rule values become variants named as the Variant of the
+// evaluator package results (`enabled`, `disabled`, `rule:<name|order>`).
+//
+// Some App Configuration semantics have no flagd equivalent; they are reported as warnings rather than errors, and
+// the closest expression is used.
+package flagd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SchemaURL is the JSON schema of flagd flag definitions, set as `$schema` of the documents.
+const SchemaURL = "https://flagd.dev/schema/v0/flags.json"
+
+// Constants associated with the Flag.State property.
+const (
+	StateEnabled  = "ENABLED"
+	StateDisabled = "DISABLED"
+)
+
+// Document : A flagd flag definition file.
+type Document struct {
+	Schema string `json:"$schema,omitempty"`
+
+	// The flags, by key. The key is the feature ID.
+	Flags map[string]*Flag `json:"flags"`
+
+	// Shared targeting rules, referenced with `{"$ref": "<name>"}`. There is one per segment, see EvaluatorName.
+	Evaluators map[string]interface{} `json:"$evaluators,omitempty"`
+}
+
+// Flag : A flagd flag.
+type Flag struct {
+	State          string                 `json:"state"`
+	Variants       map[string]interface{} `json:"variants"`
+	DefaultVariant string                 `json:"defaultVariant"`
+	Targeting      interface{}            `json:"targeting,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Warning : A construct that could not be expressed exactly in flagd.
+type Warning struct {
+	// The feature or segment concerned.
+	Kind string
+	ID   string
+
+	Message string
+}
+
+// String returns the warning in the form `<kind> '<id>': <message>`.
+func (warning Warning) String() string {
+	return fmt.Sprintf("%s '%s': %s", warning.Kind, warning.ID, warning.Message)
+}
+
+// EvaluatorName returns the name of the `$evaluators` entry of a segment.
+func EvaluatorName(segmentID string) string {
+	return "segment-" + segmentID
+}
+
+// Convert returns the flagd document of the features of the environment environmentID of config, as returned by
+// ListInstanceConfig, with the warnings of the conversion.
+//
+// A disabled feature is converted to an enabled flag whose default variant is `disabled`, since App Configuration
+// serves the disabled value where flagd would report a disabled flag as an error.
+func Convert(config *appconfigurationv1.ImportConfig, environmentID string) (*Document, []Warning, error) {
+	var environment *appconfigurationv1.ImportEnvironmentSchema
+	for i := range config.Environments {
+		if stringValue(config.Environments[i].EnvironmentID) == environmentID {
+			environment = &config.Environments[i]
+		}
+	}
+	if environment == nil {
+		return nil, nil, core.SDKErrorf(nil, fmt.Sprintf("environment '%s' is not in the configuration", environmentID), "unknown-environment", common.GetComponentInfo())
+	}
+
+	converter := &converter{segments: map[string]bool{}}
+	document := &Document{Schema: SchemaURL, Flags: map[string]*Flag{}, Evaluators: map[string]interface{}{}}
+	for _, segment := range config.Segments {
+		segmentID := stringValue(segment.SegmentID)
+		converter.segments[segmentID] = true
+		document.Evaluators[EvaluatorName(segmentID)] = converter.segment(segment)
+	}
+	for _, feature := range environment.Features {
+		document.Flags[stringValue(feature.FeatureID)] = converter.feature(feature)
+	}
+	if len(document.Evaluators) == 0 {
+		document.Evaluators = nil
+	}
+	return document, converter.warnings, nil
+}
+
+// ConvertAll converts every environment of config, see Convert. The documents are returned by environment ID.
+func ConvertAll(config *appconfigurationv1.ImportConfig) (map[string]*Document, []Warning, error) {
+	documents := map[string]*Document{}
+	var warnings []Warning
+	for _, environment := range config.Environments {
+		environmentID := stringValue(environment.EnvironmentID)
+		document, environmentWarnings, err := Convert(config, environmentID)
+		if err != nil {
+			return nil, nil, err
+		}
+		documents[environmentID] = document
+		// Segment warnings are the same for every environment.
+		for _, warning := range environmentWarnings {
+			if warning.Kind == "feature" || len(documents) == 1 {
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+	return documents, warnings, nil
+}
+
+// Marshal returns the indented JSON form of a document.
+func Marshal(document *Document) ([]byte, error) {
+	buffer, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "marshal-flagd-error", common.GetComponentInfo())
+	}
+	return buffer, nil
+}
+
+type converter struct {
+	segments map[string]bool
+	warnings []Warning
+}
+
+func (converter *converter) warn(kind string, id string, format string, args ...interface{}) {
+	converter.warnings = append(converter.warnings, Warning{Kind: kind, ID: id, Message: fmt.Sprintf(format, args...)})
+}
+
+// segment returns the JsonLogic condition of a segment: all of its rules must match.
+func (converter *converter) segment(segment appconfigurationv1.ImportSegmentSchema) interface{} {
+	segmentID := stringValue(segment.SegmentID)
+	conditions := []interface{}{}
+	for _, rule := range segment.Rules {
+		conditions = append(conditions, converter.rule(segmentID, rule))
+	}
+	if len(conditions) == 0 {
+		converter.warn("segment", segmentID, "the segment has no rules and never matches")
+		return false
+	}
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return map[string]interface{}{"and": conditions}
+}
+
+// rule returns the JsonLogic condition of a segment rule, following evaluator.MatchesRule.
+func (converter *converter) rule(segmentID string, rule appconfigurationv1.Rule) interface{} {
+	attribute := stringValue(rule.AttributeName)
+	operator := stringValue(rule.Operator)
+	if strings.Contains(attribute, ".") {
+		converter.warn("segment", segmentID, "attribute '%s' is read as a nested path by flagd", attribute)
+	}
+	variable := map[string]interface{}{"var": attribute}
+
+	var build func(value string) (interface{}, bool)
+	positive, negated := map[string]string{
+		"isNot":         "is",
+		"notContains":   "contains",
+		"notStartsWith": "startsWith",
+		"notEndsWith":   "endsWith",
+	}[operator]
+	if !negated {
+		positive = operator
+	}
+	switch positive {
+	case "is":
+		build = func(value string) (interface{}, bool) {
+			// JsonLogic equality is loose, so numeric attributes match numeric strings as in App Configuration.
+			return map[string]interface{}{"==": []interface{}{variable, value}}, true
+		}
+	case "contains":
+		build = func(value string) (interface{}, bool) {
+			return map[string]interface{}{"in": []interface{}{value, variable}}, true
+		}
+	case "startsWith":
+		build = func(value string) (interface{}, bool) {
+			return map[string]interface{}{"starts_with": []interface{}{variable, value}}, true
+		}
+	case "endsWith":
+		build = func(value string) (interface{}, bool) {
+			return map[string]interface{}{"ends_with": []interface{}{variable, value}}, true
+		}
+	case "greaterThan", "lesserThan", "greaterThanEquals", "lesserThanEquals":
+		symbol := map[string]string{"greaterThan": ">", "lesserThan": "<", "greaterThanEquals": ">=", "lesserThanEquals": "<="}[positive]
+		build = func(value string) (interface{}, bool) {
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, false
+			}
+			return map[string]interface{}{symbol: []interface{}{variable, number}}, true
+		}
+	default:
+		converter.warn("segment", segmentID, "operator '%s' is not supported, the rule never matches", operator)
+		return false
+	}
+
+	alternatives := []interface{}{}
+	for _, value := range rule.Values {
+		condition, ok := build(value)
+		if !ok {
+			converter.warn("segment", segmentID, "value '%s' of operator '%s' is not a number and is ignored", value, operator)
+			continue
+		}
+		alternatives = append(alternatives, condition)
+	}
+	var condition interface{} = false
+	if len(alternatives) == 1 {
+		condition = alternatives[0]
+	} else if len(alternatives) > 1 {
+		condition = map[string]interface{}{"or": alternatives}
+	}
+	if !negated {
+		return condition
+	}
+	// A missing attribute never matches, not even a negative operator.
+	return map[string]interface{}{"and": []interface{}{
+		map[string]interface{}{"!": map[string]interface{}{"missing": []interface{}{attribute}}},
+		map[string]interface{}{"!": condition},
+	}}
+}
+
+func (converter *converter) feature(feature appconfigurationv1.ImportFeatureRequestBody) *Flag {
+	featureID := stringValue(feature.FeatureID)
+	flag := &Flag{
+		State: StateEnabled,
+		Variants: map[string]interface{}{
+			evaluator.VariantEnabled:  feature.EnabledValue,
+			evaluator.VariantDisabled: feature.DisabledValue,
+		},
+		DefaultVariant: evaluator.VariantEnabled,
+		Metadata:       map[string]interface{}{"name": stringValue(feature.Name)},
+	}
+	if feature.Type != nil {
+		flag.Metadata["type"] = *feature.Type
+	}
+	if feature.Format != nil {
+		flag.Metadata["format"] = *feature.Format
+	}
+	if feature.Enabled == nil || !*feature.Enabled {
+		flag.DefaultVariant = evaluator.VariantDisabled
+		return flag
+	}
+	if stringValue(feature.RolloutType) == appconfigurationv1.ImportFeatureRequestBody_RolloutType_Progressive {
+		converter.warn("feature", featureID, "progressive rollout is exported as a fixed split at the current percentage")
+	}
+
+	rules := append([]appconfigurationv1.FeatureSegmentRule{}, feature.SegmentRules...)
+	sort.SliceStable(rules, func(i, j int) bool { return int64Value(rules[i].Order) < int64Value(rules[j].Order) })
+	chain := []interface{}{}
+	for _, rule := range rules {
+		references := []interface{}{}
+		for _, targets := range rule.Rules {
+			for _, segmentID := range targets.Segments {
+				if !converter.segments[segmentID] {
+					converter.warn("feature", featureID, "segment '%s' is not in the configuration and is ignored", segmentID)
+					continue
+				}
+				references = append(references, map[string]interface{}{"$ref": EvaluatorName(segmentID)})
+			}
+		}
+		if len(references) == 0 {
+			continue
+		}
+		if stringValue(rule.RolloutType) == appconfigurationv1.FeatureSegmentRule_RolloutType_Progressive {
+			converter.warn("feature", featureID, "progressive rollout of rule %d is exported as a fixed split at the current percentage", int64Value(rule.Order))
+		}
+
+		variant := evaluator.VariantEnabled
+		if rule.Value != evaluator.DefaultValue {
+			variant = ruleVariant(rule.RuleName, rule.Order)
+			flag.Variants[variant] = rule.Value
+		}
+		percentage := feature.RolloutPercentage
+		if rule.RolloutPercentage != nil {
+			percentage = rule.RolloutPercentage
+		}
+		var condition interface{} = references[0]
+		if len(references) > 1 {
+			condition = map[string]interface{}{"or": references}
+		}
+		chain = append(chain, condition, outcome(variant, percentage))
+	}
+
+	fallback := outcome(evaluator.VariantEnabled, feature.RolloutPercentage)
+	if len(chain) == 0 {
+		if _, split := fallback.(map[string]interface{}); split {
+			flag.Targeting = fallback
+		}
+		return flag
+	}
+	flag.Targeting = map[string]interface{}{"if": append(chain, fallback)}
+	return flag
+}
+
+// outcome returns the variant name, or a fractional split between the variant and `disabled` if the percentage is
+// below 100. The split is bucketed on `<targetingKey>:<flagKey>`, the key App Configuration hashes.
+func outcome(variant string, percentage *int64) interface{} {
+	if percentage == nil || *percentage >= 100 {
+		return variant
+	}
+	return map[string]interface{}{"fractional": []interface{}{
+		map[string]interface{}{"cat": []interface{}{
+			map[string]interface{}{"var": "targetingKey"}, ":", map[string]interface{}{"var": "$flagd.flagKey"},
+		}},
+		[]interface{}{variant, *percentage},
+		[]interface{}{evaluator.VariantDisabled, 100 - *percentage},
+	}}
+}
+
+func ruleVariant(name *string, order *int64) string {
+	if name != nil && *name != "" {
+		return "rule:" + *name
+	}
+	return fmt.Sprintf("rule:%d", int64Value(order))
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
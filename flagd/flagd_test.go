@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flagd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `{
+	"environments": [
+		{"name": "Dev", "environment_id": "dev",
+		 "features": [
+			{"name": "Checkout", "feature_id": "checkout", "type": "STRING", "format": "TEXT", "enabled_value": "new", "disabled_value": "old", "enabled": true,
+			 "rollout_percentage": 100,
+			 "segment_rules": [
+				{"rules": [{"segments": ["ibmers"]}], "value": "$default", "order": 2, "rollout_percentage": 30},
+				{"rules": [{"segments": ["beta", "unknown"]}], "value": "beta", "order": 1, "rule_name": "beta"}
+			 ]},
+			{"name": "Banner", "feature_id": "banner", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true,
+			 "rollout_percentage": 40, "rollout_type": "PROGRESSIVE"},
+			{"name": "Off", "feature_id": "off", "type": "NUMERIC", "enabled_value": 1, "disabled_value": 0, "enabled": false}
+		 ]},
+		{"name": "Prod", "environment_id": "prod", "features": []}
+	],
+	"segments": [
+		{"name": "ibmers", "segment_id": "ibmers", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com", "@in.ibm.com"]}]},
+		{"name": "beta", "segment_id": "beta", "rules": [
+			{"attribute_name": "plan", "operator": "isNot", "values": ["free"]},
+			{"attribute_name": "age", "operator": "greaterThanEquals", "values": ["18", "adult"]}
+		]},
+		{"name": "odd", "segment_id": "odd", "rules": [{"attribute_name": "user.tier", "operator": "matches", "values": ["gold"]}]}
+	]
+}`
+
+func loadTestConfig(t *testing.T) *appconfigurationv1.ImportConfig {
+	config, err := evaluator.LoadConfig(strings.NewReader(testConfig))
+	require.Nil(t, err)
+	return config
+}
+
+func assertJSON(t *testing.T, expected string, actual interface{}) {
+	buffer, err := json.Marshal(actual)
+	require.Nil(t, err)
+	assert.JSONEq(t, expected, string(buffer))
+}
+
+func TestConvertSegments(t *testing.T) {
+	document, _, err := Convert(loadTestConfig(t), "dev")
+	require.Nil(t, err)
+
+	assertJSON(t, `{"or": [
+		{"ends_with": [{"var": "email"}, "@ibm.com"]},
+		{"ends_with": [{"var": "email"}, "@in.ibm.com"]}
+	]}`, document.Evaluators["segment-ibmers"])
+	assertJSON(t, `{"and": [
+		{"and": [{"!": {"missing": ["plan"]}}, {"!": {"==": [{"var": "plan"}, "free"]}}]},
+		{">=": [{"var": "age"}, 18]}
+	]}`, document.Evaluators["segment-beta"])
+	assert.Equal(t, false, document.Evaluators["segment-odd"])
+}
+
+func TestConvertFeatures(t *testing.T) {
+	document, _, err := Convert(loadTestConfig(t), "dev")
+	require.Nil(t, err)
+	assert.Equal(t, SchemaURL, document.Schema)
+
+	checkout := document.Flags["checkout"]
+	assert.Equal(t, StateEnabled, checkout.State)
+	assert.Equal(t, "enabled", checkout.DefaultVariant)
+	assert.Equal(t, map[string]interface{}{"enabled": "new", "disabled": "old", "rule:beta": "beta"}, checkout.Variants)
+	assertJSON(t, `{"if": [
+		{"$ref": "segment-beta"}, "rule:beta",
+		{"$ref": "segment-ibmers"}, {"fractional": [
+			{"cat": [{"var": "targetingKey"}, ":", {"var": "$flagd.flagKey"}]},
+			["enabled", 30], ["disabled", 70]
+		]},
+		"enabled"
+	]}`, checkout.Targeting)
+
+	banner := document.Flags["banner"]
+	assertJSON(t, `{"fractional": [
+		{"cat": [{"var": "targetingKey"}, ":", {"var": "$flagd.flagKey"}]},
+		["enabled", 40], ["disabled", 60]
+	]}`, banner.Targeting)
+	assert.Equal(t, "BOOLEAN", banner.Metadata["type"])
+
+	off := document.Flags["off"]
+	assert.Equal(t, StateEnabled, off.State)
+	assert.Equal(t, "disabled", off.DefaultVariant)
+	assert.Nil(t, off.Targeting)
+
+	buffer, err := Marshal(document)
+	require.Nil(t, err)
+	assert.Contains(t, string(buffer), `"$evaluators"`)
+	assert.Contains(t, string(buffer), `"defaultVariant": "disabled"`)
+}
+
+func TestConvertWarnings(t *testing.T) {
+	_, warnings, err := Convert(loadTestConfig(t), "dev")
+	require.Nil(t, err)
+
+	messages := []string{}
+	for _, warning := range warnings {
+		messages = append(messages, warning.String())
+	}
+	assert.ElementsMatch(t, []string{
+		"segment 'beta': value 'adult' of operator 'greaterThanEquals' is not a number and is ignored",
+		"segment 'odd': attribute 'user.tier' is read as a nested path by flagd",
+		"segment 'odd': operator 'matches' is not supported, the rule never matches",
+		"feature 'checkout': segment 'unknown' is not in the configuration and is ignored",
+		"feature 'banner': progressive rollout is exported as a fixed split at the current percentage",
+	}, messages)
+
+	_, _, err = Convert(loadTestConfig(t), "staging")
+	assert.NotNil(t, err)
+}
+
+func TestConvertAll(t *testing.T) {
+	documents, warnings, err := ConvertAll(loadTestConfig(t))
+	require.Nil(t, err)
+	assert.Len(t, documents, 2)
+	assert.Len(t, documents["dev"].Flags, 3)
+	assert.Len(t, documents["prod"].Flags, 0)
+	assert.Len(t, documents["prod"].Evaluators, 3)
+	// Segment warnings are reported once.
+	assert.Len(t, warnings, 5)
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package importers : Conversion of other feature flag platforms' exports to App Configuration imports
+// FromLaunchDarkly and FromUnleash read export files and return ImportConfigOptions for ImportConfig, together with a
+// Report of what could not be converted exactly. The options leave `clean` unset, so the import is merged into the
+// instance unless SetClean("true") is called.
+//
+// Targeting rules become segments (App Configuration segments match when all their rules match) referenced by
+// feature segment rules, in evaluation order. Percentage rollouts keep their percentage, but App Configuration buckets
+// entities on their ID and the feature ID, so the entities in a rollout differ from those of the source platform.
+package importers
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// LossyMapping : A construct of the source that was dropped or converted approximately.
+type LossyMapping struct {
+	// `feature`, `segment`, `environment` or `collection`.
+	Kind string
+
+	// The key of the construct in the source.
+	ID string
+
+	// The environment concerned, if the construct is specific to one.
+	EnvironmentID string
+
+	Message string
+}
+
+// String returns the mapping in the form `<kind> '<id>' in environment '<environment>': <message>`.
+func (mapping LossyMapping) String() string {
+	if mapping.EnvironmentID == "" {
+		return fmt.Sprintf("%s '%s': %s", mapping.Kind, mapping.ID, mapping.Message)
+	}
+	return fmt.Sprintf("%s '%s' in environment '%s': %s", mapping.Kind, mapping.ID, mapping.EnvironmentID, mapping.Message)
+}
+
+// Report : The lossy mappings of a conversion, in the order they were found.
+type Report struct {
+	Lossy []LossyMapping
+}
+
+// String returns one lossy mapping per line.
+func (report *Report) String() string {
+	lines := make([]string, len(report.Lossy))
+	for i, mapping := range report.Lossy {
+		lines[i] = mapping.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (report *Report) add(kind string, id string, environmentID string, format string, args ...interface{}) {
+	report.Lossy = append(report.Lossy, LossyMapping{Kind: kind, ID: id, EnvironmentID: environmentID, Message: fmt.Sprintf(format, args...)})
+}
+
+// builder accumulates the schemas of an import.
+type builder struct {
+	report       *Report
+	environments []appconfigurationv1.ImportEnvironmentSchema
+	collections  []appconfigurationv1.ImportCollectionSchema
+	segments     []appconfigurationv1.ImportSegmentSchema
+	segmentIndex map[string]int
+}
+
+func newBuilder() *builder {
+	return &builder{report: &Report{}, segmentIndex: map[string]int{}}
+}
+
+func (builder *builder) options() *appconfigurationv1.ImportConfigOptions {
+	return &appconfigurationv1.ImportConfigOptions{
+		Environments: builder.environments,
+		Collections:  builder.collections,
+		Segments:     builder.segments,
+	}
+}
+
+func (builder *builder) addCollection(id string, name string, description string) {
+	collection := appconfigurationv1.ImportCollectionSchema{CollectionID: core.StringPtr(id), Name: core.StringPtr(name)}
+	if description != "" {
+		collection.Description = core.StringPtr(description)
+	}
+	builder.collections = append(builder.collections, collection)
+}
+
+func newEnvironment(id string, name string, colorCode string) appconfigurationv1.ImportEnvironmentSchema {
+	environment := appconfigurationv1.ImportEnvironmentSchema{
+		EnvironmentID: core.StringPtr(id),
+		Name:          core.StringPtr(name),
+		Features:      []appconfigurationv1.ImportFeatureRequestBody{},
+	}
+	if colorCode != "" {
+		environment.ColorCode = core.StringPtr(colorCode)
+	}
+	return environment
+}
+
+// addSegment adds a segment and returns its ID. Segments of the source are often scoped to an environment while those
+// of App Configuration are not: a segment identical to one already added is reused, and one with the same candidate ID
+// but different rules is suffixed with the environment ID.
+func (builder *builder) addSegment(candidate string, environmentID string, name string, description string, rules []appconfigurationv1.Rule) string {
+	id := candidate
+	if index, ok := builder.segmentIndex[id]; ok {
+		if reflect.DeepEqual(builder.segments[index].Rules, rules) {
+			return id
+		}
+		id = candidate + "-" + environmentID
+		if index, ok := builder.segmentIndex[id]; ok && reflect.DeepEqual(builder.segments[index].Rules, rules) {
+			return id
+		}
+	}
+	segment := appconfigurationv1.ImportSegmentSchema{SegmentID: core.StringPtr(id), Name: core.StringPtr(name), Rules: rules}
+	if description != "" {
+		segment.Description = core.StringPtr(description)
+	}
+	builder.segmentIndex[id] = len(builder.segments)
+	builder.segments = append(builder.segments, segment)
+	return id
+}
+
+var invalidIDCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// sanitizeID replaces the characters not allowed in App Configuration IDs with hyphens.
+func sanitizeID(id string) string {
+	return invalidIDCharacters.ReplaceAllString(id, "-")
+}
+
+// valueType returns the App Configuration type and format of a set of values: BOOLEAN, NUMERIC, STRING/TEXT if they
+// all have that type, STRING/JSON otherwise. The last result reports whether the values were of mixed types.
+func valueType(values []interface{}) (string, string, bool) {
+	kinds := map[string]bool{}
+	for _, value := range values {
+		switch value.(type) {
+		case bool:
+			kinds[appconfigurationv1.ImportFeatureRequestBody_Type_Boolean] = true
+		case float64, int, int64:
+			kinds[appconfigurationv1.ImportFeatureRequestBody_Type_Numeric] = true
+		case string:
+			kinds[appconfigurationv1.ImportFeatureRequestBody_Format_Text] = true
+		default:
+			kinds[appconfigurationv1.ImportFeatureRequestBody_Format_JSON] = true
+		}
+	}
+	if len(kinds) == 1 {
+		for kind := range kinds {
+			switch kind {
+			case appconfigurationv1.ImportFeatureRequestBody_Type_Boolean, appconfigurationv1.ImportFeatureRequestBody_Type_Numeric:
+				return kind, "", false
+			default:
+				return appconfigurationv1.ImportFeatureRequestBody_Type_String, kind, false
+			}
+		}
+	}
+	return appconfigurationv1.ImportFeatureRequestBody_Type_String, appconfigurationv1.ImportFeatureRequestBody_Format_JSON, len(kinds) > 1
+}
+
+func newRule(attribute string, operator string, values []string) appconfigurationv1.Rule {
+	return appconfigurationv1.Rule{AttributeName: core.StringPtr(attribute), Operator: core.StringPtr(operator), Values: values}
+}
+
+func stringValues(values []interface{}) []string {
+	converted := make([]string, len(values))
+	for i, value := range values {
+		converted[i] = fmt.Sprint(value)
+	}
+	return converted
+}
+
+func joinTags(tags []string) *string {
+	if len(tags) == 0 {
+		return nil
+	}
+	sorted := append([]string{}, tags...)
+	sort.Strings(sorted)
+	return core.StringPtr(strings.Join(sorted, ","))
+}
@@ -0,0 +1,472 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package importers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// LaunchDarklyTargetAttribute is the attribute that individual targets of LaunchDarkly flags and segments are matched
+// on. Applications must pass the LaunchDarkly context key under this attribute.
+const LaunchDarklyTargetAttribute = "key"
+
+type ldExport struct {
+	Project  ldProject             `json:"project"`
+	Flags    ldFlags               `json:"flags"`
+	Segments map[string]ldSegments `json:"segments"`
+}
+
+type ldProject struct {
+	Key          string         `json:"key"`
+	Name         string         `json:"name"`
+	Environments ldEnvironments `json:"environments"`
+}
+
+// ldEnvironments accepts both the `{"items": [...]}` form of the API and a plain list.
+type ldEnvironments []ldEnvironment
+
+func (environments *ldEnvironments) UnmarshalJSON(data []byte) error {
+	var list []ldEnvironment
+	if err := json.Unmarshal(data, &list); err == nil {
+		*environments = list
+		return nil
+	}
+	var wrapper struct {
+		Items []ldEnvironment `json:"items"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	*environments = wrapper.Items
+	return nil
+}
+
+type ldEnvironment struct {
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type ldFlags struct {
+	Items []ldFlag `json:"items"`
+}
+
+type ldFlag struct {
+	Key          string                       `json:"key"`
+	Name         string                       `json:"name"`
+	Description  string                       `json:"description"`
+	Tags         []string                     `json:"tags"`
+	Variations   []ldVariation                `json:"variations"`
+	Environments map[string]ldFlagEnvironment `json:"environments"`
+}
+
+type ldVariation struct {
+	Value interface{} `json:"value"`
+	Name  string      `json:"name"`
+}
+
+type ldFlagEnvironment struct {
+	On             bool              `json:"on"`
+	OffVariation   *int              `json:"offVariation"`
+	Fallthrough    ldServe           `json:"fallthrough"`
+	Rules          []ldRule          `json:"rules"`
+	Targets        []ldTarget        `json:"targets"`
+	ContextTargets []ldTarget        `json:"contextTargets"`
+	Prerequisites  []json.RawMessage `json:"prerequisites"`
+}
+
+type ldServe struct {
+	Variation *int       `json:"variation"`
+	Rollout   *ldRollout `json:"rollout"`
+}
+
+type ldRollout struct {
+	Variations []struct {
+		Variation int   `json:"variation"`
+		Weight    int64 `json:"weight"`
+	} `json:"variations"`
+	BucketBy string `json:"bucketBy"`
+}
+
+type ldRule struct {
+	ldServe
+	Description string     `json:"description"`
+	Clauses     []ldClause `json:"clauses"`
+}
+
+type ldTarget struct {
+	Values    []string `json:"values"`
+	Variation int      `json:"variation"`
+}
+
+type ldClause struct {
+	Attribute   string        `json:"attribute"`
+	Op          string        `json:"op"`
+	Values      []interface{} `json:"values"`
+	Negate      bool          `json:"negate"`
+	ContextKind string        `json:"contextKind"`
+}
+
+type ldSegments struct {
+	Items []ldSegment `json:"items"`
+}
+
+type ldSegment struct {
+	Key         string   `json:"key"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Included    []string `json:"included"`
+	Excluded    []string `json:"excluded"`
+	Unbounded   bool     `json:"unbounded"`
+	Rules       []struct {
+		Clauses []ldClause `json:"clauses"`
+		Weight  *int64     `json:"weight"`
+	} `json:"rules"`
+}
+
+// ldOperators maps LaunchDarkly clause operators to App Configuration rule operators, without and with negation.
+var ldOperators = map[string][2]string{
+	"in":                 {appconfigurationv1.Rule_Operator_Is, appconfigurationv1.Rule_Operator_Isnot},
+	"contains":           {appconfigurationv1.Rule_Operator_Contains, appconfigurationv1.Rule_Operator_Notcontains},
+	"startsWith":         {appconfigurationv1.Rule_Operator_Startswith, appconfigurationv1.Rule_Operator_Notstartswith},
+	"endsWith":           {appconfigurationv1.Rule_Operator_Endswith, appconfigurationv1.Rule_Operator_Notendswith},
+	"lessThan":           {appconfigurationv1.Rule_Operator_Lesserthan},
+	"lessThanOrEqual":    {appconfigurationv1.Rule_Operator_Lesserthanequals},
+	"greaterThan":        {appconfigurationv1.Rule_Operator_Greaterthan},
+	"greaterThanOrEqual": {appconfigurationv1.Rule_Operator_Greaterthanequals},
+}
+
+// FromLaunchDarkly converts a LaunchDarkly project export. The export is a JSON object bundling responses of the
+// LaunchDarkly REST API as returned:
+//
+//	{
+//	  "project": <GET /api/v2/projects/{projectKey}?expand=environments>,
+//	  "flags": <GET /api/v2/flags/{projectKey}?summary=0>,
+//	  "segments": {"<environmentKey>": <GET /api/v2/segments/{projectKey}/{environmentKey}>, ...}
+//	}
+//
+// The project becomes a collection holding every feature, and each environment an environment with one feature per
+// flag. The fallthrough variation becomes the enabled value and the off variation the disabled value. Individual
+// targets, then targeting rules, become segment rules; individual targets are matched on LaunchDarklyTargetAttribute.
+// A percentage rollout is kept when it splits between one variation and the off variation.
+func FromLaunchDarkly(reader io.Reader) (*appconfigurationv1.ImportConfigOptions, *Report, error) {
+	var export ldExport
+	if err := json.NewDecoder(reader).Decode(&export); err != nil {
+		return nil, nil, core.SDKErrorf(err, "", "decode-export-error", common.GetComponentInfo())
+	}
+
+	converter := &ldConverter{builder: newBuilder()}
+	var collections []appconfigurationv1.CollectionRef
+	if export.Project.Key != "" {
+		collectionID := sanitizeID(export.Project.Key)
+		converter.builder.addCollection(collectionID, firstNonEmpty(export.Project.Name, export.Project.Key), "")
+		collections = []appconfigurationv1.CollectionRef{{CollectionID: core.StringPtr(collectionID)}}
+	}
+
+	environments := export.Project.Environments
+	if len(environments) == 0 {
+		keys := map[string]bool{}
+		for _, flag := range export.Flags.Items {
+			for key := range flag.Environments {
+				keys[key] = true
+			}
+		}
+		for key := range keys {
+			environments = append(environments, ldEnvironment{Key: key})
+		}
+		sort.Slice(environments, func(i, j int) bool { return environments[i].Key < environments[j].Key })
+	}
+
+	for _, flag := range export.Flags.Items {
+		values := make([]interface{}, len(flag.Variations))
+		for i, variation := range flag.Variations {
+			values[i] = variation.Value
+		}
+		if _, _, mixed := valueType(values); mixed {
+			converter.builder.report.add("feature", flag.Key, "", "variations of different types are imported as JSON values")
+		}
+	}
+
+	for _, source := range environments {
+		segments := map[string][]string{}
+		for _, segment := range export.Segments[source.Key].Items {
+			segments[segment.Key] = converter.segment(segment, source.Key)
+		}
+		colorCode := ""
+		if source.Color != "" {
+			colorCode = "#" + strings.TrimPrefix(source.Color, "#")
+		}
+		environment := newEnvironment(sanitizeID(source.Key), firstNonEmpty(source.Name, source.Key), colorCode)
+		for _, flag := range export.Flags.Items {
+			if feature, ok := converter.feature(flag, source.Key, segments); ok {
+				feature.Collections = collections
+				environment.Features = append(environment.Features, feature)
+			}
+		}
+		converter.builder.environments = append(converter.builder.environments, environment)
+	}
+	return converter.builder.options(), converter.builder.report, nil
+}
+
+type ldConverter struct {
+	builder *builder
+}
+
+// segment converts a LaunchDarkly segment, whose included keys and rules are alternatives, into one App Configuration
+// segment per alternative and returns their IDs.
+func (converter *ldConverter) segment(segment ldSegment, environmentKey string) []string {
+	report := converter.builder.report
+	var alternatives [][]appconfigurationv1.Rule
+	if len(segment.Included) > 0 {
+		alternatives = append(alternatives, []appconfigurationv1.Rule{newRule(LaunchDarklyTargetAttribute, appconfigurationv1.Rule_Operator_Is, segment.Included)})
+	}
+	if len(segment.Excluded) > 0 {
+		report.add("segment", segment.Key, environmentKey, "excluded keys are dropped")
+	}
+	if segment.Unbounded {
+		report.add("segment", segment.Key, environmentKey, "the keys of a big segment are not part of the export and are dropped")
+	}
+	for i, rule := range segment.Rules {
+		if rule.Weight != nil {
+			report.add("segment", segment.Key, environmentKey, "the percentage of rule %d is dropped", i+1)
+		}
+		rules, reason := converter.clauses(rule.Clauses)
+		if reason != "" {
+			report.add("segment", segment.Key, environmentKey, "rule %d is dropped: %s", i+1, reason)
+			continue
+		}
+		alternatives = append(alternatives, rules)
+	}
+	if len(alternatives) == 0 {
+		report.add("segment", segment.Key, environmentKey, "the segment matches nobody and is dropped")
+		return nil
+	}
+
+	ids := make([]string, len(alternatives))
+	for i, rules := range alternatives {
+		candidate := sanitizeID(segment.Key)
+		if len(alternatives) > 1 {
+			candidate = fmt.Sprintf("%s-%d", candidate, i+1)
+		}
+		ids[i] = converter.builder.addSegment(candidate, sanitizeID(environmentKey), firstNonEmpty(segment.Name, segment.Key), segment.Description, rules)
+	}
+	return ids
+}
+
+// clauses converts clauses that must all match. The reason is set if one of them cannot be converted.
+func (converter *ldConverter) clauses(clauses []ldClause) ([]appconfigurationv1.Rule, string) {
+	rules := []appconfigurationv1.Rule{}
+	for _, clause := range clauses {
+		operators, ok := ldOperators[clause.Op]
+		operator := operators[0]
+		if clause.Negate {
+			operator = operators[1]
+		}
+		if !ok || operator == "" {
+			negation := ""
+			if clause.Negate {
+				negation = "negated "
+			}
+			return nil, fmt.Sprintf("%soperator '%s' is not supported", negation, clause.Op)
+		}
+		if clause.ContextKind != "" && clause.ContextKind != "user" {
+			return nil, fmt.Sprintf("attributes of context kind '%s' are not supported", clause.ContextKind)
+		}
+		rules = append(rules, newRule(clause.Attribute, operator, stringValues(clause.Values)))
+	}
+	return rules, ""
+}
+
+func (converter *ldConverter) feature(flag ldFlag, environmentKey string, segments map[string][]string) (appconfigurationv1.ImportFeatureRequestBody, bool) {
+	report := converter.builder.report
+	settings, ok := flag.Environments[environmentKey]
+	if !ok || len(flag.Variations) == 0 {
+		report.add("feature", flag.Key, environmentKey, "the flag has no settings in the environment and is skipped")
+		return appconfigurationv1.ImportFeatureRequestBody{}, false
+	}
+
+	offVariation := len(flag.Variations) - 1
+	if settings.OffVariation != nil {
+		offVariation = *settings.OffVariation
+	} else {
+		report.add("feature", flag.Key, environmentKey, "the flag has no off variation, the last variation is used as the disabled value")
+	}
+	enabledVariation, percentage := converter.serve(flag.Key, environmentKey, "the fallthrough", settings.Fallthrough, offVariation)
+	if percentage == nil {
+		percentage = core.Int64Ptr(100)
+	}
+	if offVariation < 0 || offVariation >= len(flag.Variations) || enabledVariation < 0 || enabledVariation >= len(flag.Variations) {
+		report.add("feature", flag.Key, environmentKey, "the flag refers to a variation that does not exist and is skipped")
+		return appconfigurationv1.ImportFeatureRequestBody{}, false
+	}
+
+	values := make([]interface{}, len(flag.Variations))
+	for i, variation := range flag.Variations {
+		values[i] = variation.Value
+	}
+	valueType, format, _ := valueType(values)
+	feature := appconfigurationv1.ImportFeatureRequestBody{
+		FeatureID:         core.StringPtr(sanitizeID(flag.Key)),
+		Name:              core.StringPtr(firstNonEmpty(flag.Name, flag.Key)),
+		Type:              core.StringPtr(valueType),
+		EnabledValue:      values[enabledVariation],
+		DisabledValue:     values[offVariation],
+		Enabled:           core.BoolPtr(settings.On),
+		RolloutPercentage: percentage,
+		Tags:              joinTags(flag.Tags),
+		SegmentRules:      []appconfigurationv1.FeatureSegmentRule{},
+	}
+	if format != "" {
+		feature.Format = core.StringPtr(format)
+	}
+	if flag.Description != "" {
+		feature.Description = core.StringPtr(flag.Description)
+	}
+	if len(settings.Prerequisites) > 0 {
+		report.add("feature", flag.Key, environmentKey, "prerequisites are dropped")
+	}
+
+	addRule := func(name string, segmentIDs []string, variation int, percentage *int64) {
+		if percentage == nil {
+			percentage = core.Int64Ptr(100)
+		}
+		rule := appconfigurationv1.FeatureSegmentRule{
+			Rules:             []appconfigurationv1.TargetSegments{{Segments: segmentIDs}},
+			Value:             values[variation],
+			Order:             core.Int64Ptr(int64(len(feature.SegmentRules) + 1)),
+			RolloutPercentage: percentage,
+		}
+		if name != "" {
+			rule.RuleName = core.StringPtr(name)
+		}
+		feature.SegmentRules = append(feature.SegmentRules, rule)
+	}
+
+	// Individual targets are evaluated before the rules.
+	for _, target := range append(append([]ldTarget{}, settings.Targets...), settings.ContextTargets...) {
+		if len(target.Values) == 0 || target.Variation < 0 || target.Variation >= len(values) {
+			continue
+		}
+		name := firstNonEmpty(flag.Variations[target.Variation].Name, fmt.Sprint(target.Variation))
+		segmentID := converter.builder.addSegment(fmt.Sprintf("%s-targets-%d", sanitizeID(flag.Key), target.Variation), sanitizeID(environmentKey),
+			fmt.Sprintf("%s targets (%s)", firstNonEmpty(flag.Name, flag.Key), name), "",
+			[]appconfigurationv1.Rule{newRule(LaunchDarklyTargetAttribute, appconfigurationv1.Rule_Operator_Is, target.Values)})
+		addRule("", []string{segmentID}, target.Variation, nil)
+	}
+
+	for i, rule := range settings.Rules {
+		var segmentClauses, attributeClauses []ldClause
+		for _, clause := range rule.Clauses {
+			if clause.Op == "segmentMatch" {
+				segmentClauses = append(segmentClauses, clause)
+			} else {
+				attributeClauses = append(attributeClauses, clause)
+			}
+		}
+		var segmentIDs []string
+		switch {
+		case len(segmentClauses) == 0:
+			rules, reason := converter.clauses(attributeClauses)
+			if reason != "" {
+				report.add("feature", flag.Key, environmentKey, "rule %d is dropped: %s", i+1, reason)
+				continue
+			}
+			segmentIDs = []string{converter.builder.addSegment(fmt.Sprintf("%s-rule-%d", sanitizeID(flag.Key), i+1), sanitizeID(environmentKey),
+				fmt.Sprintf("%s rule %d", firstNonEmpty(flag.Name, flag.Key), i+1), rule.Description, rules)}
+		case len(segmentClauses) == 1 && len(attributeClauses) == 0 && !segmentClauses[0].Negate:
+			for _, key := range stringValues(segmentClauses[0].Values) {
+				segmentIDs = append(segmentIDs, segments[key]...)
+			}
+			if len(segmentIDs) == 0 {
+				report.add("feature", flag.Key, environmentKey, "rule %d is dropped: its segments were not converted", i+1)
+				continue
+			}
+		default:
+			report.add("feature", flag.Key, environmentKey, "rule %d is dropped: segment clauses can only be converted alone", i+1)
+			continue
+		}
+		variation, percentage := converter.serve(flag.Key, environmentKey, fmt.Sprintf("rule %d", i+1), rule.ldServe, offVariation)
+		if variation < 0 || variation >= len(values) {
+			report.add("feature", flag.Key, environmentKey, "rule %d is dropped: it refers to a variation that does not exist", i+1)
+			continue
+		}
+		addRule(rule.Description, segmentIDs, variation, percentage)
+	}
+	return feature, true
+}
+
+// serve returns the variation served by a fallthrough or rule and, for a rollout between one variation and the off
+// variation, the percentage of the former. Other rollouts are reduced to their largest variation.
+func (converter *ldConverter) serve(flagKey string, environmentKey string, what string, serve ldServe, offVariation int) (int, *int64) {
+	report := converter.builder.report
+	if serve.Variation != nil {
+		return *serve.Variation, nil
+	}
+	if serve.Rollout == nil {
+		report.add("feature", flagKey, environmentKey, "%s serves no variation, the off variation is used", what)
+		return offVariation, nil
+	}
+	if serve.Rollout.BucketBy != "" && serve.Rollout.BucketBy != LaunchDarklyTargetAttribute {
+		report.add("feature", flagKey, environmentKey, "%s is bucketed by '%s', App Configuration buckets by entity ID", what, serve.Rollout.BucketBy)
+	}
+
+	largest, weights := -1, map[int]int64{}
+	for _, variation := range serve.Rollout.Variations {
+		if variation.Weight > 0 {
+			weights[variation.Variation] += variation.Weight
+			if largest < 0 || weights[variation.Variation] > weights[largest] {
+				largest = variation.Variation
+			}
+		}
+	}
+	switch {
+	case len(weights) == 1:
+		return largest, nil
+	case len(weights) == 2 && weights[offVariation] > 0:
+		for variation, weight := range weights {
+			if variation != offVariation {
+				if weight%1000 != 0 {
+					report.add("feature", flagKey, environmentKey, "%s rollout of %.3f%% is rounded to a whole percentage", what, float64(weight)/1000)
+				}
+				return variation, core.Int64Ptr((weight + 500) / 1000)
+			}
+		}
+	}
+	if largest < 0 {
+		report.add("feature", flagKey, environmentKey, "%s rollout has no weights, the off variation is used", what)
+		return offVariation, nil
+	}
+	report.add("feature", flagKey, environmentKey, "%s rollout across %d variations is reduced to its largest variation", what, len(weights))
+	return largest, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package importers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const launchDarklyExport = `{
+	"project": {"key": "shop", "name": "Shop", "environments": {"items": [
+		{"key": "production", "name": "Production", "color": "417505"},
+		{"key": "test", "name": "Test", "color": "F5A623"}
+	]}},
+	"flags": {"items": [
+		{"key": "checkout", "name": "Checkout", "description": "New checkout", "tags": ["web", "cart"],
+		 "variations": [{"value": "new", "name": "New"}, {"value": "beta"}, {"value": "old"}],
+		 "environments": {
+			"production": {"on": true, "offVariation": 2, "fallthrough": {"rollout": {"variations": [{"variation": 0, "weight": 25000}, {"variation": 2, "weight": 75000}]}},
+				"targets": [{"values": ["alice", "bob"], "variation": 1}],
+				"rules": [
+					{"variation": 1, "description": "Staff", "clauses": [{"attribute": "email", "op": "endsWith", "values": ["@example.com"]}, {"attribute": "age", "op": "greaterThanOrEqual", "values": [18]}]},
+					{"variation": 0, "clauses": [{"attribute": "country", "op": "in", "values": ["FR"], "negate": true}]},
+					{"variation": 0, "clauses": [{"attribute": "ip", "op": "matches", "values": ["^10\\."]}]},
+					{"variation": 1, "clauses": [{"op": "segmentMatch", "values": ["vip"]}]}
+				],
+				"prerequisites": [{"key": "cart", "variation": 0}]},
+			"test": {"on": false, "offVariation": 2, "fallthrough": {"variation": 0}}
+		 }},
+		{"key": "limits", "name": "Limits", "variations": [{"value": {"max": 3}}, {"value": 10}],
+		 "environments": {
+			"production": {"on": true, "offVariation": 1, "fallthrough": {"rollout": {"variations": [{"variation": 0, "weight": 50000}, {"variation": 1, "weight": 30000}, {"variation": 2, "weight": 20000}], "bucketBy": "company"}}},
+			"test": {"on": true, "offVariation": 1, "fallthrough": {"variation": 0}}
+		 }}
+	]},
+	"segments": {
+		"production": {"items": [{"key": "vip", "name": "VIP", "included": ["carol"], "excluded": ["dave"],
+			"rules": [{"clauses": [{"attribute": "plan", "op": "in", "values": ["gold", "platinum"]}]}]}]},
+		"test": {"items": [{"key": "vip", "name": "VIP", "included": ["carol"]}]}
+	}
+}`
+
+func importedSnapshot(t *testing.T, options *appconfigurationv1.ImportConfigOptions, environmentID string) *evaluator.Snapshot {
+	config := &appconfigurationv1.ImportConfig{Environments: options.Environments, Collections: options.Collections, Segments: options.Segments}
+	snapshot, err := evaluator.NewSnapshot(config, environmentID)
+	require.Nil(t, err)
+	return snapshot
+}
+
+func TestFromLaunchDarkly(t *testing.T) {
+	options, report, err := FromLaunchDarkly(strings.NewReader(launchDarklyExport))
+	require.Nil(t, err)
+
+	require.Len(t, options.Collections, 1)
+	assert.Equal(t, "shop", *options.Collections[0].CollectionID)
+	require.Len(t, options.Environments, 2)
+	assert.Equal(t, "#417505", *options.Environments[0].ColorCode)
+
+	checkout := options.Environments[0].Features[0]
+	assert.Equal(t, "checkout", *checkout.FeatureID)
+	assert.Equal(t, "STRING", *checkout.Type)
+	assert.Equal(t, "TEXT", *checkout.Format)
+	assert.Equal(t, "new", checkout.EnabledValue)
+	assert.Equal(t, "old", checkout.DisabledValue)
+	assert.Equal(t, int64(25), *checkout.RolloutPercentage)
+	assert.Equal(t, "cart,web", *checkout.Tags)
+	assert.Equal(t, "shop", *checkout.Collections[0].CollectionID)
+	require.Len(t, checkout.SegmentRules, 4)
+	assert.Equal(t, []string{"checkout-targets-1"}, checkout.SegmentRules[0].Rules[0].Segments)
+	assert.Equal(t, "Staff", *checkout.SegmentRules[1].RuleName)
+	assert.Equal(t, []string{"vip-1", "vip-2"}, checkout.SegmentRules[3].Rules[0].Segments)
+
+	limits := options.Environments[0].Features[1]
+	assert.Equal(t, "JSON", *limits.Format)
+	assert.Equal(t, map[string]interface{}{"max": float64(3)}, limits.EnabledValue)
+
+	snapshot := importedSnapshot(t, options, "production")
+	evaluate := func(id string, attributes map[string]interface{}) interface{} {
+		result, err := snapshot.EvaluateFeature("checkout", evaluator.Entity{ID: id, Attributes: attributes})
+		require.Nil(t, err)
+		return result.Value
+	}
+	assert.Equal(t, "beta", evaluate("alice", map[string]interface{}{"key": "alice"}))
+	assert.Equal(t, "beta", evaluate("u1", map[string]interface{}{"email": "jo@example.com", "age": 30}))
+	assert.Equal(t, "new", evaluate("u1", map[string]interface{}{"email": "jo@example.com", "age": 12, "country": "DE"}))
+	assert.Equal(t, "beta", evaluate("u1", map[string]interface{}{"plan": "gold", "country": "FR"}))
+	assert.Equal(t, "beta", evaluate("u1", map[string]interface{}{"key": "carol", "country": "FR"}))
+
+	// The test environment has a VIP segment with the same ID but different rules.
+	assert.Equal(t, false, *options.Environments[1].Features[0].Enabled)
+	ids := []string{}
+	for _, segment := range options.Segments {
+		ids = append(ids, *segment.SegmentID)
+	}
+	assert.ElementsMatch(t, []string{"vip-1", "vip-2", "vip", "checkout-targets-1", "checkout-rule-1", "checkout-rule-2"}, ids)
+
+	assert.Equal(t, []string{
+		"feature 'limits': variations of different types are imported as JSON values",
+		"segment 'vip' in environment 'production': excluded keys are dropped",
+		"feature 'checkout' in environment 'production': prerequisites are dropped",
+		"feature 'checkout' in environment 'production': rule 3 is dropped: operator 'matches' is not supported",
+		"feature 'limits' in environment 'production': the fallthrough is bucketed by 'company', App Configuration buckets by entity ID",
+		"feature 'limits' in environment 'production': the fallthrough rollout across 3 variations is reduced to its largest variation",
+	}, strings.Split(report.String(), "\n"))
+}
+
+func TestFromLaunchDarklyErrors(t *testing.T) {
+	_, _, err := FromLaunchDarkly(strings.NewReader("not json"))
+	assert.NotNil(t, err)
+
+	options, report, err := FromLaunchDarkly(strings.NewReader(`{"flags": {"items": [
+		{"key": "broken", "variations": [{"value": true}], "environments": {"dev": {"on": true, "offVariation": 4, "fallthrough": {"variation": 0}}}}
+	]}}`))
+	require.Nil(t, err)
+	assert.Len(t, options.Collections, 0)
+	require.Len(t, options.Environments, 1)
+	assert.Len(t, options.Environments[0].Features, 0)
+	assert.Equal(t, "feature 'broken' in environment 'dev': the flag refers to a variation that does not exist and is skipped", report.String())
+}
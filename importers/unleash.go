@@ -0,0 +1,365 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package importers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type unleashExport struct {
+	Features                []unleashFeature            `json:"features"`
+	Projects                []unleashProject            `json:"projects"`
+	Environments            []unleashEnvironment        `json:"environments"`
+	FeatureStrategies       []unleashStrategy           `json:"featureStrategies"`
+	FeatureEnvironments     []unleashFeatureEnvironment `json:"featureEnvironments"`
+	Segments                []unleashSegment            `json:"segments"`
+	FeatureStrategySegments []struct {
+		FeatureStrategyID string `json:"featureStrategyId"`
+		SegmentID         int    `json:"segmentId"`
+	} `json:"featureStrategySegments"`
+	FeatureTags []struct {
+		FeatureName string `json:"featureName"`
+		TagType     string `json:"tagType"`
+		TagValue    string `json:"tagValue"`
+	} `json:"featureTags"`
+}
+
+type unleashFeature struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Project     string            `json:"project"`
+	Archived    bool              `json:"archived"`
+	ArchivedAt  *string           `json:"archivedAt"`
+	Variants    []json.RawMessage `json:"variants"`
+}
+
+type unleashProject struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type unleashEnvironment struct {
+	Name string `json:"name"`
+}
+
+type unleashStrategy struct {
+	ID           string                 `json:"id"`
+	FeatureName  string                 `json:"featureName"`
+	Environment  string                 `json:"environment"`
+	StrategyName string                 `json:"strategyName"`
+	Title        string                 `json:"title"`
+	Parameters   map[string]interface{} `json:"parameters"`
+	Constraints  []unleashConstraint    `json:"constraints"`
+	Segments     []int                  `json:"segments"`
+	SortOrder    int                    `json:"sortOrder"`
+	Disabled     bool                   `json:"disabled"`
+	Variants     []json.RawMessage      `json:"variants"`
+}
+
+type unleashFeatureEnvironment struct {
+	FeatureName string            `json:"featureName"`
+	Environment string            `json:"environment"`
+	Enabled     bool              `json:"enabled"`
+	Variants    []json.RawMessage `json:"variants"`
+}
+
+type unleashSegment struct {
+	ID          int                 `json:"id"`
+	Name        string              `json:"name"`
+	Constraints []unleashConstraint `json:"constraints"`
+}
+
+type unleashConstraint struct {
+	ContextName     string   `json:"contextName"`
+	Operator        string   `json:"operator"`
+	Values          []string `json:"values"`
+	Value           string   `json:"value"`
+	Inverted        bool     `json:"inverted"`
+	CaseInsensitive bool     `json:"caseInsensitive"`
+}
+
+// unleashOperators maps Unleash constraint operators to App Configuration rule operators, without and with inversion.
+var unleashOperators = map[string][2]string{
+	"IN":              {appconfigurationv1.Rule_Operator_Is, appconfigurationv1.Rule_Operator_Isnot},
+	"NOT_IN":          {appconfigurationv1.Rule_Operator_Isnot, appconfigurationv1.Rule_Operator_Is},
+	"STR_CONTAINS":    {appconfigurationv1.Rule_Operator_Contains, appconfigurationv1.Rule_Operator_Notcontains},
+	"STR_STARTS_WITH": {appconfigurationv1.Rule_Operator_Startswith, appconfigurationv1.Rule_Operator_Notstartswith},
+	"STR_ENDS_WITH":   {appconfigurationv1.Rule_Operator_Endswith, appconfigurationv1.Rule_Operator_Notendswith},
+	"NUM_EQ":          {appconfigurationv1.Rule_Operator_Is, appconfigurationv1.Rule_Operator_Isnot},
+	"NUM_GT":          {appconfigurationv1.Rule_Operator_Greaterthan, appconfigurationv1.Rule_Operator_Lesserthanequals},
+	"NUM_GTE":         {appconfigurationv1.Rule_Operator_Greaterthanequals, appconfigurationv1.Rule_Operator_Lesserthan},
+	"NUM_LT":          {appconfigurationv1.Rule_Operator_Lesserthan, appconfigurationv1.Rule_Operator_Greaterthanequals},
+	"NUM_LTE":         {appconfigurationv1.Rule_Operator_Lesserthanequals, appconfigurationv1.Rule_Operator_Greaterthan},
+}
+
+// FromUnleash converts an Unleash state export (GET /api/admin/state/export). Strategies without an environment, as
+// in older exports, apply to every environment.
+//
+// Projects become collections and every toggle a BOOLEAN feature in each environment. Unleash enables a toggle for a
+// user when any of its strategies matches: each strategy with constraints, segments or user IDs becomes a segment
+// rule, and the percentage of the strategies without any becomes the rollout percentage of the feature (0 if there is
+// none, so that users matching no rule get `false`). The constraints of the segments of a strategy are merged into
+// the segment of the strategy.
+func FromUnleash(reader io.Reader) (*appconfigurationv1.ImportConfigOptions, *Report, error) {
+	var export unleashExport
+	if err := json.NewDecoder(reader).Decode(&export); err != nil {
+		return nil, nil, core.SDKErrorf(err, "", "decode-export-error", common.GetComponentInfo())
+	}
+	converter := &unleashConverter{
+		builder:          newBuilder(),
+		segments:         map[int]unleashSegment{},
+		strategySegments: map[string][]int{},
+	}
+	report := converter.builder.report
+	for _, segment := range export.Segments {
+		converter.segments[segment.ID] = segment
+	}
+	for _, link := range export.FeatureStrategySegments {
+		converter.strategySegments[link.FeatureStrategyID] = append(converter.strategySegments[link.FeatureStrategyID], link.SegmentID)
+	}
+
+	projects := map[string]bool{}
+	for _, project := range export.Projects {
+		projects[project.ID] = true
+		converter.builder.addCollection(sanitizeID(project.ID), firstNonEmpty(project.Name, project.ID), project.Description)
+	}
+	tags := map[string][]string{}
+	for _, tag := range export.FeatureTags {
+		tags[tag.FeatureName] = append(tags[tag.FeatureName], tag.TagType+":"+tag.TagValue)
+	}
+
+	var features []unleashFeature
+	for _, feature := range export.Features {
+		if feature.Archived || feature.ArchivedAt != nil {
+			report.add("feature", feature.Name, "", "the toggle is archived and is skipped")
+			continue
+		}
+		if feature.Project != "" && !projects[feature.Project] {
+			projects[feature.Project] = true
+			converter.builder.addCollection(sanitizeID(feature.Project), feature.Project, "")
+		}
+		if len(feature.Variants) > 0 {
+			report.add("feature", feature.Name, "", "variants are dropped")
+		}
+		features = append(features, feature)
+	}
+
+	environmentNames := []string{}
+	for _, environment := range export.Environments {
+		environmentNames = append(environmentNames, environment.Name)
+	}
+	if len(environmentNames) == 0 {
+		names := map[string]bool{}
+		for _, featureEnvironment := range export.FeatureEnvironments {
+			names[featureEnvironment.Environment] = true
+		}
+		for name := range names {
+			environmentNames = append(environmentNames, name)
+		}
+		sort.Strings(environmentNames)
+	}
+
+	for _, environmentName := range environmentNames {
+		states := map[string]unleashFeatureEnvironment{}
+		for _, featureEnvironment := range export.FeatureEnvironments {
+			if featureEnvironment.Environment == environmentName {
+				states[featureEnvironment.FeatureName] = featureEnvironment
+			}
+		}
+		strategies := map[string][]unleashStrategy{}
+		for _, strategy := range export.FeatureStrategies {
+			if (strategy.Environment == "" || strategy.Environment == environmentName) && !strategy.Disabled {
+				strategies[strategy.FeatureName] = append(strategies[strategy.FeatureName], strategy)
+			}
+		}
+
+		environment := newEnvironment(sanitizeID(environmentName), environmentName, "")
+		for _, unleashFeature := range features {
+			feature := converter.feature(unleashFeature, environmentName, states[unleashFeature.Name], strategies[unleashFeature.Name])
+			feature.Tags = joinTags(tags[unleashFeature.Name])
+			environment.Features = append(environment.Features, feature)
+		}
+		converter.builder.environments = append(converter.builder.environments, environment)
+	}
+	return converter.builder.options(), report, nil
+}
+
+type unleashConverter struct {
+	builder          *builder
+	segments         map[int]unleashSegment
+	strategySegments map[string][]int
+}
+
+func (converter *unleashConverter) feature(unleashFeature unleashFeature, environmentName string, state unleashFeatureEnvironment, strategies []unleashStrategy) appconfigurationv1.ImportFeatureRequestBody {
+	report := converter.builder.report
+	featureID := sanitizeID(unleashFeature.Name)
+	feature := appconfigurationv1.ImportFeatureRequestBody{
+		FeatureID:     core.StringPtr(featureID),
+		Name:          core.StringPtr(unleashFeature.Name),
+		Type:          core.StringPtr(appconfigurationv1.ImportFeatureRequestBody_Type_Boolean),
+		EnabledValue:  true,
+		DisabledValue: false,
+		Enabled:       core.BoolPtr(state.Enabled),
+		SegmentRules:  []appconfigurationv1.FeatureSegmentRule{},
+	}
+	if unleashFeature.Description != "" {
+		feature.Description = core.StringPtr(unleashFeature.Description)
+	}
+	if unleashFeature.Project != "" {
+		feature.Collections = []appconfigurationv1.CollectionRef{{CollectionID: core.StringPtr(sanitizeID(unleashFeature.Project))}}
+	}
+	if len(state.Variants) > 0 {
+		report.add("feature", unleashFeature.Name, environmentName, "variants are dropped")
+	}
+
+	// Without strategies, an enabled toggle is on for everyone.
+	fallback, unconstrained := int64(0), map[int64]bool{}
+	if len(strategies) == 0 {
+		fallback, unconstrained[100] = 100, true
+	}
+	// The constrained strategies rolled out to part of their segment, by rule index.
+	partial := map[int]string{}
+	sort.SliceStable(strategies, func(i, j int) bool { return strategies[i].SortOrder < strategies[j].SortOrder })
+	for i, strategy := range strategies {
+		what := fmt.Sprintf("strategy %d (%s)", i+1, strategy.StrategyName)
+		percentage, rules, reason := converter.strategy(unleashFeature.Name, environmentName, what, strategy)
+		if reason != "" {
+			report.add("feature", unleashFeature.Name, environmentName, "%s is dropped: %s", what, reason)
+			continue
+		}
+		if len(strategy.Variants) > 0 {
+			report.add("feature", unleashFeature.Name, environmentName, "the variants of %s are dropped", what)
+		}
+		if len(rules) == 0 {
+			unconstrained[percentage] = true
+			if percentage > fallback {
+				fallback = percentage
+			}
+			continue
+		}
+
+		name := fmt.Sprintf("%s strategy %d", unleashFeature.Name, i+1)
+		segmentID := converter.builder.addSegment(fmt.Sprintf("%s-strategy-%d", featureID, i+1), sanitizeID(environmentName), name, strategy.Title, rules)
+		rule := appconfigurationv1.FeatureSegmentRule{
+			Rules:             []appconfigurationv1.TargetSegments{{Segments: []string{segmentID}}},
+			Value:             true,
+			Order:             core.Int64Ptr(int64(len(feature.SegmentRules) + 1)),
+			RolloutPercentage: core.Int64Ptr(percentage),
+		}
+		if strategy.Title != "" {
+			rule.RuleName = core.StringPtr(strategy.Title)
+		}
+		if percentage < 100 {
+			partial[len(feature.SegmentRules)] = what
+		}
+		feature.SegmentRules = append(feature.SegmentRules, rule)
+	}
+	// Unleash turns the toggle on if any strategy matches, but the first segment rule matching a user decides in
+	// App Configuration: a user outside the rollout of a rule does not fall through to the other strategies.
+	for i, rule := range feature.SegmentRules {
+		if what, ok := partial[i]; ok && (i < len(feature.SegmentRules)-1 || fallback > 0) {
+			report.add("feature", unleashFeature.Name, environmentName, "%s is rolled out to %d%% of its segment, and the users of the segment outside the rollout get the disabled value instead of being matched by the other strategies", what, *rule.RolloutPercentage)
+		}
+	}
+	if len(unconstrained) > 1 {
+		report.add("feature", unleashFeature.Name, environmentName, "strategies without constraints have different percentages, the largest (%d%%) is used", fallback)
+	}
+	feature.RolloutPercentage = core.Int64Ptr(fallback)
+	return feature
+}
+
+// strategy returns the percentage of a strategy and the rules its users must match. The reason is set if the strategy
+// cannot be converted.
+func (converter *unleashConverter) strategy(featureName string, environmentName string, what string, strategy unleashStrategy) (int64, []appconfigurationv1.Rule, string) {
+	report := converter.builder.report
+	percentage := int64(100)
+	rules := []appconfigurationv1.Rule{}
+	switch strategy.StrategyName {
+	case "default":
+	case "userWithId":
+		userIDs := []string{}
+		for _, userID := range strings.Split(fmt.Sprint(strategy.Parameters["userIds"]), ",") {
+			if userID = strings.TrimSpace(userID); userID != "" {
+				userIDs = append(userIDs, userID)
+			}
+		}
+		if len(userIDs) == 0 {
+			return 0, nil, "it has no user IDs"
+		}
+		rules = append(rules, newRule("userId", appconfigurationv1.Rule_Operator_Is, userIDs))
+	case "flexibleRollout", "gradualRolloutUserId", "gradualRolloutSessionId", "gradualRolloutRandom":
+		parameter := "rollout"
+		if strategy.StrategyName != "flexibleRollout" {
+			parameter = "percentage"
+		}
+		value, err := strconv.ParseFloat(fmt.Sprint(strategy.Parameters[parameter]), 64)
+		if err != nil || value < 0 || value > 100 {
+			return 0, nil, fmt.Sprintf("its percentage '%v' is invalid", strategy.Parameters[parameter])
+		}
+		percentage = int64(value + 0.5)
+		stickiness, _ := strategy.Parameters["stickiness"].(string)
+		switch {
+		case strategy.StrategyName == "gradualRolloutSessionId" || strategy.StrategyName == "gradualRolloutRandom":
+			report.add("feature", featureName, environmentName, "%s is bucketed by entity ID instead of session or at random", what)
+		case stickiness != "" && stickiness != "default" && stickiness != "userId":
+			report.add("feature", featureName, environmentName, "%s is bucketed by entity ID instead of '%s'", what, stickiness)
+		}
+		if groupID, _ := strategy.Parameters["groupId"].(string); groupID != "" && groupID != featureName {
+			report.add("feature", featureName, environmentName, "%s shares the bucketing of group '%s', App Configuration buckets each feature independently", what, groupID)
+		}
+	default:
+		return 0, nil, "the strategy type is not supported"
+	}
+
+	constraints := append([]unleashConstraint{}, strategy.Constraints...)
+	for _, segmentID := range append(append([]int{}, strategy.Segments...), converter.strategySegments[strategy.ID]...) {
+		segment, ok := converter.segments[segmentID]
+		if !ok {
+			return 0, nil, fmt.Sprintf("segment %d is not in the export", segmentID)
+		}
+		constraints = append(constraints, segment.Constraints...)
+	}
+	for _, constraint := range constraints {
+		operators, ok := unleashOperators[constraint.Operator]
+		if !ok {
+			return 0, nil, fmt.Sprintf("operator '%s' is not supported", constraint.Operator)
+		}
+		operator := operators[0]
+		if constraint.Inverted {
+			operator = operators[1]
+		}
+		values := constraint.Values
+		if len(values) == 0 && constraint.Value != "" {
+			values = []string{constraint.Value}
+		}
+		if constraint.CaseInsensitive {
+			report.add("feature", featureName, environmentName, "case-insensitive matching of '%s' in %s becomes case-sensitive", constraint.ContextName, what)
+		}
+		rules = append(rules, newRule(constraint.ContextName, operator, values))
+	}
+	return percentage, rules, ""
+}
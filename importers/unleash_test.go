@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package importers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const unleashExportFile = `{
+	"version": 1,
+	"projects": [{"id": "default", "name": "Default project"}],
+	"environments": [{"name": "development"}, {"name": "production"}],
+	"features": [
+		{"name": "new-search", "description": "Search v2", "project": "default"},
+		{"name": "dark mode", "project": "mobile", "variants": [{"name": "a"}]},
+		{"name": "old", "project": "default", "archived": true}
+	],
+	"featureEnvironments": [
+		{"featureName": "new-search", "environment": "production", "enabled": true},
+		{"featureName": "dark mode", "environment": "production", "enabled": true},
+		{"featureName": "new-search", "environment": "development", "enabled": true}
+	],
+	"featureStrategies": [
+		{"id": "s1", "featureName": "new-search", "environment": "production", "strategyName": "userWithId", "parameters": {"userIds": "alice, bob"}, "sortOrder": 0},
+		{"id": "s2", "featureName": "new-search", "environment": "production", "strategyName": "flexibleRollout", "title": "Adults",
+		 "parameters": {"rollout": "100", "stickiness": "default", "groupId": "new-search"},
+		 "constraints": [{"contextName": "age", "operator": "NUM_LT", "value": "18", "inverted": true}], "segments": [7], "sortOrder": 1},
+		{"id": "s3", "featureName": "new-search", "environment": "production", "strategyName": "flexibleRollout",
+		 "parameters": {"rollout": "30", "stickiness": "sessionId", "groupId": "new-search"}, "sortOrder": 2},
+		{"id": "s4", "featureName": "new-search", "environment": "production", "strategyName": "remoteAddress", "parameters": {"IPs": "10.0.0.1"}, "sortOrder": 3},
+		{"id": "s5", "featureName": "dark mode", "environment": "production", "strategyName": "default",
+		 "constraints": [{"contextName": "appVersion", "operator": "SEMVER_GT", "value": "2.0.0"}]},
+		{"id": "s6", "featureName": "dark mode", "environment": "production", "strategyName": "default",
+		 "constraints": [{"contextName": "email", "operator": "STR_ENDS_WITH", "values": ["@example.com"], "caseInsensitive": true}]}
+	],
+	"segments": [{"id": 7, "name": "europe", "constraints": [{"contextName": "region", "operator": "IN", "values": ["eu-de", "eu-gb"]}]}],
+	"featureTags": [{"featureName": "new-search", "tagType": "simple", "tagValue": "search"}]
+}`
+
+func TestFromUnleash(t *testing.T) {
+	options, report, err := FromUnleash(strings.NewReader(unleashExportFile))
+	require.Nil(t, err)
+
+	collections := []string{}
+	for _, collection := range options.Collections {
+		collections = append(collections, *collection.CollectionID)
+	}
+	assert.Equal(t, []string{"default", "mobile"}, collections)
+	require.Len(t, options.Environments, 2)
+
+	development := options.Environments[0].Features
+	require.Len(t, development, 2)
+	assert.Equal(t, true, *development[0].Enabled)
+	assert.Equal(t, int64(100), *development[0].RolloutPercentage)
+	assert.Equal(t, false, *development[1].Enabled)
+	assert.Equal(t, "dark-mode", *development[1].FeatureID)
+
+	search := options.Environments[1].Features[0]
+	assert.Equal(t, "BOOLEAN", *search.Type)
+	assert.Equal(t, "simple:search", *search.Tags)
+	assert.Equal(t, int64(30), *search.RolloutPercentage)
+	require.Len(t, search.SegmentRules, 2)
+	assert.Equal(t, "Adults", *search.SegmentRules[1].RuleName)
+
+	snapshot := importedSnapshot(t, options, "production")
+	evaluate := func(featureID string, attributes map[string]interface{}) interface{} {
+		result, err := snapshot.EvaluateFeature(featureID, evaluator.Entity{ID: "user-1", Attributes: attributes})
+		require.Nil(t, err)
+		return result.Value
+	}
+	assert.Equal(t, true, evaluate("new-search", map[string]interface{}{"userId": "bob"}))
+	assert.Equal(t, true, evaluate("new-search", map[string]interface{}{"age": 30, "region": "eu-de"}))
+	assert.Equal(t, evaluator.Bucket("user-1", "new-search") < 30, evaluate("new-search", map[string]interface{}{"age": 12, "region": "eu-de"}))
+	assert.Equal(t, true, evaluate("dark-mode", map[string]interface{}{"email": "jo@example.com"}))
+	assert.Equal(t, false, evaluate("dark-mode", map[string]interface{}{"email": "jo@ibm.com"}))
+
+	assert.Equal(t, []string{
+		"feature 'dark mode': variants are dropped",
+		"feature 'old': the toggle is archived and is skipped",
+		"feature 'new-search' in environment 'production': strategy 3 (flexibleRollout) is bucketed by entity ID instead of 'sessionId'",
+		"feature 'new-search' in environment 'production': strategy 4 (remoteAddress) is dropped: the strategy type is not supported",
+		"feature 'dark mode' in environment 'production': strategy 1 (default) is dropped: operator 'SEMVER_GT' is not supported",
+		"feature 'dark mode' in environment 'production': case-insensitive matching of 'email' in strategy 2 (default) becomes case-sensitive",
+	}, strings.Split(report.String(), "\n"))
+}
+
+func TestFromUnleashReportsPartialRolloutsThatDoNotFallThrough(t *testing.T) {
+	_, report, err := FromUnleash(strings.NewReader(`{
+		"environments": [{"name": "production"}],
+		"features": [{"name": "beta-search"}, {"name": "eu-search"}],
+		"featureEnvironments": [
+			{"featureName": "beta-search", "environment": "production", "enabled": true},
+			{"featureName": "eu-search", "environment": "production", "enabled": true}
+		],
+		"featureStrategies": [
+			{"id": "b1", "featureName": "beta-search", "strategyName": "flexibleRollout", "parameters": {"rollout": "20"},
+			 "constraints": [{"contextName": "region", "operator": "IN", "values": ["eu-de"]}], "sortOrder": 0},
+			{"id": "b2", "featureName": "beta-search", "strategyName": "flexibleRollout", "parameters": {"rollout": "50"}, "sortOrder": 1},
+			{"id": "e1", "featureName": "eu-search", "strategyName": "flexibleRollout", "parameters": {"rollout": "20"},
+			 "constraints": [{"contextName": "region", "operator": "IN", "values": ["eu-de"]}], "sortOrder": 0}
+		]
+	}`))
+	require.Nil(t, err)
+	// The single strategy of eu-search has nothing to fall through to, so nothing is lost.
+	assert.Equal(t, []string{
+		"feature 'beta-search' in environment 'production': strategy 1 (flexibleRollout) is rolled out to 20% of its segment, and the users of the segment outside the rollout get the disabled value instead of being matched by the other strategies",
+	}, strings.Split(report.String(), "\n"))
+}
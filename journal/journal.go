@@ -0,0 +1,301 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package journal : An undo journal of the changes made through a client
+// A Journal attached to a client reads every environment, collection, feature flag, feature rule, property and
+// segment before a request changes it, and appends the state it read to a local append-only file once the change
+// succeeds. Undo restores the recorded state, re-creating the resource if it was deleted, or deletes the resource if
+// the change created it.
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrNotFound is returned when the journal has no entry with the requested ID.
+var ErrNotFound = errors.New("journal entry not found")
+
+// Entry : A change recorded in the journal, with the state of the resource before it.
+type Entry struct {
+	// A unique ID, starting with the time of the entry so that IDs sort in the order of the entries.
+	ID string `json:"id"`
+
+	// When the change was made.
+	Time time.Time `json:"time"`
+
+	// The operation that made the change, one of the appconfigurationv1.Operation_ID_* constants.
+	OperationID string `json:"operation_id"`
+
+	// The type of the changed resource, one of the appconfigurationv1.ServiceError_ResourceType_* constants.
+	ResourceType string `json:"resource_type"`
+
+	// The environment of feature flags, feature rules and properties, and the feature flag of feature rules.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	FeatureID     string `json:"feature_id,omitempty"`
+
+	// The ID of the changed resource.
+	ResourceID string `json:"resource_id"`
+
+	// The HTTP status code of the change. 202 means that a workflow approval was initiated for it.
+	StatusCode int `json:"status_code"`
+
+	// The resource before the change, as returned by the service, or null if the change created it.
+	Before json.RawMessage `json:"before"`
+
+	// The ID of the entry that this change undid, for changes made by Undo.
+	Undoes string `json:"undoes,omitempty"`
+}
+
+// Created returns true if the change created the resource.
+func (entry *Entry) Created() bool {
+	return len(entry.Before) == 0 || bytes.Equal(entry.Before, []byte("null"))
+}
+
+// String returns a summary of the entry, for example `20260301T090000.000Z-1a2b3c4d update_feature dev/checkout`.
+func (entry *Entry) String() string {
+	resource := entry.ResourceID
+	if entry.FeatureID != "" {
+		resource = entry.FeatureID + "/" + resource
+	}
+	if entry.EnvironmentID != "" {
+		resource = entry.EnvironmentID + "/" + resource
+	}
+	return fmt.Sprintf("%s %s %s", entry.ID, entry.OperationID, resource)
+}
+
+// Options : Options of a Journal.
+type Options struct {
+	// Called when a change succeeded but could not be appended to the journal. By default the error is logged.
+	OnError func(entry *Entry, err error)
+}
+
+// Journal : An append-only file of changes and the state of the resources before them.
+type Journal struct {
+	path    string
+	onError func(entry *Entry, err error)
+
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// Open opens the journal at path, creating the file if needed. An incomplete last entry, left by a crash while it
+// was appended, is removed.
+func Open(path string, options *Options) (*Journal, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "open-journal-error", common.GetComponentInfo())
+	}
+	content, err := os.ReadFile(path)
+	if err == nil && len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+		err = file.Truncate(int64(bytes.LastIndexByte(content, '\n') + 1))
+	}
+	if err != nil {
+		file.Close()
+		return nil, core.SDKErrorf(err, "", "open-journal-error", common.GetComponentInfo())
+	}
+	journal := &Journal{path: path, file: file}
+	if options != nil {
+		journal.onError = options.OnError
+	}
+	if journal.onError == nil {
+		journal.onError = func(entry *Entry, err error) {
+			core.GetLogger().Error("could not append %s to the journal: %s", entry, err.Error())
+		}
+	}
+	return journal, nil
+}
+
+// Close closes the file of the journal.
+func (journal *Journal) Close() error {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+	if err := journal.file.Close(); err != nil {
+		return core.SDKErrorf(err, "", "close-journal-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Attach records the changes made through client from now on. Each change is preceded by a read of the resource, and
+// fails without being sent if the resource cannot be read.
+func (journal *Journal) Attach(client *appconfigurationv1.AppConfigurationV1) error {
+	return client.AddRequestHook(journal.hook)
+}
+
+// Entries returns the entries of the journal, oldest first.
+func (journal *Journal) Entries() ([]Entry, error) {
+	content, err := os.ReadFile(journal.path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "read-journal-error", common.GetComponentInfo())
+	}
+	entries := []Entry{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// An entry being appended, or left incomplete by a crash, is not read.
+			if !bytes.HasSuffix(content, []byte("\n")) && line == bytes.Count(content, []byte("\n"))+1 {
+				break
+			}
+			return nil, core.SDKErrorf(err, fmt.Sprintf("invalid journal entry on line %d", line), "read-journal-error", common.GetComponentInfo())
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Entry returns the entry with the ID id, or an error matching ErrNotFound.
+func (journal *Journal) Entry(id string) (*Entry, error) {
+	entries, err := journal.Entries()
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if entries[i].ID == id {
+			return &entries[i], nil
+		}
+	}
+	return nil, core.SDKErrorf(fmt.Errorf("%w: %s", ErrNotFound, id), "", "journal-entry-not-found", common.GetComponentInfo())
+}
+
+// append writes an entry as a single line, and syncs the file so that the entry survives a crash.
+func (journal *Journal) append(entry *Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+	if _, err = journal.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return journal.file.Sync()
+}
+
+type undoKey struct{}
+
+// hook reads the resource changed by a mutating operation, and appends an entry once the change succeeds.
+func (journal *Journal) hook(ctx context.Context, operation *appconfigurationv1.Operation) (func(*http.Response, error), error) {
+	entry, ok := newEntry(operation)
+	if !ok {
+		return nil, nil
+	}
+	if undoes, ok := ctx.Value(undoKey{}).(string); ok {
+		entry.Undoes = undoes
+	}
+	if !creates(operation.ID) {
+		before, err := read(ctx, operation.Client, entry)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("could not read %s '%s' for the journal", entry.ResourceType, entry.ResourceID), "journal-read-error", common.GetComponentInfo())
+		}
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return nil, core.SDKErrorf(err, "", "journal-read-error", common.GetComponentInfo())
+		}
+	}
+
+	return func(response *http.Response, err error) {
+		if err != nil || response.StatusCode >= 300 {
+			return
+		}
+		entry.Time = time.Now().UTC()
+		entry.ID = newEntryID(entry.Time)
+		entry.StatusCode = response.StatusCode
+		if err := journal.append(entry); err != nil {
+			journal.onError(entry, core.SDKErrorf(err, "", "append-journal-error", common.GetComponentInfo()))
+		}
+	}, nil
+}
+
+// newEntry returns the entry of an operation, without its before-image, or false if the operation is not journaled.
+func newEntry(operation *appconfigurationv1.Operation) (*Entry, bool) {
+	entry := &Entry{
+		OperationID:   operation.ID,
+		ResourceType:  operation.ResourceType,
+		EnvironmentID: operation.PathParams["environment_id"],
+		Before:        json.RawMessage("null"),
+	}
+	switch operation.ResourceType {
+	case appconfigurationv1.ServiceError_ResourceType_Environment:
+		entry.EnvironmentID = ""
+		entry.ResourceID = operation.PathParams["environment_id"]
+		if options, ok := operation.Options.(*appconfigurationv1.CreateEnvironmentOptions); ok {
+			entry.ResourceID = core.StringNilMapper(options.EnvironmentID)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Collection:
+		entry.ResourceID = operation.PathParams["collection_id"]
+		if options, ok := operation.Options.(*appconfigurationv1.CreateCollectionOptions); ok {
+			entry.ResourceID = core.StringNilMapper(options.CollectionID)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Feature:
+		entry.ResourceID = operation.PathParams["feature_id"]
+		if options, ok := operation.Options.(*appconfigurationv1.CreateFeatureOptions); ok {
+			entry.ResourceID = core.StringNilMapper(options.FeatureID)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_FeatureRule:
+		entry.FeatureID = operation.PathParams["feature_id"]
+		entry.ResourceID = operation.PathParams["rule_id"]
+		if options, ok := operation.Options.(*appconfigurationv1.CreateFeatureRuleOptions); ok {
+			entry.ResourceID = core.StringNilMapper(options.RuleID)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Property:
+		entry.ResourceID = operation.PathParams["property_id"]
+		if options, ok := operation.Options.(*appconfigurationv1.CreatePropertyOptions); ok {
+			entry.ResourceID = core.StringNilMapper(options.PropertyID)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Segment:
+		entry.ResourceID = operation.PathParams["segment_id"]
+		if options, ok := operation.Options.(*appconfigurationv1.CreateSegmentOptions); ok {
+			entry.ResourceID = core.StringNilMapper(options.SegmentID)
+		}
+	default:
+		return nil, false
+	}
+	return entry, entry.ResourceID != ""
+}
+
+func creates(operationID string) bool {
+	switch operationID {
+	case appconfigurationv1.Operation_ID_CreateEnvironment, appconfigurationv1.Operation_ID_CreateCollection,
+		appconfigurationv1.Operation_ID_CreateFeature, appconfigurationv1.Operation_ID_CreateFeatureRule,
+		appconfigurationv1.Operation_ID_CreateProperty, appconfigurationv1.Operation_ID_CreateSegment:
+		return true
+	}
+	return false
+}
+
+func newEntryID(now time.Time) string {
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	return now.Format("20060102T150405.000Z") + "-" + hex.EncodeToString(suffix)
+}
@@ -0,0 +1,248 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package journal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInstance stores resources by path, and records the requests it receives.
+type fakeInstance struct {
+	mutex     sync.Mutex
+	resources map[string]map[string]interface{}
+	requests  []string
+}
+
+func (instance *fakeInstance) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	instance.requests = append(instance.requests, req.Method+" "+req.URL.Path)
+	res.Header().Set("Content-type", "application/json")
+	body := map[string]interface{}{}
+	if content, _ := io.ReadAll(req.Body); len(content) > 0 {
+		_ = json.Unmarshal(content, &body)
+	}
+
+	path := req.URL.Path
+	switch req.Method {
+	case http.MethodPost:
+		for _, key := range []string{"feature_id", "segment_id"} {
+			if id, ok := body[key].(string); ok {
+				path += "/" + id
+			}
+		}
+		if _, exists := instance.resources[path]; exists {
+			res.WriteHeader(409)
+			fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "already exists"}]}`)
+			return
+		}
+		instance.resources[path] = body
+		res.WriteHeader(201)
+	case http.MethodDelete:
+		delete(instance.resources, path)
+		res.WriteHeader(204)
+		return
+	default:
+		if strings.HasSuffix(path, "/toggle") {
+			path = strings.TrimSuffix(path, "/toggle")
+		}
+		resource, ok := instance.resources[path]
+		if !ok {
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Not found"}]}`)
+			return
+		}
+		for key, value := range body {
+			if name, ok := value.(string); req.Method != http.MethodGet && ok && name == "fail" {
+				res.WriteHeader(400)
+				fmt.Fprint(res, `{"errors": [{"code": "bad_request", "message": "Invalid name"}]}`)
+				return
+			}
+			resource[key] = value
+		}
+		body = resource
+	}
+	json.NewEncoder(res).Encode(body)
+}
+
+func newTestJournal(t *testing.T) (*Journal, *appconfigurationv1.AppConfigurationV1, *fakeInstance) {
+	instance := &fakeInstance{resources: map[string]map[string]interface{}{
+		"/environments/dev/features/checkout": {
+			"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN",
+			"enabled_value": true, "disabled_value": false, "enabled": false,
+		},
+	}}
+	server := httptest.NewServer(instance)
+	t.Cleanup(server.Close)
+
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	journal, err := Open(filepath.Join(t.TempDir(), "journal.jsonl"), nil)
+	require.Nil(t, err)
+	t.Cleanup(func() { journal.Close() })
+	require.Nil(t, journal.Attach(client))
+	return journal, client, instance
+}
+
+func TestUndoUpdate(t *testing.T) {
+	journal, client, instance := newTestJournal(t)
+	_, _, err := client.UpdateFeatureValues(&appconfigurationv1.UpdateFeatureValuesOptions{
+		EnvironmentID: core.StringPtr("dev"),
+		FeatureID:     core.StringPtr("checkout"),
+		Name:          core.StringPtr("Checkout v2"),
+	})
+	require.Nil(t, err)
+
+	entries, err := journal.Entries()
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	assert.Equal(t, appconfigurationv1.Operation_ID_UpdateFeatureValues, entry.OperationID)
+	assert.Equal(t, appconfigurationv1.ServiceError_ResourceType_Feature, entry.ResourceType)
+	assert.Equal(t, "dev", entry.EnvironmentID)
+	assert.Equal(t, "checkout", entry.ResourceID)
+	assert.Equal(t, 200, entry.StatusCode)
+	assert.Contains(t, string(entry.Before), `"name":"Checkout"`)
+	assert.False(t, entry.Created())
+
+	_, err = journal.Undo(client, entry.ID)
+	require.Nil(t, err)
+	assert.Equal(t, "Checkout", instance.resources["/environments/dev/features/checkout"]["name"])
+
+	entries, err = journal.Entries()
+	require.Nil(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, entry.ID, entries[1].Undoes)
+	assert.Contains(t, string(entries[1].Before), `"name":"Checkout v2"`)
+}
+
+func TestUndoDeleteAndCreate(t *testing.T) {
+	journal, client, instance := newTestJournal(t)
+	_, _, err := client.DeleteFeature(&appconfigurationv1.DeleteFeatureOptions{
+		EnvironmentID: core.StringPtr("dev"),
+		FeatureID:     core.StringPtr("checkout"),
+	})
+	require.Nil(t, err)
+	_, _, err = client.CreateSegment(&appconfigurationv1.CreateSegmentOptions{
+		Name:      core.StringPtr("Beta"),
+		SegmentID: core.StringPtr("beta"),
+		Rules:     []appconfigurationv1.Rule{{AttributeName: core.StringPtr("email"), Operator: core.StringPtr("endsWith"), Values: []string{"@ibm.com"}}},
+	})
+	require.Nil(t, err)
+
+	entries, err := journal.Entries()
+	require.Nil(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "beta", entries[1].ResourceID)
+	assert.True(t, entries[1].Created())
+
+	_, err = journal.Undo(client, entries[1].ID)
+	require.Nil(t, err)
+	assert.NotContains(t, instance.resources, "/segments/beta")
+
+	instance.requests = nil
+	_, err = journal.Undo(client, entries[0].ID)
+	require.Nil(t, err)
+	assert.Equal(t, []string{
+		"GET /environments/dev/features/checkout",
+		"POST /environments/dev/features",
+	}, instance.requests)
+	recreated := instance.resources["/environments/dev/features/checkout"]
+	assert.Equal(t, "Checkout", recreated["name"])
+	assert.Equal(t, false, recreated["enabled"])
+}
+
+func TestFailedChangesAreNotJournaled(t *testing.T) {
+	journal, client, instance := newTestJournal(t)
+	_, _, err := client.UpdateFeatureValues(&appconfigurationv1.UpdateFeatureValuesOptions{
+		EnvironmentID: core.StringPtr("dev"),
+		FeatureID:     core.StringPtr("checkout"),
+		Name:          core.StringPtr("fail"),
+	})
+	require.NotNil(t, err)
+
+	// The resource cannot be read, so the change is not sent.
+	instance.requests = nil
+	_, _, err = client.ToggleFeature(&appconfigurationv1.ToggleFeatureOptions{
+		EnvironmentID: core.StringPtr("dev"),
+		FeatureID:     core.StringPtr("missing"),
+		Enabled:       core.BoolPtr(true),
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "could not read feature 'missing' for the journal")
+	assert.Equal(t, []string{"GET /environments/dev/features/missing"}, instance.requests)
+
+	entries, err := journal.Entries()
+	require.Nil(t, err)
+	assert.Empty(t, entries)
+	_, err = journal.Undo(client, "unknown")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestEntriesIgnoreIncompleteLastLine(t *testing.T) {
+	journal, client, _ := newTestJournal(t)
+	_, _, err := client.ToggleFeature(&appconfigurationv1.ToggleFeatureOptions{
+		EnvironmentID: core.StringPtr("dev"),
+		FeatureID:     core.StringPtr("checkout"),
+		Enabled:       core.BoolPtr(true),
+	})
+	require.Nil(t, err)
+
+	file, err := os.OpenFile(journal.path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.Nil(t, err)
+	_, err = file.WriteString(`{"id": "20261019T101500.000Z-0`)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	entries, err := journal.Entries()
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, appconfigurationv1.Operation_ID_ToggleFeature, entries[0].OperationID)
+
+	require.Nil(t, journal.Close())
+	reopened, err := Open(journal.path, nil)
+	require.Nil(t, err)
+	defer reopened.Close()
+	require.Nil(t, reopened.Attach(client))
+	_, _, err = client.ToggleFeature(&appconfigurationv1.ToggleFeatureOptions{
+		EnvironmentID: core.StringPtr("dev"),
+		FeatureID:     core.StringPtr("checkout"),
+		Enabled:       core.BoolPtr(false),
+	})
+	require.Nil(t, err)
+	entries, err = reopened.Entries()
+	require.Nil(t, err)
+	assert.Len(t, entries, 2)
+}
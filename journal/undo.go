@@ -0,0 +1,233 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Undo : Restore the state recorded by an entry
+// If the change created the resource, the resource is deleted. Otherwise the resource is updated to its recorded
+// state, or created again with it if it no longer exists. Re-creating a deleted environment or collection does not
+// re-create the resources it contained, and a re-created feature rule is added after the existing rules. When the
+// journal is attached to client, the undo is itself journaled, with Undoes set to entryID.
+func (journal *Journal) Undo(client *appconfigurationv1.AppConfigurationV1, entryID string) (*core.DetailedResponse, error) {
+	response, err := journal.UndoWithContext(context.Background(), client, entryID)
+	return response, core.RepurposeSDKProblem(err, "")
+}
+
+// UndoWithContext is an alternate form of the Undo method which supports a Context parameter
+func (journal *Journal) UndoWithContext(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, entryID string) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(client, "client cannot be nil")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	entry, err := journal.Entry(entryID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, undoKey{}, entry.ID)
+
+	if entry.Created() {
+		response, err = remove(ctx, client, entry)
+	} else {
+		response, err = restore(ctx, client, entry)
+	}
+	if err != nil {
+		return response, core.SDKErrorf(err, fmt.Sprintf("could not undo %s", entry), "undo-error", common.GetComponentInfo())
+	}
+	return response, nil
+}
+
+// read returns the current state of the resource of an entry.
+func read(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, entry *Entry) (result interface{}, err error) {
+	switch entry.ResourceType {
+	case appconfigurationv1.ServiceError_ResourceType_Environment:
+		result, _, err = client.GetEnvironmentWithContext(ctx, &appconfigurationv1.GetEnvironmentOptions{
+			EnvironmentID: &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Collection:
+		result, _, err = client.GetCollectionWithContext(ctx, &appconfigurationv1.GetCollectionOptions{
+			CollectionID: &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Feature:
+		result, _, err = client.GetFeatureWithContext(ctx, &appconfigurationv1.GetFeatureOptions{
+			EnvironmentID: &entry.EnvironmentID,
+			FeatureID:     &entry.ResourceID,
+			Include:       []string{appconfigurationv1.GetFeatureOptions_Include_Collections, appconfigurationv1.GetFeatureOptions_Include_Rules},
+		})
+	case appconfigurationv1.ServiceError_ResourceType_FeatureRule:
+		result, _, err = client.GetFeatureRuleWithContext(ctx, &appconfigurationv1.GetFeatureRuleOptions{
+			EnvironmentID: &entry.EnvironmentID,
+			FeatureID:     &entry.FeatureID,
+			RuleID:        &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Property:
+		result, _, err = client.GetPropertyWithContext(ctx, &appconfigurationv1.GetPropertyOptions{
+			EnvironmentID: &entry.EnvironmentID,
+			PropertyID:    &entry.ResourceID,
+			Include:       []string{appconfigurationv1.GetPropertyOptions_Include_Collections, appconfigurationv1.GetPropertyOptions_Include_Rules},
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Segment:
+		result, _, err = client.GetSegmentWithContext(ctx, &appconfigurationv1.GetSegmentOptions{
+			SegmentID: &entry.ResourceID,
+		})
+	default:
+		err = fmt.Errorf("unsupported resource type '%s'", entry.ResourceType)
+	}
+	return
+}
+
+// remove deletes the resource that an entry created.
+func remove(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, entry *Entry) (response *core.DetailedResponse, err error) {
+	switch entry.ResourceType {
+	case appconfigurationv1.ServiceError_ResourceType_Environment:
+		_, response, err = client.DeleteEnvironmentWithContext(ctx, &appconfigurationv1.DeleteEnvironmentOptions{
+			EnvironmentID: &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Collection:
+		_, response, err = client.DeleteCollectionWithContext(ctx, &appconfigurationv1.DeleteCollectionOptions{
+			CollectionID: &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Feature:
+		_, response, err = client.DeleteFeatureWithContext(ctx, &appconfigurationv1.DeleteFeatureOptions{
+			EnvironmentID: &entry.EnvironmentID,
+			FeatureID:     &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_FeatureRule:
+		_, response, err = client.DeleteFeatureRuleWithContext(ctx, &appconfigurationv1.DeleteFeatureRuleOptions{
+			EnvironmentID: &entry.EnvironmentID,
+			FeatureID:     &entry.FeatureID,
+			RuleID:        &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Property:
+		_, response, err = client.DeletePropertyWithContext(ctx, &appconfigurationv1.DeletePropertyOptions{
+			EnvironmentID: &entry.EnvironmentID,
+			PropertyID:    &entry.ResourceID,
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Segment:
+		_, response, err = client.DeleteSegmentWithContext(ctx, &appconfigurationv1.DeleteSegmentOptions{
+			SegmentID: &entry.ResourceID,
+		})
+	default:
+		err = fmt.Errorf("unsupported resource type '%s'", entry.ResourceType)
+	}
+	return
+}
+
+// restore updates the resource of an entry to its recorded state, or creates it again if it no longer exists.
+func restore(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, entry *Entry) (*core.DetailedResponse, error) {
+	_, err := read(ctx, client, entry)
+	if errors.Is(err, appconfigurationv1.ErrNotFound) {
+		return recreate(ctx, client, entry)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return update(ctx, client, entry)
+}
+
+// update writes the recorded state of an existing resource, with the narrowest operation that changes it.
+func update(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, entry *Entry) (response *core.DetailedResponse, err error) {
+	switch entry.ResourceType {
+	case appconfigurationv1.ServiceError_ResourceType_Environment:
+		_, response, err = client.MutateEnvironment(ctx, entry.ResourceID, func(environment *appconfigurationv1.Environment) error {
+			*environment = appconfigurationv1.Environment{}
+			return json.Unmarshal(entry.Before, environment)
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Collection:
+		_, response, err = client.MutateCollection(ctx, entry.ResourceID, func(collection *appconfigurationv1.Collection) error {
+			*collection = appconfigurationv1.Collection{}
+			return json.Unmarshal(entry.Before, collection)
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Feature:
+		_, response, err = client.MutateFeature(ctx, entry.EnvironmentID, entry.ResourceID, func(feature *appconfigurationv1.Feature) error {
+			*feature = appconfigurationv1.Feature{}
+			return json.Unmarshal(entry.Before, feature)
+		})
+	case appconfigurationv1.ServiceError_ResourceType_FeatureRule:
+		options := &appconfigurationv1.UpdateFeatureRuleOptions{}
+		if err = json.Unmarshal(entry.Before, options); err != nil {
+			return
+		}
+		options.EnvironmentID, options.FeatureID, options.RuleID = &entry.EnvironmentID, &entry.FeatureID, &entry.ResourceID
+		_, response, err = client.UpdateFeatureRuleWithContext(ctx, options)
+	case appconfigurationv1.ServiceError_ResourceType_Property:
+		_, response, err = client.MutateProperty(ctx, entry.EnvironmentID, entry.ResourceID, func(property *appconfigurationv1.Property) error {
+			*property = appconfigurationv1.Property{}
+			return json.Unmarshal(entry.Before, property)
+		})
+	case appconfigurationv1.ServiceError_ResourceType_Segment:
+		_, response, err = client.MutateSegment(ctx, entry.ResourceID, func(segment *appconfigurationv1.Segment) error {
+			*segment = appconfigurationv1.Segment{}
+			return json.Unmarshal(entry.Before, segment)
+		})
+	default:
+		err = fmt.Errorf("unsupported resource type '%s'", entry.ResourceType)
+	}
+	return
+}
+
+// recreate creates a deleted resource from its recorded state. The fields of the resources match the fields of the
+// options that create them, so the recorded state is decoded into the options.
+func recreate(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, entry *Entry) (response *core.DetailedResponse, err error) {
+	switch entry.ResourceType {
+	case appconfigurationv1.ServiceError_ResourceType_Environment:
+		options := &appconfigurationv1.CreateEnvironmentOptions{}
+		if err = json.Unmarshal(entry.Before, options); err == nil {
+			_, response, err = client.CreateEnvironmentWithContext(ctx, options)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Collection:
+		options := &appconfigurationv1.CreateCollectionOptions{}
+		if err = json.Unmarshal(entry.Before, options); err == nil {
+			_, response, err = client.CreateCollectionWithContext(ctx, options)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Feature:
+		options := &appconfigurationv1.CreateFeatureOptions{}
+		if err = json.Unmarshal(entry.Before, options); err == nil {
+			options.EnvironmentID = &entry.EnvironmentID
+			_, response, err = client.CreateFeatureWithContext(ctx, options)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_FeatureRule:
+		options := &appconfigurationv1.CreateFeatureRuleOptions{}
+		if err = json.Unmarshal(entry.Before, options); err == nil {
+			options.EnvironmentID, options.FeatureID = &entry.EnvironmentID, &entry.FeatureID
+			_, response, err = client.CreateFeatureRuleWithContext(ctx, options)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Property:
+		options := &appconfigurationv1.CreatePropertyOptions{}
+		if err = json.Unmarshal(entry.Before, options); err == nil {
+			options.EnvironmentID = &entry.EnvironmentID
+			_, response, err = client.CreatePropertyWithContext(ctx, options)
+		}
+	case appconfigurationv1.ServiceError_ResourceType_Segment:
+		options := &appconfigurationv1.CreateSegmentOptions{}
+		if err = json.Unmarshal(entry.Before, options); err == nil {
+			_, response, err = client.CreateSegmentWithContext(ctx, options)
+		}
+	default:
+		err = fmt.Errorf("unsupported resource type '%s'", entry.ResourceType)
+	}
+	return
+}
@@ -0,0 +1,436 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package materialize : Rendering of evaluated properties as Kubernetes manifests and env files
+// A Materializer evaluates the properties of an environment (optionally of one collection) for an entity, with the
+// evaluator package, and renders them as a `.env` file, a ConfigMap or a Secret manifest. SECRETREF properties are not
+// resolved: in manifests they become an ExternalSecret (External Secrets Operator) reading the IBM Cloud Secrets
+// Manager secret, and in env files a comment naming the secret.
+package materialize
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Options.Format property.
+const (
+	FormatDotenv    = "dotenv"
+	FormatConfigMap = "configmap"
+	FormatSecret    = "secret"
+)
+
+// NameMapping : How property IDs are turned into variable or key names. Rename takes precedence; other names are
+// prefixed, then optionally uppercased. Characters not allowed by the output format are replaced with underscores.
+type NameMapping struct {
+	// Explicit names by property ID.
+	Rename map[string]string
+
+	Prefix    string
+	Uppercase bool
+}
+
+// Name returns the name of a property before format-specific sanitization.
+func (mapping NameMapping) Name(propertyID string) string {
+	if name, ok := mapping.Rename[propertyID]; ok {
+		return name
+	}
+	name := mapping.Prefix + propertyID
+	if mapping.Uppercase {
+		name = strings.ToUpper(name)
+	}
+	return name
+}
+
+// Options : What to materialize and how.
+type Options struct {
+	// The environment whose properties are evaluated.
+	EnvironmentID string
+
+	// If set, only the properties of this collection are materialized.
+	CollectionID string
+
+	// The entity the properties are evaluated for. An ID is required if properties have segment rules.
+	Entity evaluator.Entity
+
+	Naming NameMapping
+
+	// One of the Format* constants.
+	Format string
+
+	// The name and namespace of the ConfigMap or Secret.
+	Name      string
+	Namespace string
+
+	// The SecretStore (or ClusterSecretStore, see SecretStoreKind) of the ExternalSecret generated for SECRETREF
+	// properties in manifests. Required if there are such properties.
+	SecretStore     string
+	SecretStoreKind string
+
+	// The file written by Write and Run.
+	Output string
+
+	// The permissions of the output file. Defaults to 0600 for Secret manifests and dotenv files, which hold property
+	// values, and to 0644 for ConfigMap manifests.
+	FileMode os.FileMode
+
+	// Called by Write and Run after the output file changed.
+	OnChange func(path string)
+}
+
+// SecretRef : A reference to an IBM Cloud Secrets Manager secret, the value of a SECRETREF property.
+type SecretRef struct {
+	ID            string
+	SecretType    string
+	SMInstanceCRN string
+}
+
+// Value : A materialized property.
+type Value struct {
+	// The variable or key name.
+	Name string
+
+	PropertyID string
+
+	// The value as a string, empty for secret references.
+	Value string
+
+	// Set for SECRETREF properties.
+	Secret *SecretRef
+}
+
+// Materialize evaluates the properties of an instance configuration, as returned by ListInstanceConfig. The values are
+// sorted by name.
+func Materialize(config *appconfigurationv1.ImportConfig, options *Options) ([]Value, error) {
+	var environment *appconfigurationv1.ImportEnvironmentSchema
+	for i := range config.Environments {
+		if config.Environments[i].EnvironmentID != nil && *config.Environments[i].EnvironmentID == options.EnvironmentID {
+			environment = &config.Environments[i]
+		}
+	}
+	if environment == nil {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("environment '%s' is not in the configuration", options.EnvironmentID), "unknown-environment", common.GetComponentInfo())
+	}
+
+	segments := make([]appconfigurationv1.Segment, len(config.Segments))
+	for i, segment := range config.Segments {
+		segments[i] = appconfigurationv1.Segment{SegmentID: segment.SegmentID, Name: segment.Name, Rules: segment.Rules}
+	}
+	properties := []appconfigurationv1.Property{}
+	for _, property := range environment.Properties {
+		if options.CollectionID != "" && !inCollection(property.Collections, options.CollectionID) {
+			continue
+		}
+		properties = append(properties, appconfigurationv1.Property{
+			PropertyID:   property.PropertyID,
+			Name:         property.Name,
+			Type:         property.Type,
+			Format:       property.Format,
+			Value:        property.Value,
+			SegmentRules: property.SegmentRules,
+		})
+	}
+	return evaluate(properties, segments, options)
+}
+
+func evaluate(properties []appconfigurationv1.Property, segments []appconfigurationv1.Segment, options *Options) ([]Value, error) {
+	propertyEvaluator := evaluator.New(segments)
+	values := []Value{}
+	names := map[string]string{}
+	for i := range properties {
+		property := &properties[i]
+		propertyID := stringValue(property.PropertyID)
+		result, err := propertyEvaluator.EvaluateProperty(property, options.Entity)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("unable to evaluate property '%s'", propertyID), "evaluate-property-error", common.GetComponentInfo())
+		}
+
+		name := sanitizeName(options.Format, options.Naming.Name(propertyID))
+		if other, ok := names[name]; ok {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("properties '%s' and '%s' are both named '%s'", other, propertyID, name), "duplicate-name", common.GetComponentInfo())
+		}
+		names[name] = propertyID
+
+		value := Value{Name: name, PropertyID: propertyID}
+		if stringValue(property.Type) == appconfigurationv1.ImportPropertyRequestBody_Type_Secretref {
+			value.Secret = secretRef(result.Value, property.Value)
+			if value.Secret.ID == "" {
+				return nil, core.SDKErrorf(nil, fmt.Sprintf("property '%s' has no secret ID", propertyID), "invalid-secret-reference", common.GetComponentInfo())
+			}
+		} else {
+			value.Value = valueString(result.Value)
+		}
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Name < values[j].Name })
+	return values, nil
+}
+
+// secretRef returns the secret reference of a SECRETREF value. Segment rule values may only set the secret ID, the
+// other fields then come from the value of the property.
+func secretRef(value interface{}, propertyValue interface{}) *SecretRef {
+	field := func(key string) string {
+		for _, source := range []interface{}{value, propertyValue} {
+			if fields, ok := source.(map[string]interface{}); ok {
+				if text, ok := fields[key].(string); ok && text != "" {
+					return text
+				}
+			}
+		}
+		return ""
+	}
+	return &SecretRef{ID: field("id"), SecretType: field("secret_type"), SMInstanceCRN: field("sm_instance_crn")}
+}
+
+// valueString returns a property value as a string: booleans and numbers in their literal form, JSON values in
+// compact JSON, YAML and text values as they are.
+func valueString(value interface{}) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case bool:
+		return strconv.FormatBool(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(buffer)
+}
+
+var (
+	invalidVariableCharacters = regexp.MustCompile(`[^A-Za-z0-9_]`)
+	invalidKeyCharacters      = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+)
+
+// sanitizeName replaces the characters not allowed in env variable names, or in ConfigMap and Secret keys.
+func sanitizeName(format string, name string) string {
+	if format == FormatDotenv {
+		name = invalidVariableCharacters.ReplaceAllString(name, "_")
+		if name != "" && name[0] >= '0' && name[0] <= '9' {
+			name = "_" + name
+		}
+		return name
+	}
+	return invalidKeyCharacters.ReplaceAllString(name, "_")
+}
+
+// Materializer : Materializes the properties of an instance to a file.
+type Materializer struct {
+	client  *appconfigurationv1.AppConfigurationV1
+	options Options
+
+	mutex   sync.Mutex
+	written []byte
+}
+
+// NewMaterializer returns a materializer reading properties and segments with client.
+func NewMaterializer(client *appconfigurationv1.AppConfigurationV1, options *Options) (*Materializer, error) {
+	if client == nil {
+		return nil, core.SDKErrorf(nil, "a client is required", "missing-client", common.GetComponentInfo())
+	}
+	if options == nil || options.EnvironmentID == "" {
+		return nil, core.SDKErrorf(nil, "an environment ID is required", "missing-environment", common.GetComponentInfo())
+	}
+	switch options.Format {
+	case FormatDotenv:
+	case FormatConfigMap, FormatSecret:
+		if options.Name == "" {
+			return nil, core.SDKErrorf(nil, "a name is required for Kubernetes manifests", "missing-name", common.GetComponentInfo())
+		}
+	default:
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("unknown format '%s'", options.Format), "invalid-format", common.GetComponentInfo())
+	}
+	return &Materializer{client: client, options: *options}, nil
+}
+
+// Values evaluates the properties.
+func (materializer *Materializer) Values() ([]Value, error) {
+	return materializer.ValuesWithContext(context.Background())
+}
+
+// ValuesWithContext is an alternate form of the Values method which supports a Context parameter
+func (materializer *Materializer) ValuesWithContext(ctx context.Context) ([]Value, error) {
+	listOptions := &appconfigurationv1.ListPropertiesOptions{
+		EnvironmentID: core.StringPtr(materializer.options.EnvironmentID),
+		Expand:        core.BoolPtr(true),
+		Include:       []string{appconfigurationv1.ListPropertiesOptions_Include_Rules},
+	}
+	if materializer.options.CollectionID != "" {
+		listOptions.Collections = []string{materializer.options.CollectionID}
+	}
+	propertiesPager, err := materializer.client.NewPropertiesPager(listOptions)
+	if err != nil {
+		return nil, err
+	}
+	properties, err := propertiesPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []appconfigurationv1.Segment
+	for _, property := range properties {
+		if len(property.SegmentRules) > 0 {
+			segmentsPager, err := materializer.client.NewSegmentsPager(&appconfigurationv1.ListSegmentsOptions{
+				Expand:  core.BoolPtr(true),
+				Include: []string{appconfigurationv1.ListSegmentsOptions_Include_Rules},
+			})
+			if err != nil {
+				return nil, err
+			}
+			if segments, err = segmentsPager.GetAllWithContext(ctx); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return evaluate(properties, segments, &materializer.options)
+}
+
+// Render evaluates the properties and renders them in the format of the options.
+func (materializer *Materializer) Render() ([]byte, error) {
+	return materializer.RenderWithContext(context.Background())
+}
+
+// RenderWithContext is an alternate form of the Render method which supports a Context parameter
+func (materializer *Materializer) RenderWithContext(ctx context.Context) ([]byte, error) {
+	values, err := materializer.ValuesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Render(values, &materializer.options)
+}
+
+// Write renders the properties to the output file, replacing it atomically, and reports whether its content changed.
+// The file is not rewritten if it is unchanged.
+func (materializer *Materializer) Write() (bool, error) {
+	return materializer.WriteWithContext(context.Background())
+}
+
+// WriteWithContext is an alternate form of the Write method which supports a Context parameter
+func (materializer *Materializer) WriteWithContext(ctx context.Context) (bool, error) {
+	if materializer.options.Output == "" {
+		return false, core.SDKErrorf(nil, "an output file is required", "missing-output", common.GetComponentInfo())
+	}
+	content, err := materializer.RenderWithContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	materializer.mutex.Lock()
+	defer materializer.mutex.Unlock()
+	if materializer.written == nil {
+		if existing, err := os.ReadFile(materializer.options.Output); err == nil {
+			materializer.written = existing
+		}
+	}
+	if materializer.written != nil && bytes.Equal(materializer.written, content) {
+		return false, nil
+	}
+	if err := writeFile(materializer.options.Output, content, materializer.fileMode()); err != nil {
+		return false, err
+	}
+	materializer.written = content
+	if materializer.options.OnChange != nil {
+		materializer.options.OnChange(materializer.options.Output)
+	}
+	return true, nil
+}
+
+// Run writes the output file every interval until ctx is done. Errors are passed to onError, which may be nil, and the
+// previous output is kept.
+func (materializer *Materializer) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := materializer.WriteWithContext(ctx); err != nil && ctx.Err() == nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fileMode returns the permissions of the output file.
+func (materializer *Materializer) fileMode() os.FileMode {
+	if materializer.options.FileMode != 0 {
+		return materializer.options.FileMode
+	}
+	if materializer.options.Format == FormatConfigMap {
+		return 0644
+	}
+	return 0600
+}
+
+// writeFile replaces a file atomically, through a temporary file in the same directory.
+func writeFile(path string, content []byte, mode os.FileMode) error {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-output-error", common.GetComponentInfo())
+	}
+	defer os.Remove(file.Name())
+	if _, err = file.Write(content); err == nil {
+		err = file.Chmod(mode)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-output-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func inCollection(collections []appconfigurationv1.CollectionRef, collectionID string) bool {
+	for _, collection := range collections {
+		if collection.CollectionID != nil && *collection.CollectionID == collectionID {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
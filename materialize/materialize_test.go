@@ -0,0 +1,233 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package materialize
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProperties = `[
+	{"name": "Host", "property_id": "db-host", "type": "STRING", "format": "TEXT", "value": "db.internal", "collections": [{"collection_id": "api"}]},
+	{"name": "Pool", "property_id": "pool-size", "type": "NUMERIC", "value": 10, "collections": [{"collection_id": "api"}],
+	 "segment_rules": [{"rules": [{"segments": ["big"]}], "value": 50, "order": 1}]},
+	{"name": "Limits", "property_id": "limits", "type": "STRING", "format": "JSON", "value": {"rps": 5}, "collections": [{"collection_id": "api"}]},
+	{"name": "Banner", "property_id": "banner", "type": "STRING", "format": "TEXT", "value": "It's $5\nToday", "collections": [{"collection_id": "api"}]},
+	{"name": "Password", "property_id": "db-password", "type": "SECRETREF",
+	 "value": {"id": "abc-1", "secret_type": "kv", "sm_instance_crn": "crn:v1:sm"}, "collections": [{"collection_id": "api"}],
+	 "segment_rules": [{"rules": [{"segments": ["big"]}], "value": {"id": "abc-2"}, "order": 1}]},
+	{"name": "Other", "property_id": "other", "type": "BOOLEAN", "value": true}
+]`
+
+const testSegments = `[{"name": "Big", "segment_id": "big", "rules": [{"attribute_name": "tier", "operator": "is", "values": ["large"]}]}]`
+
+func testConfig(t *testing.T) *appconfigurationv1.ImportConfig {
+	config, err := evaluator.LoadConfig(strings.NewReader(
+		`{"environments": [{"name": "Dev", "environment_id": "dev", "properties": ` + testProperties + `}], "segments": ` + testSegments + `}`))
+	require.Nil(t, err)
+	return config
+}
+
+func TestMaterializeDotenv(t *testing.T) {
+	options := &Options{
+		EnvironmentID: "dev",
+		CollectionID:  "api",
+		Entity:        evaluator.Entity{ID: "pod-1", Attributes: map[string]interface{}{"tier": "large"}},
+		Naming:        NameMapping{Prefix: "app-", Uppercase: true, Rename: map[string]string{"limits": "RATE_LIMITS"}},
+		Format:        FormatDotenv,
+	}
+	values, err := Materialize(testConfig(t), options)
+	require.Nil(t, err)
+	require.Len(t, values, 5)
+	assert.Equal(t, Value{Name: "APP_DB_PASSWORD", PropertyID: "db-password", Secret: &SecretRef{ID: "abc-2", SecretType: "kv", SMInstanceCRN: "crn:v1:sm"}}, values[2])
+
+	content, err := Render(values, options)
+	require.Nil(t, err)
+	assert.Equal(t, `APP_BANNER="It's \$5\nToday"
+APP_DB_HOST=db.internal
+# APP_DB_PASSWORD: secret kv/abc-2 of crn:v1:sm is not materialized
+APP_POOL_SIZE=50
+RATE_LIMITS='{"rps":5}'
+`, string(content))
+
+	options.Entity = evaluator.Entity{}
+	_, err = Materialize(testConfig(t), options)
+	assert.NotNil(t, err)
+
+	options.Naming = NameMapping{Rename: map[string]string{"limits": "db-host"}}
+	options.Entity = evaluator.Entity{ID: "pod-1"}
+	_, err = Materialize(testConfig(t), options)
+	assert.NotNil(t, err)
+}
+
+func TestMaterializeManifests(t *testing.T) {
+	options := &Options{
+		EnvironmentID: "dev",
+		CollectionID:  "api",
+		Entity:        evaluator.Entity{ID: "pod-1"},
+		Format:        FormatConfigMap,
+		Name:          "api-config",
+		Namespace:     "shop",
+	}
+	values, err := Materialize(testConfig(t), options)
+	require.Nil(t, err)
+	_, err = Render(values, options)
+	assert.NotNil(t, err)
+
+	options.SecretStore = "ibm-sm"
+	content, err := Render(values, options)
+	require.Nil(t, err)
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: api-config
+  namespace: shop
+data:
+  banner: |-
+    It's $5
+    Today
+  db-host: db.internal
+  limits: '{"rps":5}'
+  pool-size: "10"
+---
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+metadata:
+  name: api-config-secrets
+  namespace: shop
+spec:
+  refreshInterval: 1h
+  secretStoreRef:
+    name: ibm-sm
+    kind: SecretStore
+  target:
+    name: api-config-secrets
+  data:
+    - secretKey: db-password
+      remoteRef:
+        key: kv/abc-1
+`, string(content))
+
+	options.Format = FormatSecret
+	content, err = Render(values, options)
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "apiVersion: v1\nkind: Secret\nmetadata:\n  name: api-config\n  namespace: shop\ntype: Opaque\nstringData:\n"))
+}
+
+func TestMaterializerFileMode(t *testing.T) {
+	for _, test := range []struct {
+		options Options
+		mode    os.FileMode
+	}{
+		{Options{Format: FormatSecret}, 0600},
+		{Options{Format: FormatDotenv}, 0600},
+		{Options{Format: FormatConfigMap}, 0644},
+		{Options{Format: FormatSecret, FileMode: 0640}, 0640},
+	} {
+		materializer := &Materializer{options: test.options}
+		assert.Equal(t, test.mode, materializer.fileMode(), test.options.Format)
+
+		path := filepath.Join(t.TempDir(), "output")
+		require.Nil(t, writeFile(path, []byte("data"), materializer.fileMode()))
+		info, err := os.Stat(path)
+		require.Nil(t, err)
+		assert.Equal(t, test.mode, info.Mode().Perm())
+	}
+}
+
+func TestMaterializerWatch(t *testing.T) {
+	var mutex sync.Mutex
+	host := "db.internal"
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		res.Header().Set("Content-type", "application/json")
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/properties"):
+			assert.Equal(t, "api", req.URL.Query().Get("collections"))
+			fmt.Fprintf(res, `{"properties": [{"name": "Host", "property_id": "db-host", "type": "STRING", "format": "TEXT", "value": %q}], "total_count": 1}`, host)
+		case strings.HasSuffix(req.URL.Path, "/segments"):
+			fmt.Fprintf(res, `{"segments": %s, "total_count": 1}`, testSegments)
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	output := filepath.Join(t.TempDir(), "app.env")
+	changes := make(chan string, 10)
+	materializer, err := NewMaterializer(client, &Options{
+		EnvironmentID: "dev",
+		CollectionID:  "api",
+		Format:        FormatDotenv,
+		Naming:        NameMapping{Uppercase: true},
+		Output:        output,
+		OnChange:      func(path string) { changes <- path },
+	})
+	require.Nil(t, err)
+
+	changed, err := materializer.Write()
+	require.Nil(t, err)
+	assert.True(t, changed)
+	info, err := os.Stat(output)
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	changed, err = materializer.Write()
+	require.Nil(t, err)
+	assert.False(t, changed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		materializer.Run(ctx, 10*time.Millisecond, func(err error) { t.Error(err) })
+		close(done)
+	}()
+	mutex.Lock()
+	host = "db2.internal"
+	mutex.Unlock()
+	assert.Eventually(t, func() bool {
+		content, _ := os.ReadFile(output)
+		return string(content) == "DB_HOST=db2.internal\n"
+	}, 2*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+	assert.Len(t, changes, 2)
+
+	_, err = NewMaterializer(client, &Options{EnvironmentID: "dev", Format: FormatConfigMap})
+	assert.NotNil(t, err)
+	_, err = NewMaterializer(client, &Options{EnvironmentID: "dev", Format: "toml"})
+	assert.NotNil(t, err)
+}
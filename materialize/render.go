@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package materialize
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// ExternalSecretSuffix is appended to Options.Name to name the ExternalSecret of the SECRETREF properties and the
+// Secret it creates.
+const ExternalSecretSuffix = "-secrets"
+
+// ExternalSecretRefreshInterval is how often the External Secrets Operator reads the secrets again.
+const ExternalSecretRefreshInterval = "1h"
+
+// Render renders values in the format of the options.
+func Render(values []Value, options *Options) ([]byte, error) {
+	switch options.Format {
+	case FormatDotenv:
+		return renderDotenv(values), nil
+	case FormatConfigMap, FormatSecret:
+		return renderManifests(values, options)
+	}
+	return nil, core.SDKErrorf(nil, fmt.Sprintf("unknown format '%s'", options.Format), "invalid-format", common.GetComponentInfo())
+}
+
+var plainDotenvValue = regexp.MustCompile(`^[A-Za-z0-9_./:@,+-]*$`)
+
+// renderDotenv writes one `NAME=value` line per value. Values are single-quoted unless they only contain safe
+// characters, and double-quoted with escapes if they contain single quotes or line breaks.
+func renderDotenv(values []Value) []byte {
+	var buffer bytes.Buffer
+	for _, value := range values {
+		if value.Secret != nil {
+			fmt.Fprintf(&buffer, "# %s: secret %s is not materialized\n", value.Name, describeSecret(value.Secret))
+			continue
+		}
+		switch {
+		case plainDotenvValue.MatchString(value.Value):
+			fmt.Fprintf(&buffer, "%s=%s\n", value.Name, value.Value)
+		case !strings.ContainsAny(value.Value, "'\n\r"):
+			fmt.Fprintf(&buffer, "%s='%s'\n", value.Name, value.Value)
+		default:
+			replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "$", `\$`)
+			fmt.Fprintf(&buffer, "%s=\"%s\"\n", value.Name, replacer.Replace(value.Value))
+		}
+	}
+	return buffer.Bytes()
+}
+
+func describeSecret(secret *SecretRef) string {
+	description := secretKey(secret)
+	if secret.SMInstanceCRN != "" {
+		description += " of " + secret.SMInstanceCRN
+	}
+	return description
+}
+
+// secretKey returns the key of a secret for the IBM provider of the External Secrets Operator: `<secret type>/<id>`.
+func secretKey(secret *SecretRef) string {
+	if secret.SecretType == "" {
+		return secret.ID
+	}
+	return secret.SecretType + "/" + secret.ID
+}
+
+type objectMeta struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+type dataObject struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   objectMeta        `yaml:"metadata"`
+	Type       string            `yaml:"type,omitempty"`
+	Data       map[string]string `yaml:"data,omitempty"`
+	StringData map[string]string `yaml:"stringData,omitempty"`
+}
+
+type externalSecret struct {
+	APIVersion string             `yaml:"apiVersion"`
+	Kind       string             `yaml:"kind"`
+	Metadata   objectMeta         `yaml:"metadata"`
+	Spec       externalSecretSpec `yaml:"spec"`
+}
+
+type externalSecretSpec struct {
+	RefreshInterval string `yaml:"refreshInterval"`
+	SecretStoreRef  struct {
+		Name string `yaml:"name"`
+		Kind string `yaml:"kind"`
+	} `yaml:"secretStoreRef"`
+	Target struct {
+		Name string `yaml:"name"`
+	} `yaml:"target"`
+	Data []externalSecretData `yaml:"data"`
+}
+
+type externalSecretData struct {
+	SecretKey string `yaml:"secretKey"`
+	RemoteRef struct {
+		Key string `yaml:"key"`
+	} `yaml:"remoteRef"`
+}
+
+// renderManifests writes a ConfigMap or a Secret holding the values, followed, if there are secret references, by an
+// ExternalSecret creating a Secret named Options.Name + ExternalSecretSuffix.
+func renderManifests(values []Value, options *Options) ([]byte, error) {
+	data := map[string]string{}
+	var secrets []externalSecretData
+	for _, value := range values {
+		if value.Secret == nil {
+			data[value.Name] = value.Value
+			continue
+		}
+		entry := externalSecretData{SecretKey: value.Name}
+		entry.RemoteRef.Key = secretKey(value.Secret)
+		secrets = append(secrets, entry)
+	}
+
+	metadata := objectMeta{Name: options.Name, Namespace: options.Namespace}
+	object := dataObject{APIVersion: "v1", Kind: "ConfigMap", Metadata: metadata, Data: data}
+	if options.Format == FormatSecret {
+		object = dataObject{APIVersion: "v1", Kind: "Secret", Metadata: metadata, Type: "Opaque", StringData: data}
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(object); err != nil {
+		return nil, core.SDKErrorf(err, "", "render-manifest-error", common.GetComponentInfo())
+	}
+	if len(secrets) > 0 {
+		if options.SecretStore == "" {
+			return nil, core.SDKErrorf(nil, "a secret store is required to reference SECRETREF properties", "missing-secret-store", common.GetComponentInfo())
+		}
+		external := externalSecret{
+			APIVersion: "external-secrets.io/v1beta1",
+			Kind:       "ExternalSecret",
+			Metadata:   objectMeta{Name: options.Name + ExternalSecretSuffix, Namespace: options.Namespace},
+		}
+		external.Spec.RefreshInterval = ExternalSecretRefreshInterval
+		external.Spec.SecretStoreRef.Name = options.SecretStore
+		external.Spec.SecretStoreRef.Kind = options.SecretStoreKind
+		if external.Spec.SecretStoreRef.Kind == "" {
+			external.Spec.SecretStoreRef.Kind = "SecretStore"
+		}
+		external.Spec.Target.Name = options.Name + ExternalSecretSuffix
+		external.Spec.Data = secrets
+		if err := encoder.Encode(external); err != nil {
+			return nil, core.SDKErrorf(err, "", "render-manifest-error", common.GetComponentInfo())
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, core.SDKErrorf(err, "", "render-manifest-error", common.GetComponentInfo())
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,347 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package openfeature : An OpenFeature provider evaluating App Configuration exports locally
+// The Provider loads an instance configuration, either from a file (for example the output of ListInstanceConfig, or
+// a snapshot of the backup package) or from ListInstanceConfig, and evaluates its features and properties with the
+// evaluator package. It implements the FeatureProvider and StateHandler interfaces of the OpenFeature Go SDK
+// (github.com/open-feature/go-sdk/openfeature), so it is registered with openfeature.SetProvider.
+//
+// The targeting key of the evaluation context is used as the App Configuration entity ID, and the other attributes as
+// the entity attributes. Flag keys are looked up among the feature IDs first, then among the property IDs.
+package openfeature
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/IBM/go-sdk-core/v5/core"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"gopkg.in/yaml.v3"
+)
+
+// ProviderName is the name reported in the provider metadata.
+const ProviderName = "IBM App Configuration"
+
+// Options : Where the provider loads its configuration from. Exactly one of File and Client must be set.
+type Options struct {
+	// The environment whose features and properties are evaluated.
+	EnvironmentID string
+
+	// A file holding an instance configuration, plain or gzip-compressed JSON.
+	File string
+
+	// A client whose ListInstanceConfig is loaded.
+	Client *appconfigurationv1.AppConfigurationV1
+
+	// How often the file is checked for changes, or the instance configuration fetched again. Reloading is disabled
+	// if zero.
+	ReloadInterval time.Duration
+
+	// Called when a reload fails. The previous configuration is kept.
+	OnReloadError func(error)
+}
+
+// Provider : Evaluates flags against a locally held instance configuration.
+type Provider struct {
+	options  Options
+	snapshot atomic.Pointer[evaluator.Snapshot]
+
+	fileMutex   sync.Mutex
+	fileModTime time.Time
+	fileSize    int64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var (
+	_ of.FeatureProvider = (*Provider)(nil)
+	_ of.StateHandler    = (*Provider)(nil)
+)
+
+// NewProvider loads the configuration described by options and, if options.ReloadInterval is set, starts reloading
+// it in the background until Close is called.
+func NewProvider(options *Options) (*Provider, error) {
+	if options == nil || options.EnvironmentID == "" {
+		return nil, core.SDKErrorf(nil, "an environment ID is required", "missing-environment", common.GetComponentInfo())
+	}
+	if (options.File == "") == (options.Client == nil) {
+		return nil, core.SDKErrorf(nil, "exactly one of File and Client must be set", "invalid-provider-source", common.GetComponentInfo())
+	}
+
+	provider := &Provider{options: *options}
+	if err := provider.Reload(); err != nil {
+		return nil, err
+	}
+	if options.ReloadInterval > 0 {
+		var ctx context.Context
+		ctx, provider.cancel = context.WithCancel(context.Background())
+		provider.done = make(chan struct{})
+		go provider.watch(ctx)
+	}
+	return provider, nil
+}
+
+// NewProviderFromConfig returns a provider for an environment of an instance configuration held in memory.
+func NewProviderFromConfig(config *appconfigurationv1.ImportConfig, environmentID string) (*Provider, error) {
+	snapshot, err := evaluator.NewSnapshot(config, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	provider := &Provider{options: Options{EnvironmentID: environmentID}}
+	provider.snapshot.Store(snapshot)
+	return provider, nil
+}
+
+// Metadata returns the provider metadata.
+func (provider *Provider) Metadata() of.Metadata {
+	return of.Metadata{Name: ProviderName}
+}
+
+// Hooks returns the hooks of the provider. It has none.
+func (provider *Provider) Hooks() []of.Hook {
+	return []of.Hook{}
+}
+
+// Init is called by OpenFeature when the provider is registered. The configuration is already loaded by NewProvider.
+func (provider *Provider) Init(evaluationContext of.EvaluationContext) error {
+	if provider.snapshot.Load() == nil {
+		return of.NewProviderNotReadyResolutionError("the configuration is not loaded")
+	}
+	return nil
+}
+
+// Shutdown is called by OpenFeature when the provider is replaced or OpenFeature shuts down. It stops reloading the
+// configuration, like Close.
+func (provider *Provider) Shutdown() {
+	provider.Close()
+}
+
+// Reload loads the configuration again. For a file, it is only read if it changed since the last load.
+func (provider *Provider) Reload() error {
+	return provider.ReloadWithContext(context.Background())
+}
+
+// ReloadWithContext is an alternate form of the Reload method which supports a Context parameter
+func (provider *Provider) ReloadWithContext(ctx context.Context) error {
+	var config *appconfigurationv1.ImportConfig
+	var err error
+	switch {
+	case provider.options.Client != nil:
+		config, _, err = provider.options.Client.ListInstanceConfigWithContext(ctx, &appconfigurationv1.ListInstanceConfigOptions{})
+	case provider.options.File != "":
+		provider.fileMutex.Lock()
+		defer provider.fileMutex.Unlock()
+		info, statErr := os.Stat(provider.options.File)
+		if statErr != nil {
+			return core.SDKErrorf(statErr, "", "stat-config-error", common.GetComponentInfo())
+		}
+		if provider.snapshot.Load() != nil && info.ModTime().Equal(provider.fileModTime) && info.Size() == provider.fileSize {
+			return nil
+		}
+		config, err = evaluator.LoadConfigFile(provider.options.File)
+		if err == nil {
+			provider.fileModTime, provider.fileSize = info.ModTime(), info.Size()
+		}
+	default:
+		return nil
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "unable to load the configuration", "load-config-error", common.GetComponentInfo())
+	}
+
+	snapshot, err := evaluator.NewSnapshot(config, provider.options.EnvironmentID)
+	if err != nil {
+		return err
+	}
+	provider.snapshot.Store(snapshot)
+	return nil
+}
+
+// Close stops reloading the configuration.
+func (provider *Provider) Close() {
+	if provider.cancel != nil {
+		provider.cancel()
+		<-provider.done
+		provider.cancel = nil
+	}
+}
+
+func (provider *Provider) watch(ctx context.Context) {
+	defer close(provider.done)
+	ticker := time.NewTicker(provider.options.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := provider.ReloadWithContext(ctx); err != nil && ctx.Err() == nil && provider.options.OnReloadError != nil {
+				provider.options.OnReloadError(err)
+			}
+		}
+	}
+}
+
+// BooleanEvaluation resolves a BOOLEAN feature or property.
+func (provider *Provider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, flatCtx of.FlattenedContext) of.BoolResolutionDetail {
+	value, detail, ok := provider.evaluate(flag, flatCtx)
+	if ok {
+		if typed, isBool := value.(bool); isBool {
+			return of.BoolResolutionDetail{Value: typed, ProviderResolutionDetail: detail}
+		}
+		detail = typeMismatch(flag, "boolean", value)
+	}
+	return of.BoolResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+}
+
+// StringEvaluation resolves a STRING feature or property. JSON values are returned in their JSON form.
+func (provider *Provider) StringEvaluation(ctx context.Context, flag string, defaultValue string, flatCtx of.FlattenedContext) of.StringResolutionDetail {
+	value, detail, ok := provider.evaluate(flag, flatCtx)
+	if ok {
+		switch typed := value.(type) {
+		case string:
+			return of.StringResolutionDetail{Value: typed, ProviderResolutionDetail: detail}
+		case map[string]interface{}, []interface{}:
+			if buffer, err := json.Marshal(typed); err == nil {
+				return of.StringResolutionDetail{Value: string(buffer), ProviderResolutionDetail: detail}
+			}
+		}
+		detail = typeMismatch(flag, "string", value)
+	}
+	return of.StringResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+}
+
+// FloatEvaluation resolves a NUMERIC feature or property.
+func (provider *Provider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, flatCtx of.FlattenedContext) of.FloatResolutionDetail {
+	value, detail, ok := provider.evaluate(flag, flatCtx)
+	if ok {
+		if typed, isNumber := toFloat(value); isNumber {
+			return of.FloatResolutionDetail{Value: typed, ProviderResolutionDetail: detail}
+		}
+		detail = typeMismatch(flag, "float", value)
+	}
+	return of.FloatResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+}
+
+// IntEvaluation resolves a NUMERIC feature or property whose value is a whole number.
+func (provider *Provider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, flatCtx of.FlattenedContext) of.IntResolutionDetail {
+	value, detail, ok := provider.evaluate(flag, flatCtx)
+	if ok {
+		if typed, isNumber := toFloat(value); isNumber && typed == math.Trunc(typed) {
+			return of.IntResolutionDetail{Value: int64(typed), ProviderResolutionDetail: detail}
+		}
+		detail = typeMismatch(flag, "integer", value)
+	}
+	return of.IntResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+}
+
+// ObjectEvaluation resolves a feature or property of any type. YAML values are parsed, JSON values are returned as
+// decoded, and other values as they are.
+func (provider *Provider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, flatCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	value, detail, ok := provider.evaluate(flag, flatCtx)
+	if ok {
+		if text, isText := value.(string); isText && detail.FlagMetadata["format"] == appconfigurationv1.Feature_Format_Yaml {
+			var parsed interface{}
+			if err := yaml.Unmarshal([]byte(text), &parsed); err != nil {
+				detail = errorDetail(of.NewParseErrorResolutionError(fmt.Sprintf("flag '%s' has an invalid YAML value", flag), err))
+				return of.InterfaceResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+			}
+			value = parsed
+		}
+		return of.InterfaceResolutionDetail{Value: value, ProviderResolutionDetail: detail}
+	}
+	return of.InterfaceResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+}
+
+// evaluate resolves a flag to its raw value, or returns false with a detail holding the resolution error. The flag
+// metadata holds the kind (`feature` or `property`), type and format of the flag.
+func (provider *Provider) evaluate(flag string, flatCtx of.FlattenedContext) (interface{}, of.ProviderResolutionDetail, bool) {
+	snapshot := provider.snapshot.Load()
+	if snapshot == nil {
+		return nil, errorDetail(of.NewProviderNotReadyResolutionError("the configuration is not loaded")), false
+	}
+
+	entity := evaluator.Entity{Attributes: map[string]interface{}{}}
+	for key, value := range flatCtx {
+		if key == of.TargetingKey {
+			entity.ID, _ = value.(string)
+		} else {
+			entity.Attributes[key] = value
+		}
+	}
+
+	var result evaluator.Result
+	var err error
+	metadata := of.FlagMetadata{}
+	if feature, ok := snapshot.Feature(flag); ok {
+		metadata["kind"], metadata["type"], metadata["format"] = "feature", stringValue(feature.Type), stringValue(feature.Format)
+		result, err = snapshot.EvaluateFeature(flag, entity)
+	} else if property, ok := snapshot.Property(flag); ok {
+		metadata["kind"], metadata["type"], metadata["format"] = "property", stringValue(property.Type), stringValue(property.Format)
+		result, err = snapshot.EvaluateProperty(flag, entity)
+	} else {
+		return nil, errorDetail(of.NewFlagNotFoundResolutionError(fmt.Sprintf("flag '%s' is not in environment '%s'", flag, provider.options.EnvironmentID))), false
+	}
+	if errors.Is(err, evaluator.ErrEntityIDRequired) {
+		return nil, errorDetail(of.NewTargetingKeyMissingResolutionError(fmt.Sprintf("flag '%s' uses targeting and the evaluation context has no targeting key", flag))), false
+	}
+	if err != nil {
+		return nil, errorDetail(of.NewGeneralResolutionError(err.Error(), err)), false
+	}
+	return result.Value, of.ProviderResolutionDetail{Reason: of.Reason(result.Reason), Variant: result.Variant, FlagMetadata: metadata}, true
+}
+
+func typeMismatch(flag string, expected string, value interface{}) of.ProviderResolutionDetail {
+	return errorDetail(of.NewTypeMismatchResolutionError(fmt.Sprintf("flag '%s' is not a %s, its value is %T", flag, expected, value)))
+}
+
+func errorDetail(resolutionError of.ResolutionError) of.ProviderResolutionDetail {
+	return of.ProviderResolutionDetail{ResolutionError: resolutionError, Reason: of.ErrorReason}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch number := value.(type) {
+	case float64:
+		return number, true
+	case int64:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case json.Number:
+		parsed, err := number.Float64()
+		return parsed, err == nil
+	}
+	return 0, false
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,199 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openfeature
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `{
+	"environments": [{"name": "Dev", "environment_id": "dev",
+		"features": [
+			{"name": "dark-mode", "feature_id": "dark-mode", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true,
+			 "segment_rules": [{"rules": [{"segments": ["free"]}], "value": false, "order": 1, "rule_name": "free"}]},
+			{"name": "theme", "feature_id": "theme", "type": "STRING", "format": "TEXT", "enabled_value": "blue", "disabled_value": "grey", "enabled": false}
+		],
+		"properties": [
+			{"name": "retries", "property_id": "retries", "type": "NUMERIC", "value": 3},
+			{"name": "ratio", "property_id": "ratio", "type": "NUMERIC", "value": 0.25},
+			{"name": "limits", "property_id": "limits", "type": "STRING", "format": "JSON", "value": {"max": 10}},
+			{"name": "menu", "property_id": "menu", "type": "STRING", "format": "YAML", "value": "items:\n  - home\n  - about\n"}
+		]
+	}],
+	"segments": [
+		{"name": "free", "segment_id": "free", "rules": [{"attribute_name": "plan", "operator": "is", "values": ["free"]}]}
+	]
+}`
+
+func writeConfig(t *testing.T, path string, content string) {
+	require.Nil(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func newTestProvider(t *testing.T) *Provider {
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfig(t, path, testConfig)
+	provider, err := NewProvider(&Options{EnvironmentID: "dev", File: path})
+	require.Nil(t, err)
+	return provider
+}
+
+func TestProviderEvaluations(t *testing.T) {
+	provider := newTestProvider(t)
+	ctx := context.Background()
+	assert.Equal(t, ProviderName, provider.Metadata().Name)
+
+	boolean := provider.BooleanEvaluation(ctx, "dark-mode", false, of.FlattenedContext{of.TargetingKey: "u1", "plan": "pro"})
+	assert.True(t, boolean.Value)
+	assert.Equal(t, of.StaticReason, boolean.Reason)
+	assert.Equal(t, "feature", boolean.FlagMetadata["kind"])
+
+	boolean = provider.BooleanEvaluation(ctx, "dark-mode", true, of.FlattenedContext{of.TargetingKey: "u1", "plan": "free"})
+	assert.False(t, boolean.Value)
+	assert.Equal(t, of.TargetingMatchReason, boolean.Reason)
+	assert.Equal(t, "rule:free", boolean.Variant)
+
+	text := provider.StringEvaluation(ctx, "theme", "red", nil)
+	assert.Equal(t, "grey", text.Value)
+	assert.Equal(t, of.DisabledReason, text.Reason)
+
+	integer := provider.IntEvaluation(ctx, "retries", 0, nil)
+	assert.Equal(t, int64(3), integer.Value)
+	assert.Equal(t, "property", integer.FlagMetadata["kind"])
+
+	float := provider.FloatEvaluation(ctx, "ratio", 0, nil)
+	assert.Equal(t, 0.25, float.Value)
+
+	object := provider.ObjectEvaluation(ctx, "limits", nil, nil)
+	assert.Equal(t, map[string]interface{}{"max": float64(10)}, object.Value)
+	text = provider.StringEvaluation(ctx, "limits", "", nil)
+	assert.Equal(t, `{"max":10}`, text.Value)
+
+	object = provider.ObjectEvaluation(ctx, "menu", nil, nil)
+	assert.Equal(t, map[string]interface{}{"items": []interface{}{"home", "about"}}, object.Value)
+}
+
+func TestProviderErrors(t *testing.T) {
+	provider := newTestProvider(t)
+	ctx := context.Background()
+
+	boolean := provider.BooleanEvaluation(ctx, "missing", true, nil)
+	assert.True(t, boolean.Value)
+	assert.Equal(t, of.FlagNotFoundCode, boolean.ResolutionDetail().ErrorCode)
+	assert.Equal(t, of.ErrorReason, boolean.Reason)
+
+	boolean = provider.BooleanEvaluation(ctx, "dark-mode", true, of.FlattenedContext{"plan": "free"})
+	assert.True(t, boolean.Value)
+	assert.Equal(t, of.TargetingKeyMissingCode, boolean.ResolutionDetail().ErrorCode)
+
+	integer := provider.IntEvaluation(ctx, "ratio", 7, nil)
+	assert.Equal(t, int64(7), integer.Value)
+	assert.Equal(t, of.TypeMismatchCode, integer.ResolutionDetail().ErrorCode)
+
+	boolean = provider.BooleanEvaluation(ctx, "retries", false, nil)
+	assert.Equal(t, of.TypeMismatchCode, boolean.ResolutionDetail().ErrorCode)
+	assert.True(t, strings.HasPrefix(boolean.ResolutionError.Error(), "TYPE_MISMATCH: "))
+
+	_, err := NewProvider(&Options{EnvironmentID: "dev"})
+	assert.NotNil(t, err)
+	_, err = NewProvider(&Options{File: "config.json"})
+	assert.NotNil(t, err)
+	_, err = NewProvider(&Options{EnvironmentID: "dev", File: filepath.Join(t.TempDir(), "missing.json")})
+	assert.NotNil(t, err)
+}
+
+func TestProviderReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfig(t, path, testConfig)
+	reloadErrors := make(chan error, 10)
+	provider, err := NewProvider(&Options{
+		EnvironmentID:  "dev",
+		File:           path,
+		ReloadInterval: 10 * time.Millisecond,
+		OnReloadError: func(err error) {
+			select {
+			case reloadErrors <- err:
+			default:
+			}
+		},
+	})
+	require.Nil(t, err)
+	defer provider.Close()
+
+	ctx := context.Background()
+	assert.Equal(t, int64(3), provider.IntEvaluation(ctx, "retries", 0, nil).Value)
+
+	writeConfig(t, path, strings.Replace(testConfig, `"value": 3}`, `"value": 5}`, 1))
+	assert.Eventually(t, func() bool {
+		return provider.IntEvaluation(ctx, "retries", 0, nil).Value == 5
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// An invalid file is reported and the last good configuration is kept.
+	writeConfig(t, path, "not json")
+	select {
+	case err := <-reloadErrors:
+		assert.NotNil(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("the reload error was not reported")
+	}
+	assert.Equal(t, int64(5), provider.IntEvaluation(ctx, "retries", 0, nil).Value)
+
+	provider.Close()
+	provider.Close()
+}
+
+func TestProviderWithOpenFeatureClient(t *testing.T) {
+	provider := newTestProvider(t)
+	require.Nil(t, of.SetNamedProviderAndWait("appconfig-test", provider))
+	defer of.Shutdown()
+	client := of.NewClient("appconfig-test")
+	ctx := context.Background()
+	assert.Equal(t, ProviderName, of.NamedProviderMetadata("appconfig-test").Name)
+
+	free := of.NewEvaluationContext("u1", map[string]interface{}{"plan": "free"})
+	enabled, err := client.BooleanValue(ctx, "dark-mode", true, free)
+	require.Nil(t, err)
+	assert.False(t, enabled)
+
+	details, err := client.BooleanValueDetails(ctx, "dark-mode", true, free)
+	require.Nil(t, err)
+	assert.Equal(t, of.TargetingMatchReason, details.Reason)
+	assert.Equal(t, "rule:free", details.Variant)
+	kind, err := details.FlagMetadata.GetString("kind")
+	require.Nil(t, err)
+	assert.Equal(t, "feature", kind)
+
+	retries, err := client.IntValue(ctx, "retries", 0, of.EvaluationContext{})
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), retries)
+	menu, err := client.ObjectValue(ctx, "menu", nil, of.EvaluationContext{})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"items": []interface{}{"home", "about"}}, menu)
+
+	theme, err := client.StringValueDetails(ctx, "missing", "red", of.EvaluationContext{})
+	assert.NotNil(t, err)
+	assert.Equal(t, "red", theme.Value)
+	assert.Equal(t, of.FlagNotFoundCode, theme.ErrorCode)
+}
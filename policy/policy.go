@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package policy : Guardrails evaluated before the mutating calls of a client
+// A Guard attached to a client evaluates its policies against every mutating operation before the request is sent,
+// and fails the operation with a *ViolationError if a policy is violated. Policies are written in Go with New, or
+// declared as Rules, which can be loaded from a YAML or JSON file, for example:
+//
+//	rules:
+//	  - name: prod-business-hours
+//	    operations: [toggle_feature]
+//	    environment_tags: [prod]
+//	    allowed_hours: {days: [mon, tue, wed, thu, fri], from: "09:00", to: "17:00", time_zone: Europe/Berlin}
+//	  - name: small-rollout-steps
+//	    max_rollout_increase: 25
+//	  - name: segments-in-use
+//	    operations: [delete_segment]
+//	    max_segment_features: 3
+//	  - name: tagged-secrets
+//	    operations: [create_property]
+//	    when: {type: SECRETREF}
+//	    require_fields: [tags]
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Policy : A guardrail for mutating operations.
+type Policy interface {
+	// Name identifies the policy in violations.
+	Name() string
+
+	// Evaluate returns why operation violates the policy, or an empty string if it does not. An error means that the
+	// policy could not be evaluated, and blocks the operation too.
+	Evaluate(ctx context.Context, operation *appconfigurationv1.Operation) (violation string, err error)
+}
+
+// New returns a policy named name, evaluated by evaluate.
+func New(name string, evaluate func(ctx context.Context, operation *appconfigurationv1.Operation) (string, error)) Policy {
+	return &funcPolicy{name: name, evaluate: evaluate}
+}
+
+type funcPolicy struct {
+	name     string
+	evaluate func(ctx context.Context, operation *appconfigurationv1.Operation) (string, error)
+}
+
+func (policy *funcPolicy) Name() string {
+	return policy.name
+}
+
+func (policy *funcPolicy) Evaluate(ctx context.Context, operation *appconfigurationv1.Operation) (string, error) {
+	return policy.evaluate(ctx, operation)
+}
+
+// Violation : A policy violated by an operation, and why.
+type Violation struct {
+	Policy  string
+	Message string
+}
+
+// ViolationError : The error of an operation that violates policies. The request of the operation was not sent.
+type ViolationError struct {
+	// The operation, one of the appconfigurationv1.Operation_ID_* constants.
+	OperationID string
+
+	// The resource of the operation, one of the appconfigurationv1.ServiceError_ResourceType_* constants.
+	ResourceType string
+
+	Violations []Violation
+}
+
+// Error returns the violations, for example `toggle_feature violates policy 'prod-business-hours': ...`.
+func (err *ViolationError) Error() string {
+	violations := make([]string, len(err.Violations))
+	for i, violation := range err.Violations {
+		violations[i] = fmt.Sprintf("policy '%s': %s", violation.Policy, violation.Message)
+	}
+	return fmt.Sprintf("%s violates %s", err.OperationID, strings.Join(violations, "; "))
+}
+
+// Guard : Evaluates policies before the mutating operations of a client.
+type Guard struct {
+	policies []Policy
+}
+
+// NewGuard returns a guard evaluating policies, in order.
+func NewGuard(policies ...Policy) *Guard {
+	return &Guard{policies: policies}
+}
+
+// Attach evaluates the policies of the guard before each mutating operation of client from now on. Operations that
+// violate a policy fail with an error that errors.As finds as a *ViolationError, without sending their request.
+func (guard *Guard) Attach(client *appconfigurationv1.AppConfigurationV1) error {
+	return client.AddRequestHook(func(ctx context.Context, operation *appconfigurationv1.Operation) (func(*http.Response, error), error) {
+		return nil, guard.Check(ctx, operation)
+	})
+}
+
+// Check evaluates every policy against operation, and returns a *ViolationError listing the violated ones, or the
+// error of a policy that could not be evaluated.
+func (guard *Guard) Check(ctx context.Context, operation *appconfigurationv1.Operation) error {
+	var violations []Violation
+	for _, policy := range guard.policies {
+		message, err := policy.Evaluate(ctx, operation)
+		if err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("could not evaluate policy '%s'", policy.Name()), "policy-evaluation-error", common.GetComponentInfo())
+		}
+		if message != "" {
+			violations = append(violations, Violation{Policy: policy.Name(), Message: message})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ViolationError{OperationID: operation.ID, ResourceType: operation.ResourceType, Violations: violations}
+}
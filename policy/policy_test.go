@@ -0,0 +1,298 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRules = `
+rules:
+  - name: prod-business-hours
+    operations: [toggle_feature]
+    environment_tags: [prod]
+    allowed_hours: {days: [mon, tue, wed, thu, fri], from: "09:00", to: "17:00", time_zone: Europe/Berlin}
+  - name: small-rollout-steps
+    max_rollout_increase: 25
+  - name: segments-in-use
+    operations: [delete_segment]
+    max_segment_features: 3
+  - name: tagged-secrets
+    operations: [create_property]
+    when: {type: SECRETREF}
+    require_fields: [tags]
+    message: secret references must be tagged
+`
+
+// newTestClient returns a client of a fake instance with the environments dev and prod, the feature checkout in both,
+// and the segment beta used by 5 features. Like the service, the instance returns the rule IDs of the feature only
+// when they are included. The writes that reach the instance are recorded.
+func newTestClient(t *testing.T) (*appconfigurationv1.AppConfigurationV1, *[]string) {
+	writes := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch {
+		case req.Method != http.MethodGet:
+			writes = append(writes, req.Method+" "+req.URL.Path)
+			fmt.Fprint(res, `{}`)
+		case req.URL.Path == "/environments/prod":
+			fmt.Fprint(res, `{"name": "Prod", "environment_id": "prod", "tags": "eu, prod"}`)
+		case req.URL.Path == "/environments/dev":
+			fmt.Fprint(res, `{"name": "Dev", "environment_id": "dev"}`)
+		case strings.HasSuffix(req.URL.Path, "/features/checkout"):
+			betaRuleID, canaryRuleID := "", ""
+			if slices.Contains(strings.Split(req.URL.Query().Get("include"), ","), "rules") {
+				betaRuleID, canaryRuleID = `, "rule_id": "beta-rule"`, `, "rule_id": "canary-rule"`
+			}
+			fmt.Fprintf(res, `{"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false,
+				"rollout_percentage": 10, "segment_rules": [{"rules": [{"segments": ["beta"]}], "value": true, "order": 1%s},
+				{"rules": [{"segments": ["canary"]}], "value": true, "order": 2, "rollout_percentage": 5%s}]}`, betaRuleID, canaryRuleID)
+		case req.URL.Path == "/segments/beta":
+			assert.Equal(t, "features", req.URL.Query().Get("include"))
+			fmt.Fprint(res, `{"name": "Beta", "segment_id": "beta", "rules": [], "features": [{}, {}, {}, {}, {}]}`)
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return client, &writes
+}
+
+func newTestGuard(t *testing.T, client *appconfigurationv1.AppConfigurationV1) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	require.Nil(t, os.WriteFile(path, []byte(testRules), 0600))
+	rules, err := LoadFile(path)
+	require.Nil(t, err)
+	require.Nil(t, NewGuard(rules.Policies()...).Attach(client))
+}
+
+func setNow(t *testing.T, value time.Time) {
+	previous := now
+	now = func() time.Time { return value }
+	t.Cleanup(func() { now = previous })
+}
+
+func violations(t *testing.T, err error) []Violation {
+	var violationErr *ViolationError
+	require.True(t, errors.As(err, &violationErr), "%v is not a violation", err)
+	return violationErr.Violations
+}
+
+func TestTogglesInProdAreLimitedToBusinessHours(t *testing.T) {
+	client, writes := newTestClient(t)
+	newTestGuard(t, client)
+
+	// Saturday 10:00 in Berlin.
+	setNow(t, time.Date(2026, 3, 7, 9, 0, 0, 0, time.UTC))
+	_, _, err := client.ToggleFeature(client.NewToggleFeatureOptions("prod", "checkout", true))
+	assert.Equal(t, []Violation{{Policy: "prod-business-hours",
+		Message: "the operation is only allowed on mon, tue, wed, thu, fri from 09:00 to 17:00 Europe/Berlin, not at Sat 10:00"}}, violations(t, err))
+	assert.Contains(t, err.Error(), "toggle_feature violates policy 'prod-business-hours'")
+
+	_, _, err = client.ToggleFeature(client.NewToggleFeatureOptions("dev", "checkout", true))
+	assert.Nil(t, err)
+
+	// Monday 16:59 in Berlin.
+	setNow(t, time.Date(2026, 3, 9, 15, 59, 0, 0, time.UTC))
+	_, _, err = client.ToggleFeature(client.NewToggleFeatureOptions("prod", "checkout", true))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"PUT /environments/dev/features/checkout/toggle", "PUT /environments/prod/features/checkout/toggle"}, *writes)
+}
+
+func TestRolloutIncreasesAreLimited(t *testing.T) {
+	client, writes := newTestClient(t)
+	newTestGuard(t, client)
+
+	options := client.NewUpdateFeatureValuesOptions("dev", "checkout")
+	options.SetRolloutPercentage(50)
+	options.SetSegmentRules([]appconfigurationv1.FeatureSegmentRule{{RuleID: core.StringPtr("beta-rule"), RolloutPercentage: core.Int64Ptr(50)}})
+	_, _, err := client.UpdateFeatureValues(options)
+	assert.Equal(t, []Violation{{Policy: "small-rollout-steps",
+		Message: "the rollout percentage of feature 'checkout' would rise from 10 to 50, by more than 25 points"}}, violations(t, err))
+
+	options.SetRolloutPercentage(35)
+	_, _, err = client.UpdateFeatureValues(options)
+	assert.Nil(t, err)
+
+	// The rule is rolled out to 100% when it has no rollout percentage, so lowering it is fine.
+	ruleOptions := client.NewUpdateFeatureRuleOptions("dev", "checkout", "beta-rule")
+	ruleOptions.SetRolloutPercentage(20)
+	_, _, err = client.UpdateFeatureRule(ruleOptions)
+	assert.Nil(t, err)
+	assert.Len(t, *writes, 2)
+}
+
+func TestStoppedRolloutsAreLimited(t *testing.T) {
+	client, writes := newTestClient(t)
+	newTestGuard(t, client)
+
+	_, _, err := client.StopFeatureRollout(client.NewStopFeatureRolloutOptions("dev", "checkout", appconfigurationv1.StopFeatureRolloutOptions_Action_Stop, 100))
+	assert.Equal(t, []Violation{{Policy: "small-rollout-steps",
+		Message: "the rollout percentage of feature 'checkout' would rise from 10 to 100, by more than 25 points"}}, violations(t, err))
+
+	_, _, err = client.StopFeatureRuleRollout(client.NewStopFeatureRuleRolloutOptions("dev", "checkout", "canary-rule", appconfigurationv1.StopFeatureRuleRolloutOptions_Action_Stop, 100))
+	assert.Equal(t, []Violation{{Policy: "small-rollout-steps",
+		Message: "the rollout percentage of rule 'canary-rule' of feature 'checkout' would rise from 5 to 100, by more than 25 points"}}, violations(t, err))
+	assert.Empty(t, *writes)
+
+	_, _, err = client.StopFeatureRuleRollout(client.NewStopFeatureRuleRolloutOptions("dev", "checkout", "canary-rule", appconfigurationv1.StopFeatureRuleRolloutOptions_Action_Stop, 30))
+	assert.Nil(t, err)
+	assert.Len(t, *writes, 1)
+}
+
+func TestNewRulesAreLimitedByTheRolloutOfTheirFeature(t *testing.T) {
+	client, writes := newTestClient(t)
+	newTestGuard(t, client)
+
+	// A rule without a rollout percentage is rolled out to 100%.
+	options := client.NewCreateFeatureRuleOptions("dev", "checkout", []appconfigurationv1.TargetSegments{{Segments: []string{"beta"}}}, true, "staff-rule")
+	_, _, err := client.CreateFeatureRule(options)
+	assert.Equal(t, []Violation{{Policy: "small-rollout-steps",
+		Message: "the rollout percentage of the users of new rule 'staff-rule' of feature 'checkout' would rise from 10 to 100, by more than 25 points"}}, violations(t, err))
+	assert.Empty(t, *writes)
+
+	options.SetRolloutPercentage(30)
+	_, _, err = client.CreateFeatureRule(options)
+	assert.Nil(t, err)
+	assert.Len(t, *writes, 1)
+}
+
+func TestRolloutIncreasesOfRulesAreLimited(t *testing.T) {
+	client, writes := newTestClient(t)
+	newTestGuard(t, client)
+
+	ruleOptions := client.NewUpdateFeatureRuleOptions("dev", "checkout", "canary-rule")
+	ruleOptions.SetRolloutPercentage(50)
+	_, _, err := client.UpdateFeatureRule(ruleOptions)
+	assert.Equal(t, []Violation{{Policy: "small-rollout-steps",
+		Message: "the rollout percentage of rule 'canary-rule' of feature 'checkout' would rise from 5 to 50, by more than 25 points"}}, violations(t, err))
+
+	options := client.NewUpdateFeatureValuesOptions("dev", "checkout")
+	options.SetSegmentRules([]appconfigurationv1.FeatureSegmentRule{{RuleID: core.StringPtr("canary-rule"), RolloutPercentage: core.Int64Ptr(40)}})
+	_, _, err = client.UpdateFeatureValues(options)
+	assert.Equal(t, []Violation{{Policy: "small-rollout-steps",
+		Message: "the rollout percentage of rule 'canary-rule' of feature 'checkout' would rise from 5 to 40, by more than 25 points"}}, violations(t, err))
+	assert.Empty(t, *writes)
+}
+
+func TestSegmentsInUseCannotBeDeleted(t *testing.T) {
+	client, writes := newTestClient(t)
+	newTestGuard(t, client)
+
+	_, _, err := client.DeleteSegment(client.NewDeleteSegmentOptions("beta"))
+	assert.Equal(t, []Violation{{Policy: "segments-in-use", Message: "segment 'beta' is used by 5 features, more than 3"}}, violations(t, err))
+	assert.Empty(t, *writes)
+}
+
+func TestSecretReferencesMustBeTagged(t *testing.T) {
+	client, writes := newTestClient(t)
+	newTestGuard(t, client)
+
+	options := client.NewCreatePropertyOptions("dev", "Token", "token", appconfigurationv1.CreatePropertyOptions_Type_Secretref, map[string]interface{}{"id": "secret"})
+	_, _, err := client.CreateProperty(options)
+	assert.Equal(t, []Violation{{Policy: "tagged-secrets", Message: "secret references must be tagged"}}, violations(t, err))
+
+	options.SetTags("secrets")
+	_, _, err = client.CreateProperty(options)
+	assert.Nil(t, err)
+
+	_, _, err = client.CreateProperty(client.NewCreatePropertyOptions("dev", "Timeout", "timeout", appconfigurationv1.CreatePropertyOptions_Type_Numeric, 30))
+	assert.Nil(t, err)
+	assert.Len(t, *writes, 2)
+}
+
+func TestGoPoliciesBlockOperations(t *testing.T) {
+	client, writes := newTestClient(t)
+	noDeletes := New("no-deletes", func(ctx context.Context, operation *appconfigurationv1.Operation) (string, error) {
+		if strings.HasPrefix(operation.ID, "delete_") {
+			return fmt.Sprintf("%s '%s' cannot be deleted", operation.ResourceType, operation.PathParams["feature_id"]), nil
+		}
+		return "", nil
+	})
+	broken := New("broken", func(ctx context.Context, operation *appconfigurationv1.Operation) (string, error) {
+		if operation.ID == appconfigurationv1.Operation_ID_ToggleFeature {
+			return "", errors.New("policy service unavailable")
+		}
+		return "", nil
+	})
+	require.Nil(t, NewGuard(noDeletes, broken).Attach(client))
+
+	_, _, err := client.DeleteFeature(client.NewDeleteFeatureOptions("dev", "checkout"))
+	var violationErr *ViolationError
+	require.True(t, errors.As(err, &violationErr))
+	assert.Equal(t, appconfigurationv1.Operation_ID_DeleteFeature, violationErr.OperationID)
+	assert.Equal(t, appconfigurationv1.ServiceError_ResourceType_Feature, violationErr.ResourceType)
+	assert.Equal(t, []Violation{{Policy: "no-deletes", Message: "feature 'checkout' cannot be deleted"}}, violationErr.Violations)
+
+	// A policy that cannot be evaluated blocks the operation too.
+	_, _, err = client.ToggleFeature(client.NewToggleFeatureOptions("dev", "checkout", true))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "could not evaluate policy 'broken'")
+	assert.False(t, errors.As(err, &violationErr))
+	assert.Empty(t, *writes)
+}
+
+func TestLoadRejectsInvalidRules(t *testing.T) {
+	for rules, message := range map[string]string{
+		`rules: [{name: a, deny: true, colour: red}]`:                                                                "field colour not found",
+		`rules: [{name: a}]`:                                                                                         "the rule has no check",
+		`rules: [{name: a, deny: true}, {name: a, deny: true}]`:                                                      "rule 'a' is defined twice",
+		`rules: [{name: a, allowed_hours: {days: [someday], from: "09:00", to: "17:00"}}]`:                           "unknown day 'someday'",
+		`rules: [{name: a, allowed_hours: {from: "9am", to: "17:00"}}]`:                                              "invalid time of day '9am'",
+		`{"rules": [{"name": "a", "allowed_hours": {"from": "09:00", "to": "17:00", "time_zone": "Mars/Olympus"}}]}`: "unknown time zone",
+	} {
+		_, err := Load(strings.NewReader(rules))
+		require.NotNil(t, err, rules)
+		assert.Contains(t, err.Error(), message, rules)
+	}
+}
+
+func TestAllowedHoursCanSpanMidnight(t *testing.T) {
+	hours := &Hours{Days: []string{"fri"}, From: "22:00", To: "02:00"}
+	for at, allowed := range map[time.Time]bool{
+		time.Date(2026, 3, 6, 23, 0, 0, 0, time.UTC): true,
+		time.Date(2026, 3, 7, 1, 59, 0, 0, time.UTC): true,
+		time.Date(2026, 3, 7, 2, 0, 0, 0, time.UTC):  false,
+		time.Date(2026, 3, 6, 1, 0, 0, 0, time.UTC):  false,
+	} {
+		setNow(t, at)
+		failure, err := hours.check()
+		require.Nil(t, err)
+		assert.Equal(t, allowed, failure == "", at)
+	}
+}
@@ -0,0 +1,454 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// now is the clock of the allowed hours, replaced by tests.
+var now = time.Now
+
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// RuleSet : The rules of a rules file.
+type RuleSet struct {
+	Rules []*Rule `yaml:"rules" json:"rules"`
+}
+
+// Rule : A declarative policy. A rule applies to the operations that match all of Operations, EnvironmentTags and
+// When, and is violated when any of its checks fails. New(rule.Name, rule.Evaluate) makes a Policy of a rule.
+type Rule struct {
+	// The name of the rule, reported in violations.
+	Name string `yaml:"name" json:"name"`
+
+	// Replaces the messages of the failed checks in violations.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+
+	// The operations the rule applies to, appconfigurationv1.Operation_ID_* constants. All mutating operations if empty.
+	Operations []string `yaml:"operations,omitempty" json:"operations,omitempty"`
+
+	// Restricts the rule to the operations on an environment that has one of these tags.
+	EnvironmentTags []string `yaml:"environment_tags,omitempty" json:"environment_tags,omitempty"`
+
+	// Restricts the rule to the operations whose options have these values, by JSON name, for example `type: SECRETREF`.
+	When map[string]interface{} `yaml:"when,omitempty" json:"when,omitempty"`
+
+	// Fails every operation the rule applies to.
+	Deny bool `yaml:"deny,omitempty" json:"deny,omitempty"`
+
+	// Fails the operations outside of these hours.
+	AllowedHours *Hours `yaml:"allowed_hours,omitempty" json:"allowed_hours,omitempty"`
+
+	// Fails the updates of features and feature rules, the stopped rollouts, and the new feature rules that raise a
+	// rollout percentage by more points than this. A new rule is compared with the rollout percentage of its feature.
+	MaxRolloutIncrease *int64 `yaml:"max_rollout_increase,omitempty" json:"max_rollout_increase,omitempty"`
+
+	// Fails the deletion of segments used by more features than this.
+	MaxSegmentFeatures *int `yaml:"max_segment_features,omitempty" json:"max_segment_features,omitempty"`
+
+	// Fails the operations whose options leave one of these fields, by JSON name, empty.
+	RequireFields []string `yaml:"require_fields,omitempty" json:"require_fields,omitempty"`
+}
+
+// Hours : The hours of the week during which operations are allowed.
+type Hours struct {
+	// The days, among sun, mon, tue, wed, thu, fri and sat. Monday to Friday if empty.
+	Days []string `yaml:"days,omitempty" json:"days,omitempty"`
+
+	// The time of day the hours start, as HH:MM.
+	From string `yaml:"from" json:"from"`
+
+	// The time of day the hours end, as HH:MM, excluded. Before From for hours that span midnight.
+	To string `yaml:"to" json:"to"`
+
+	// The IANA time zone of the hours. UTC if empty.
+	TimeZone string `yaml:"time_zone,omitempty" json:"time_zone,omitempty"`
+}
+
+// LoadFile loads and validates the rules file at path.
+func LoadFile(path string) (*RuleSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "open-rules-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+
+	rules, err := Load(file)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("invalid rules file '%s'", path), "load-rules-error", common.GetComponentInfo())
+	}
+	return rules, nil
+}
+
+// Load reads and validates rules in YAML (or JSON) form.
+func Load(reader io.Reader) (*RuleSet, error) {
+	rules := &RuleSet{}
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(rules); err != nil && err != io.EOF {
+		return nil, core.SDKErrorf(err, "", "decode-rules-error", common.GetComponentInfo())
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Validate checks every rule of the set, and that their names are unique.
+func (rules *RuleSet) Validate() error {
+	names := map[string]bool{}
+	for i, rule := range rules.Rules {
+		if rule == nil {
+			return core.SDKErrorf(nil, fmt.Sprintf("rule %d is empty", i+1), "empty-rule-error", common.GetComponentInfo())
+		}
+		if names[rule.Name] {
+			return core.SDKErrorf(nil, fmt.Sprintf("rule '%s' is defined twice", rule.Name), "duplicate-rule-error", common.GetComponentInfo())
+		}
+		names[rule.Name] = true
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Policies returns the rules of the set as policies, for NewGuard.
+func (rules *RuleSet) Policies() []Policy {
+	policies := make([]Policy, len(rules.Rules))
+	for i, rule := range rules.Rules {
+		policies[i] = New(rule.Name, rule.Evaluate)
+	}
+	return policies
+}
+
+// Validate checks that the rule is named and has at least one valid check.
+func (rule *Rule) Validate() error {
+	problem := ""
+	switch {
+	case rule.Name == "":
+		problem = "a name is required"
+	case !rule.Deny && rule.AllowedHours == nil && rule.MaxRolloutIncrease == nil && rule.MaxSegmentFeatures == nil && len(rule.RequireFields) == 0:
+		problem = "the rule has no check"
+	case rule.AllowedHours != nil:
+		if _, _, _, err := rule.AllowedHours.parse(); err != nil {
+			problem = err.Error()
+		}
+	}
+	if problem == "" {
+		return nil
+	}
+	return core.SDKErrorf(nil, fmt.Sprintf("invalid rule '%s': %s", rule.Name, problem), "invalid-rule-error", common.GetComponentInfo())
+}
+
+// Evaluate runs the checks of the rule if it applies to operation.
+func (rule *Rule) Evaluate(ctx context.Context, operation *appconfigurationv1.Operation) (string, error) {
+	if len(rule.Operations) > 0 && !slices.Contains(rule.Operations, operation.ID) {
+		return "", nil
+	}
+	options, err := optionFields(operation)
+	if err != nil {
+		return "", err
+	}
+	for field, value := range rule.When {
+		if options == nil || !sameJSON(options[field], value) {
+			return "", nil
+		}
+	}
+	if len(rule.EnvironmentTags) > 0 {
+		tagged, err := hasEnvironmentTag(ctx, operation, rule.EnvironmentTags)
+		if !tagged || err != nil {
+			return "", err
+		}
+	}
+
+	var failures []string
+	if rule.Deny {
+		failures = append(failures, "the operation is denied")
+	}
+	if rule.AllowedHours != nil {
+		failure, err := rule.AllowedHours.check()
+		if err != nil {
+			return "", err
+		}
+		if failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+	if rule.MaxRolloutIncrease != nil {
+		failure, err := checkRolloutIncrease(ctx, operation, options, *rule.MaxRolloutIncrease)
+		if err != nil {
+			return "", err
+		}
+		if failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+	if rule.MaxSegmentFeatures != nil {
+		failure, err := checkSegmentFeatures(ctx, operation, *rule.MaxSegmentFeatures)
+		if err != nil {
+			return "", err
+		}
+		if failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+	for _, field := range rule.RequireFields {
+		if isEmpty(options[field]) {
+			failures = append(failures, fmt.Sprintf("the field '%s' is required", field))
+		}
+	}
+
+	if len(failures) == 0 {
+		return "", nil
+	}
+	if rule.Message != "" {
+		return rule.Message, nil
+	}
+	return strings.Join(failures, ", and "), nil
+}
+
+// parse returns the location, days and minutes of the day of the hours, or why they are invalid.
+func (hours *Hours) parse() (location *time.Location, days []time.Weekday, minutes [2]int, err error) {
+	location, err = time.LoadLocation(hours.TimeZone)
+	if err != nil {
+		return nil, nil, minutes, err
+	}
+	names := hours.Days
+	if len(names) == 0 {
+		names = weekdays[1:6]
+	}
+	for _, name := range names {
+		day := slices.Index(weekdays, strings.ToLower(name))
+		if day < 0 {
+			return nil, nil, minutes, fmt.Errorf("unknown day '%s'", name)
+		}
+		days = append(days, time.Weekday(day))
+	}
+	for i, clock := range []string{hours.From, hours.To} {
+		parsed, parseErr := time.Parse("15:04", clock)
+		if parseErr != nil {
+			return nil, nil, minutes, fmt.Errorf("invalid time of day '%s'", clock)
+		}
+		minutes[i] = parsed.Hour()*60 + parsed.Minute()
+	}
+	return location, days, minutes, nil
+}
+
+// check returns why the current time is outside of the hours.
+func (hours *Hours) check() (string, error) {
+	location, days, minutes, err := hours.parse()
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "invalid-hours-error", common.GetComponentInfo())
+	}
+	current := now().In(location)
+	day, minute := current.Weekday(), current.Hour()*60+current.Minute()
+	if minutes[1] <= minutes[0] && minute < minutes[1] {
+		// Hours spanning midnight belong to the day they start.
+		day = (day + 6) % 7
+		minute += 24 * 60
+	}
+	end := minutes[1]
+	if end <= minutes[0] {
+		end += 24 * 60
+	}
+	if slices.Contains(days, day) && minute >= minutes[0] && minute < end {
+		return "", nil
+	}
+	names := hours.Days
+	if len(names) == 0 {
+		names = weekdays[1:6]
+	}
+	return fmt.Sprintf("the operation is only allowed on %s from %s to %s %s, not at %s", strings.Join(names, ", "), hours.From, hours.To,
+		location, current.Format("Mon 15:04")), nil
+}
+
+// optionFields returns the options of operation by JSON name, or nil if they were not decoded.
+func optionFields(operation *appconfigurationv1.Operation) (map[string]interface{}, error) {
+	if operation.Options == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(operation.Options)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "encode-options-error", common.GetComponentInfo())
+	}
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, core.SDKErrorf(err, "", "encode-options-error", common.GetComponentInfo())
+	}
+	return fields, nil
+}
+
+// sameJSON reports whether actual, decoded from JSON, and expected, decoded from the rules file, are the same value.
+func sameJSON(actual interface{}, expected interface{}) bool {
+	data, err := json.Marshal(expected)
+	if err != nil {
+		return false
+	}
+	var normalized interface{}
+	if err = json.Unmarshal(data, &normalized); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(actual, normalized)
+}
+
+func isEmpty(value interface{}) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(value) == ""
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// hasEnvironmentTag reports whether the environment of operation has one of tags. Operations outside of environments
+// have none.
+func hasEnvironmentTag(ctx context.Context, operation *appconfigurationv1.Operation, tags []string) (bool, error) {
+	environmentID := operation.PathParams["environment_id"]
+	if environmentID == "" {
+		return false, nil
+	}
+	environment, _, err := operation.Client.GetEnvironmentWithContext(ctx, operation.Client.NewGetEnvironmentOptions(environmentID))
+	if err != nil {
+		return false, core.SDKErrorf(err, fmt.Sprintf("could not read the tags of environment '%s'", environmentID), "read-environment-error", common.GetComponentInfo())
+	}
+	if environment.Tags == nil {
+		return false, nil
+	}
+	for _, tag := range strings.Split(*environment.Tags, ",") {
+		if slices.Contains(tags, strings.TrimSpace(tag)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkRolloutIncrease compares the rollout percentages of the options of a feature or feature rule update, or of a
+// stopped rollout, with the current ones. A new feature rule is compared with the rollout percentage of its feature,
+// which applied to its users before. A missing rollout percentage is 100.
+func checkRolloutIncrease(ctx context.Context, operation *appconfigurationv1.Operation, options map[string]interface{}, limit int64) (string, error) {
+	switch operation.ID {
+	case appconfigurationv1.Operation_ID_UpdateFeature, appconfigurationv1.Operation_ID_UpdateFeatureValues, appconfigurationv1.Operation_ID_UpdateFeatureRule,
+		appconfigurationv1.Operation_ID_StopFeatureRollout, appconfigurationv1.Operation_ID_StopFeatureRuleRollout, appconfigurationv1.Operation_ID_CreateFeatureRule:
+	default:
+		return "", nil
+	}
+	environmentID, featureID := operation.PathParams["environment_id"], operation.PathParams["feature_id"]
+	feature, _, err := operation.Client.GetFeatureWithContext(ctx, &appconfigurationv1.GetFeatureOptions{
+		EnvironmentID: &environmentID,
+		FeatureID:     &featureID,
+		Include:       []string{appconfigurationv1.GetFeatureOptions_Include_Collections, appconfigurationv1.GetFeatureOptions_Include_Rules},
+	})
+	if err != nil {
+		return "", core.SDKErrorf(err, fmt.Sprintf("could not read feature '%s'", featureID), "read-feature-error", common.GetComponentInfo())
+	}
+	current := map[string]int64{"": percentage(feature.RolloutPercentage)}
+	for _, rule := range feature.SegmentRules {
+		if rule.RuleID != nil {
+			current[*rule.RuleID] = percentage(rule.RolloutPercentage)
+		}
+	}
+
+	// The target rollout percentages by rule ID, the empty one being the feature's own.
+	target := map[string]interface{}{}
+	newRuleID := ""
+	switch operation.ID {
+	case appconfigurationv1.Operation_ID_UpdateFeatureRule, appconfigurationv1.Operation_ID_StopFeatureRuleRollout:
+		target[operation.PathParams["rule_id"]] = options["rollout_percentage"]
+	case appconfigurationv1.Operation_ID_StopFeatureRollout:
+		target[""] = options["rollout_percentage"]
+	case appconfigurationv1.Operation_ID_CreateFeatureRule:
+		newRuleID, _ = options["rule_id"].(string)
+		target[newRuleID] = options["rollout_percentage"]
+		if target[newRuleID] == nil {
+			target[newRuleID] = float64(100)
+		}
+		if _, exists := current[newRuleID]; !exists {
+			current[newRuleID] = current[""]
+		}
+	default:
+		target[""] = options["rollout_percentage"]
+		rules, _ := options["segment_rules"].([]interface{})
+		for _, rule := range rules {
+			rule, _ := rule.(map[string]interface{})
+			if ruleID, ok := rule["rule_id"].(string); ok {
+				target[ruleID] = rule["rollout_percentage"]
+			}
+		}
+	}
+
+	var failures []string
+	for _, ruleID := range slices.Sorted(maps.Keys(target)) {
+		value, ok := target[ruleID].(float64)
+		before, known := current[ruleID]
+		if !ok || !known || int64(value)-before <= limit {
+			continue
+		}
+		subject := fmt.Sprintf("feature '%s'", featureID)
+		if ruleID == newRuleID && operation.ID == appconfigurationv1.Operation_ID_CreateFeatureRule {
+			subject = fmt.Sprintf("the users of new rule '%s' of feature '%s'", ruleID, featureID)
+		} else if ruleID != "" {
+			subject = fmt.Sprintf("rule '%s' of feature '%s'", ruleID, featureID)
+		}
+		failures = append(failures, fmt.Sprintf("the rollout percentage of %s would rise from %d to %d, by more than %d points", subject, before, int64(value), limit))
+	}
+	return strings.Join(failures, ", and "), nil
+}
+
+func percentage(value *int64) int64 {
+	if value == nil {
+		return 100
+	}
+	return *value
+}
+
+// checkSegmentFeatures counts the features that use the segment deleted by operation.
+func checkSegmentFeatures(ctx context.Context, operation *appconfigurationv1.Operation, limit int) (string, error) {
+	if operation.ID != appconfigurationv1.Operation_ID_DeleteSegment {
+		return "", nil
+	}
+	segmentID := operation.PathParams["segment_id"]
+	options := operation.Client.NewGetSegmentOptions(segmentID)
+	options.SetInclude([]string{appconfigurationv1.GetSegmentOptions_Include_Features})
+	segment, _, err := operation.Client.GetSegmentWithContext(ctx, options)
+	if err != nil {
+		return "", core.SDKErrorf(err, fmt.Sprintf("could not read segment '%s'", segmentID), "read-segment-error", common.GetComponentInfo())
+	}
+	if len(segment.Features) <= limit {
+		return "", nil
+	}
+	return fmt.Sprintf("segment '%s' is used by %d features, more than %d", segmentID, len(segment.Features), limit), nil
+}
@@ -0,0 +1,381 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package profiles : Named connection profiles for App Configuration instances
+// A profiles file maps profile names to the region, instance GUID and authentication settings of an App Configuration
+// instance, for example:
+//
+//	default_profile: dr
+//	profiles:
+//	  primary:
+//	    region: us-south
+//	    guid: 36401ffc-6280-459a-ba98-456aba10d0c7
+//	    authenticator:
+//	      type: iam
+//	      credentials:
+//	        env: PRIMARY_APIKEY
+//	  dr:
+//	    region: eu-de
+//	    guid: 9f3b1c52-0d4e-4a8b-9a55-1f6a2e0c7d11
+//	    endpoint_type: private
+//	    authenticator:
+//	      type: iam
+//	      url: https://private.iam.cloud.ibm.com
+//	      credentials:
+//	        file: ~/.appconfig/dr.apikey
+//
+// Use a Registry to build, and cache, one client per profile.
+package profiles
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProfilesFileEnvVar is the environment variable that overrides the location of the profiles file.
+const DefaultProfilesFileEnvVar = "APPCONFIG_PROFILES_FILE"
+
+// DefaultProfileName is the profile used when a profiles file does not set default_profile.
+const DefaultProfileName = "default"
+
+// Constants associated with the AuthenticatorConfig.Type property.
+// The authentication scheme used to access the instance. `external` loads the authenticator from the external
+// configuration (environment variables or credentials file) of the service named by AuthenticatorConfig.ServiceName.
+const (
+	AuthTypeIam         = "iam"
+	AuthTypeBearerToken = "bearerToken"
+	AuthTypeContainer   = "container"
+	AuthTypeVpc         = "vpc"
+	AuthTypeNoAuth      = "noAuth"
+	AuthTypeExternal    = "external"
+)
+
+// Constants associated with the Profile.EndpointType property.
+const (
+	EndpointTypePublic  = "public"
+	EndpointTypePrivate = "private"
+)
+
+// Config : The contents of a profiles file.
+type Config struct {
+	// The profile returned by Registry.Default. Defaults to DefaultProfileName.
+	DefaultProfile string `yaml:"default_profile,omitempty" json:"default_profile,omitempty"`
+
+	// The profiles, by name.
+	Profiles map[string]*Profile `yaml:"profiles" json:"profiles"`
+}
+
+// Profile : The location of an App Configuration instance and how to authenticate to it.
+type Profile struct {
+	// Region of the instance, for example `us-south`.
+	Region string `yaml:"region,omitempty" json:"region,omitempty"`
+
+	// GUID of the instance.
+	GUID string `yaml:"guid,omitempty" json:"guid,omitempty"`
+
+	// `public` (the default) or `private`.
+	EndpointType string `yaml:"endpoint_type,omitempty" json:"endpoint_type,omitempty"`
+
+	// The full service URL of the instance. When set, Region, GUID and EndpointType are ignored.
+	URL string `yaml:"url,omitempty" json:"url,omitempty"`
+
+	// How to authenticate to the instance.
+	Authenticator AuthenticatorConfig `yaml:"authenticator" json:"authenticator"`
+}
+
+// AuthenticatorConfig : The authentication settings of a profile.
+type AuthenticatorConfig struct {
+	// The authentication scheme, one of the AuthType* constants.
+	Type string `yaml:"type" json:"type"`
+
+	// The URL of the token service, for example `https://private.iam.cloud.ibm.com`.
+	URL string `yaml:"url,omitempty" json:"url,omitempty"`
+
+	// Where to read the API key (iam) or bearer token (bearerToken) from.
+	Credentials CredentialsSource `yaml:"credentials,omitempty" json:"credentials,omitempty"`
+
+	// The trusted profile name, for the container authenticator.
+	IAMProfileName string `yaml:"iam_profile_name,omitempty" json:"iam_profile_name,omitempty"`
+
+	// The trusted profile ID, for the container and vpc authenticators.
+	IAMProfileID string `yaml:"iam_profile_id,omitempty" json:"iam_profile_id,omitempty"`
+
+	// The trusted profile CRN, for the vpc authenticator.
+	IAMProfileCRN string `yaml:"iam_profile_crn,omitempty" json:"iam_profile_crn,omitempty"`
+
+	// The compute resource token file, for the container authenticator.
+	CRTokenFilename string `yaml:"cr_token_filename,omitempty" json:"cr_token_filename,omitempty"`
+
+	// The service name whose external configuration is used by the `external` authenticator. Defaults to
+	// appconfigurationv1.DefaultServiceName.
+	ServiceName string `yaml:"service_name,omitempty" json:"service_name,omitempty"`
+}
+
+// CredentialsSource : Where a secret credential is read from. Exactly one field must be set.
+type CredentialsSource struct {
+	// Name of the environment variable holding the credential.
+	Env string `yaml:"env,omitempty" json:"env,omitempty"`
+
+	// Path of a file holding the credential. A leading `~/` is expanded to the user's home directory.
+	File string `yaml:"file,omitempty" json:"file,omitempty"`
+
+	// The credential itself. Prefer Env or File so that secrets are not stored in the profiles file.
+	Value string `yaml:"value,omitempty" json:"value,omitempty"`
+}
+
+// DefaultPath returns the location of the profiles file: the value of the APPCONFIG_PROFILES_FILE environment
+// variable if set, otherwise `~/.appconfig/profiles.yaml`.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(DefaultProfilesFileEnvVar); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "home-dir-error", common.GetComponentInfo())
+	}
+	return filepath.Join(home, ".appconfig", "profiles.yaml"), nil
+}
+
+// LoadDefault loads the profiles file at DefaultPath.
+func LoadDefault() (*Config, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return LoadFile(path)
+}
+
+// LoadFile loads and validates the profiles file at path.
+func LoadFile(path string) (*Config, error) {
+	file, err := os.Open(expandHome(path))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "open-profiles-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+
+	config, err := Load(file)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("invalid profiles file '%s'", path), "load-profiles-error", common.GetComponentInfo())
+	}
+	return config, nil
+}
+
+// Load reads and validates profiles in YAML (or JSON) form.
+func Load(reader io.Reader) (*Config, error) {
+	config := &Config{}
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && err != io.EOF {
+		return nil, core.SDKErrorf(err, "", "decode-profiles-error", common.GetComponentInfo())
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate checks every profile of the configuration.
+func (config *Config) Validate() error {
+	if config.DefaultProfile != "" {
+		if _, ok := config.Profiles[config.DefaultProfile]; !ok {
+			return core.SDKErrorf(nil, fmt.Sprintf("default profile '%s' is not defined", config.DefaultProfile), "unknown-default-profile", common.GetComponentInfo())
+		}
+	}
+	for _, name := range config.Names() {
+		profile := config.Profiles[name]
+		if profile == nil {
+			return core.SDKErrorf(nil, fmt.Sprintf("profile '%s' is empty", name), "empty-profile", common.GetComponentInfo())
+		}
+		if err := profile.Validate(); err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("profile '%s' is invalid", name), "invalid-profile", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// Names returns the sorted names of the profiles of the configuration.
+func (config *Config) Names() []string {
+	names := make([]string, 0, len(config.Profiles))
+	for name := range config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that the profile identifies an instance and has usable authentication settings. Credentials are
+// not read until a client is built.
+func (profile *Profile) Validate() error {
+	if profile.URL == "" {
+		if _, err := profile.ServiceURL(); err != nil {
+			return err
+		}
+	}
+
+	auth := profile.Authenticator
+	switch strings.ToLower(auth.Type) {
+	case strings.ToLower(AuthTypeIam), strings.ToLower(AuthTypeBearerToken):
+		return auth.Credentials.validate()
+	case strings.ToLower(AuthTypeContainer):
+		if auth.IAMProfileName == "" && auth.IAMProfileID == "" {
+			return core.SDKErrorf(nil, "the container authenticator requires iam_profile_name or iam_profile_id", "missing-trusted-profile", common.GetComponentInfo())
+		}
+	case strings.ToLower(AuthTypeVpc), strings.ToLower(AuthTypeNoAuth), AuthTypeExternal:
+	case "":
+		return core.SDKErrorf(nil, "authenticator type is required", "missing-auth-type", common.GetComponentInfo())
+	default:
+		return core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a supported authenticator type", auth.Type), "unknown-auth-type", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ServiceURL returns the service URL of the instance described by the profile.
+func (profile *Profile) ServiceURL() (string, error) {
+	if profile.URL != "" {
+		return profile.URL, nil
+	}
+	if profile.Region == "" || profile.GUID == "" {
+		return "", core.SDKErrorf(nil, "either url, or both region and guid, must be set", "missing-instance", common.GetComponentInfo())
+	}
+
+	region := profile.Region
+	switch strings.ToLower(profile.EndpointType) {
+	case "", EndpointTypePublic:
+	case EndpointTypePrivate:
+		if !strings.HasPrefix(region, appconfigurationv1.PrivateRegionPrefix) {
+			region = appconfigurationv1.PrivateRegionPrefix + region
+		}
+	default:
+		return "", core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a valid endpoint type", profile.EndpointType), "invalid-endpoint-type", common.GetComponentInfo())
+	}
+	return appconfigurationv1.GetServiceURLForInstance(region, profile.GUID)
+}
+
+// NewAuthenticator builds the authenticator described by the profile, reading its credentials.
+func (profile *Profile) NewAuthenticator() (core.Authenticator, error) {
+	auth := profile.Authenticator
+	switch strings.ToLower(auth.Type) {
+	case strings.ToLower(AuthTypeIam):
+		apiKey, err := auth.Credentials.read()
+		if err != nil {
+			return nil, err
+		}
+		return core.NewIamAuthenticatorBuilder().SetApiKey(apiKey).SetURL(auth.URL).Build()
+	case strings.ToLower(AuthTypeBearerToken):
+		token, err := auth.Credentials.read()
+		if err != nil {
+			return nil, err
+		}
+		return core.NewBearerTokenAuthenticator(token)
+	case strings.ToLower(AuthTypeContainer):
+		return core.NewContainerAuthenticatorBuilder().
+			SetIAMProfileName(auth.IAMProfileName).
+			SetIAMProfileID(auth.IAMProfileID).
+			SetCRTokenFilename(expandHome(auth.CRTokenFilename)).
+			SetURL(auth.URL).
+			Build()
+	case strings.ToLower(AuthTypeVpc):
+		return core.NewVpcInstanceAuthenticatorBuilder().
+			SetIAMProfileCRN(auth.IAMProfileCRN).
+			SetIAMProfileID(auth.IAMProfileID).
+			SetURL(auth.URL).
+			Build()
+	case strings.ToLower(AuthTypeNoAuth):
+		return core.NewNoAuthAuthenticator()
+	case AuthTypeExternal:
+		serviceName := auth.ServiceName
+		if serviceName == "" {
+			serviceName = appconfigurationv1.DefaultServiceName
+		}
+		return core.GetAuthenticatorFromEnvironment(serviceName)
+	}
+	return nil, core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a supported authenticator type", auth.Type), "unknown-auth-type", common.GetComponentInfo())
+}
+
+// NewClient builds a new App Configuration client for the profile.
+func (profile *Profile) NewClient() (*appconfigurationv1.AppConfigurationV1, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+	serviceURL, err := profile.ServiceURL()
+	if err != nil {
+		return nil, err
+	}
+	authenticator, err := profile.NewAuthenticator()
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "authenticator-error", common.GetComponentInfo())
+	}
+	return appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           serviceURL,
+		Authenticator: authenticator,
+	})
+}
+
+func (source CredentialsSource) validate() error {
+	set := 0
+	for _, field := range []string{source.Env, source.File, source.Value} {
+		if field != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return core.SDKErrorf(nil, "exactly one of credentials.env, credentials.file or credentials.value must be set", "invalid-credentials-source", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (source CredentialsSource) read() (string, error) {
+	if err := source.validate(); err != nil {
+		return "", err
+	}
+	switch {
+	case source.Env != "":
+		value := os.Getenv(source.Env)
+		if value == "" {
+			return "", core.SDKErrorf(nil, fmt.Sprintf("environment variable '%s' is not set", source.Env), "missing-credentials-env", common.GetComponentInfo())
+		}
+		return value, nil
+	case source.File != "":
+		content, err := os.ReadFile(expandHome(source.File))
+		if err != nil {
+			return "", core.SDKErrorf(err, "", "read-credentials-file-error", common.GetComponentInfo())
+		}
+		value := strings.TrimSpace(string(content))
+		if value == "" {
+			return "", core.SDKErrorf(nil, fmt.Sprintf("credentials file '%s' is empty", source.File), "empty-credentials-file", common.GetComponentInfo())
+		}
+		return value, nil
+	}
+	return source.Value, nil
+}
+
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package profiles
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProfiles = `
+default_profile: dr
+profiles:
+  primary:
+    region: us-south
+    guid: 36401ffc-6280-459a-ba98-456aba10d0c7
+    authenticator:
+      type: iam
+      credentials:
+        env: TEST_PRIMARY_APIKEY
+  dr:
+    region: eu-de
+    guid: 9f3b1c52-0d4e-4a8b-9a55-1f6a2e0c7d11
+    endpoint_type: private
+    authenticator:
+      type: bearerToken
+      credentials:
+        value: token
+`
+
+func TestLoad(t *testing.T) {
+	config, err := Load(strings.NewReader(testProfiles))
+	require.Nil(t, err)
+	assert.Equal(t, "dr", config.DefaultProfile)
+	assert.Equal(t, []string{"dr", "primary"}, config.Names())
+
+	url, err := config.Profiles["primary"].ServiceURL()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://us-south.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7", url)
+
+	url, err = config.Profiles["dr"].ServiceURL()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://private.eu-de.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/9f3b1c52-0d4e-4a8b-9a55-1f6a2e0c7d11", url)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	invalid := map[string]string{
+		"unknown field":   "profiles:\n  a:\n    regoin: us-south\n",
+		"unknown default": "default_profile: b\nprofiles:\n  a:\n    url: https://example.com\n    authenticator:\n      type: noAuth\n",
+		"missing guid":    "profiles:\n  a:\n    region: us-south\n    authenticator:\n      type: noAuth\n",
+		"bad region":      "profiles:\n  a:\n    region: mars\n    guid: 36401ffc-6280-459a-ba98-456aba10d0c7\n    authenticator:\n      type: noAuth\n",
+		"bad auth type":   "profiles:\n  a:\n    url: https://example.com\n    authenticator:\n      type: basic\n",
+		"no credentials":  "profiles:\n  a:\n    url: https://example.com\n    authenticator:\n      type: iam\n",
+		"two credentials": "profiles:\n  a:\n    url: https://example.com\n    authenticator:\n      type: iam\n      credentials:\n        env: A\n        value: b\n",
+		"bad endpoint":    "profiles:\n  a:\n    region: us-south\n    guid: 36401ffc-6280-459a-ba98-456aba10d0c7\n    endpoint_type: direct\n    authenticator:\n      type: noAuth\n",
+	}
+	for name, content := range invalid {
+		_, err := Load(strings.NewReader(content))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	require.Nil(t, os.WriteFile(path, []byte(testProfiles), 0600))
+
+	config, err := LoadFile(path)
+	require.Nil(t, err)
+	assert.Len(t, config.Profiles, 2)
+
+	_, err = LoadFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+}
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv(DefaultProfilesFileEnvVar, "/tmp/profiles.yaml")
+	path, err := DefaultPath()
+	assert.Nil(t, err)
+	assert.Equal(t, "/tmp/profiles.yaml", path)
+
+	t.Setenv(DefaultProfilesFileEnvVar, "")
+	path, err = DefaultPath()
+	assert.Nil(t, err)
+	assert.True(t, strings.HasSuffix(path, filepath.Join(".appconfig", "profiles.yaml")))
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Setenv("TEST_PRIMARY_APIKEY", "my-apikey")
+	keyFile := filepath.Join(t.TempDir(), "token")
+	require.Nil(t, os.WriteFile(keyFile, []byte("file-token\n"), 0600))
+
+	profile := &Profile{Authenticator: AuthenticatorConfig{Type: AuthTypeIam, Credentials: CredentialsSource{Env: "TEST_PRIMARY_APIKEY"}}}
+	authenticator, err := profile.NewAuthenticator()
+	require.Nil(t, err)
+	assert.Equal(t, "my-apikey", authenticator.(*core.IamAuthenticator).ApiKey)
+
+	profile = &Profile{Authenticator: AuthenticatorConfig{Type: AuthTypeBearerToken, Credentials: CredentialsSource{File: keyFile}}}
+	authenticator, err = profile.NewAuthenticator()
+	require.Nil(t, err)
+	assert.Equal(t, "file-token", authenticator.(*core.BearerTokenAuthenticator).BearerToken)
+
+	profile = &Profile{Authenticator: AuthenticatorConfig{Type: AuthTypeContainer, IAMProfileName: "my-profile"}}
+	authenticator, err = profile.NewAuthenticator()
+	require.Nil(t, err)
+	assert.Equal(t, core.AUTHTYPE_CONTAINER, authenticator.AuthenticationType())
+
+	profile = &Profile{Authenticator: AuthenticatorConfig{Type: AuthTypeIam, Credentials: CredentialsSource{Env: "TEST_UNSET_APIKEY"}}}
+	_, err = profile.NewAuthenticator()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package profiles
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Registry : Lazily builds and caches one client per profile.
+// A Registry is safe for concurrent use. A client is built the first time its profile is requested; if building
+// fails, the error is returned and the next request tries again.
+type Registry struct {
+	mutex          sync.Mutex
+	defaultProfile string
+	profiles       map[string]*Profile
+	entries        map[string]*registryEntry
+}
+
+type registryEntry struct {
+	mutex  sync.Mutex
+	client *appconfigurationv1.AppConfigurationV1
+}
+
+// NewRegistry returns a registry serving the profiles of config, which may be nil.
+func NewRegistry(config *Config) *Registry {
+	registry := &Registry{
+		defaultProfile: DefaultProfileName,
+		profiles:       map[string]*Profile{},
+		entries:        map[string]*registryEntry{},
+	}
+	if config != nil {
+		if config.DefaultProfile != "" {
+			registry.defaultProfile = config.DefaultProfile
+		}
+		for name, profile := range config.Profiles {
+			registry.profiles[name] = profile
+		}
+	}
+	return registry
+}
+
+// NewRegistryFromFile loads the profiles file at path and returns a registry serving its profiles.
+func NewRegistryFromFile(path string) (*Registry, error) {
+	config, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewRegistry(config), nil
+}
+
+// Register adds a profile, replacing any profile with the same name and dropping its cached client.
+func (registry *Registry) Register(name string, profile *Profile) error {
+	if profile == nil {
+		return core.SDKErrorf(nil, fmt.Sprintf("profile '%s' is empty", name), "empty-profile", common.GetComponentInfo())
+	}
+	if err := profile.Validate(); err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("profile '%s' is invalid", name), "invalid-profile", common.GetComponentInfo())
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.profiles[name] = profile
+	delete(registry.entries, name)
+	return nil
+}
+
+// Invalidate drops the cached client of a profile, so that the next request builds a new one. This is useful after
+// rotating the credentials of the profile.
+func (registry *Registry) Invalidate(name string) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	delete(registry.entries, name)
+}
+
+// Names returns the sorted names of the registered profiles.
+func (registry *Registry) Names() []string {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	config := &Config{Profiles: registry.profiles}
+	return config.Names()
+}
+
+// Client returns the client of the named profile, building it on first use.
+func (registry *Registry) Client(name string) (*appconfigurationv1.AppConfigurationV1, error) {
+	registry.mutex.Lock()
+	profile, ok := registry.profiles[name]
+	if !ok {
+		registry.mutex.Unlock()
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("profile '%s' is not defined", name), "unknown-profile", common.GetComponentInfo())
+	}
+	entry, ok := registry.entries[name]
+	if !ok {
+		entry = &registryEntry{}
+		registry.entries[name] = entry
+	}
+	registry.mutex.Unlock()
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+	if entry.client == nil {
+		client, err := profile.NewClient()
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("unable to build a client for profile '%s'", name), "build-client-error", common.GetComponentInfo())
+		}
+		entry.client = client
+	}
+	return entry.client, nil
+}
+
+// Default returns the client of the default profile.
+func (registry *Registry) Default() (*appconfigurationv1.AppConfigurationV1, error) {
+	registry.mutex.Lock()
+	name := registry.defaultProfile
+	registry.mutex.Unlock()
+	return registry.Client(name)
+}
@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package profiles
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryClient(t *testing.T) {
+	t.Setenv("TEST_PRIMARY_APIKEY", "my-apikey")
+	config, err := Load(strings.NewReader(testProfiles))
+	require.Nil(t, err)
+	registry := NewRegistry(config)
+
+	assert.Equal(t, []string{"dr", "primary"}, registry.Names())
+
+	primary, err := registry.Client("primary")
+	require.Nil(t, err)
+	assert.Equal(t, "https://us-south.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/36401ffc-6280-459a-ba98-456aba10d0c7", primary.GetServiceURL())
+
+	again, err := registry.Client("primary")
+	require.Nil(t, err)
+	assert.Same(t, primary, again)
+
+	dr, err := registry.Default()
+	require.Nil(t, err)
+	assert.NotSame(t, primary, dr)
+	assert.Equal(t, "https://private.eu-de.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/9f3b1c52-0d4e-4a8b-9a55-1f6a2e0c7d11", dr.GetServiceURL())
+
+	registry.Invalidate("primary")
+	rebuilt, err := registry.Client("primary")
+	require.Nil(t, err)
+	assert.NotSame(t, primary, rebuilt)
+
+	_, err = registry.Client("missing")
+	assert.NotNil(t, err)
+}
+
+func TestRegistryRetriesFailedBuild(t *testing.T) {
+	registry := NewRegistry(nil)
+	err := registry.Register("staging", &Profile{
+		URL:           "https://example.com",
+		Authenticator: AuthenticatorConfig{Type: AuthTypeIam, Credentials: CredentialsSource{Env: "TEST_STAGING_APIKEY"}},
+	})
+	require.Nil(t, err)
+
+	_, err = registry.Client("staging")
+	assert.NotNil(t, err)
+
+	t.Setenv("TEST_STAGING_APIKEY", "my-apikey")
+	client, err := registry.Client("staging")
+	require.Nil(t, err)
+	assert.NotNil(t, client)
+
+	assert.NotNil(t, registry.Register("invalid", &Profile{}))
+	_, err = registry.Default()
+	assert.NotNil(t, err)
+}
+
+func TestRegistryConcurrentClient(t *testing.T) {
+	registry := NewRegistry(nil)
+	require.Nil(t, registry.Register(DefaultProfileName, &Profile{
+		URL:           "https://example.com",
+		Authenticator: AuthenticatorConfig{Type: AuthTypeNoAuth},
+	}))
+
+	clients := make([]*appconfigurationv1.AppConfigurationV1, 20)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i], _ = registry.Default()
+		}(i)
+	}
+	wg.Wait()
+	for _, client := range clients {
+		assert.Same(t, clients[0], client)
+	}
+}
@@ -0,0 +1,246 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type invokeFunc func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error)
+
+// changeOperation returns the bulk operation that applies a change through the create, update and delete methods.
+func changeOperation(change appconfigurationv1.ConfigChange) appconfigurationv1.BulkOperation {
+	var invoke invokeFunc
+	switch change.Action {
+	case appconfigurationv1.ConfigChange_Action_Create:
+		invoke = createInvoke(change)
+	case appconfigurationv1.ConfigChange_Action_Update:
+		invoke = updateInvoke(change)
+	case appconfigurationv1.ConfigChange_Action_Delete:
+		invoke = deleteInvoke(change)
+	case appconfigurationv1.ConfigChange_Action_Replace:
+		remove, create := deleteInvoke(change), createInvoke(change)
+		invoke = func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			result, response, err := remove(ctx, client)
+			if err != nil || (response != nil && response.StatusCode == 202) {
+				return result, response, err
+			}
+			return create(ctx, client)
+		}
+	}
+	if invoke == nil {
+		invoke = func(context.Context, *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return nil, nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported change '%s'", change), "unsupported-change", common.GetComponentInfo())
+		}
+	}
+	return appconfigurationv1.BulkOperation{Name: change.String(), Invoke: invoke}
+}
+
+func createInvoke(change appconfigurationv1.ConfigChange) invokeFunc {
+	switch desired := change.Desired.(type) {
+	case *appconfigurationv1.ImportCollectionSchema:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.CreateCollectionWithContext(ctx, &appconfigurationv1.CreateCollectionOptions{
+				CollectionID: desired.CollectionID,
+				Name:         desired.Name,
+				Description:  desired.Description,
+				Tags:         desired.Tags,
+			})
+		}
+	case *appconfigurationv1.ImportSegmentSchema:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.CreateSegmentWithContext(ctx, &appconfigurationv1.CreateSegmentOptions{
+				SegmentID:   desired.SegmentID,
+				Name:        desired.Name,
+				Description: desired.Description,
+				Tags:        desired.Tags,
+				Rules:       desired.Rules,
+			})
+		}
+	case *appconfigurationv1.ImportEnvironmentSchema:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.CreateEnvironmentWithContext(ctx, &appconfigurationv1.CreateEnvironmentOptions{
+				EnvironmentID: desired.EnvironmentID,
+				Name:          desired.Name,
+				Description:   desired.Description,
+				Tags:          desired.Tags,
+				ColorCode:     desired.ColorCode,
+			})
+		}
+	case *appconfigurationv1.ImportFeatureRequestBody:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.CreateFeatureWithContext(ctx, &appconfigurationv1.CreateFeatureOptions{
+				EnvironmentID:        core.StringPtr(change.EnvironmentID),
+				FeatureID:            desired.FeatureID,
+				Name:                 desired.Name,
+				Description:          desired.Description,
+				Type:                 desired.Type,
+				Format:               desired.Format,
+				EnabledValue:         desired.EnabledValue,
+				DisabledValue:        desired.DisabledValue,
+				Enabled:              desired.Enabled,
+				RolloutPercentage:    desired.RolloutPercentage,
+				RolloutType:          desired.RolloutType,
+				RolloutConfiguration: desired.RolloutConfiguration,
+				Tags:                 desired.Tags,
+				SegmentRules:         desired.SegmentRules,
+				Collections:          collectionRefs(desired.Collections),
+			})
+		}
+	case *appconfigurationv1.ImportPropertyRequestBody:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.CreatePropertyWithContext(ctx, &appconfigurationv1.CreatePropertyOptions{
+				EnvironmentID: core.StringPtr(change.EnvironmentID),
+				PropertyID:    desired.PropertyID,
+				Name:          desired.Name,
+				Description:   desired.Description,
+				Type:          desired.Type,
+				Format:        desired.Format,
+				Value:         desired.Value,
+				Tags:          desired.Tags,
+				SegmentRules:  desired.SegmentRules,
+				Collections:   collectionRefs(desired.Collections),
+			})
+		}
+	}
+	return nil
+}
+
+// updateInvoke sends every attribute of the desired resource, so the update does not depend on the fields that
+// were found to differ. Collection membership is sent as the difference with the current membership.
+func updateInvoke(change appconfigurationv1.ConfigChange) invokeFunc {
+	switch desired := change.Desired.(type) {
+	case *appconfigurationv1.ImportCollectionSchema:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateCollectionWithContext(ctx, &appconfigurationv1.UpdateCollectionOptions{
+				CollectionID: desired.CollectionID,
+				Name:         desired.Name,
+				Description:  desired.Description,
+				Tags:         desired.Tags,
+			})
+		}
+	case *appconfigurationv1.ImportSegmentSchema:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateSegmentWithContext(ctx, &appconfigurationv1.UpdateSegmentOptions{
+				SegmentID:   desired.SegmentID,
+				Name:        desired.Name,
+				Description: desired.Description,
+				Tags:        desired.Tags,
+				Rules:       desired.Rules,
+			})
+		}
+	case *appconfigurationv1.ImportEnvironmentSchema:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateEnvironmentWithContext(ctx, &appconfigurationv1.UpdateEnvironmentOptions{
+				EnvironmentID: desired.EnvironmentID,
+				Name:          desired.Name,
+				Description:   desired.Description,
+				Tags:          desired.Tags,
+				ColorCode:     desired.ColorCode,
+			})
+		}
+	case *appconfigurationv1.ImportFeatureRequestBody:
+		current := change.Current.(*appconfigurationv1.ImportFeatureRequestBody)
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdateFeatureWithContext(ctx, &appconfigurationv1.UpdateFeatureOptions{
+				EnvironmentID:        core.StringPtr(change.EnvironmentID),
+				FeatureID:            desired.FeatureID,
+				Name:                 desired.Name,
+				Description:          desired.Description,
+				EnabledValue:         desired.EnabledValue,
+				DisabledValue:        desired.DisabledValue,
+				Enabled:              desired.Enabled,
+				RolloutPercentage:    desired.RolloutPercentage,
+				RolloutType:          desired.RolloutType,
+				RolloutConfiguration: desired.RolloutConfiguration,
+				Tags:                 desired.Tags,
+				SegmentRules:         desired.SegmentRules,
+				Collections:          collectionUpdateRefs(current.Collections, desired.Collections),
+			})
+		}
+	case *appconfigurationv1.ImportPropertyRequestBody:
+		current := change.Current.(*appconfigurationv1.ImportPropertyRequestBody)
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.UpdatePropertyWithContext(ctx, &appconfigurationv1.UpdatePropertyOptions{
+				EnvironmentID: core.StringPtr(change.EnvironmentID),
+				PropertyID:    desired.PropertyID,
+				Name:          desired.Name,
+				Description:   desired.Description,
+				Value:         desired.Value,
+				Tags:          desired.Tags,
+				SegmentRules:  desired.SegmentRules,
+				Collections:   collectionUpdateRefs(current.Collections, desired.Collections),
+			})
+		}
+	}
+	return nil
+}
+
+func deleteInvoke(change appconfigurationv1.ConfigChange) invokeFunc {
+	id := core.StringPtr(change.ID)
+	environmentID := core.StringPtr(change.EnvironmentID)
+	switch change.Kind {
+	case appconfigurationv1.ConfigChange_Kind_Collection:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.DeleteCollectionWithContext(ctx, &appconfigurationv1.DeleteCollectionOptions{CollectionID: id})
+		}
+	case appconfigurationv1.ConfigChange_Kind_Segment:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.DeleteSegmentWithContext(ctx, &appconfigurationv1.DeleteSegmentOptions{SegmentID: id})
+		}
+	case appconfigurationv1.ConfigChange_Kind_Environment:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.DeleteEnvironmentWithContext(ctx, &appconfigurationv1.DeleteEnvironmentOptions{EnvironmentID: id})
+		}
+	case appconfigurationv1.ConfigChange_Kind_Feature:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.DeleteFeatureWithContext(ctx, &appconfigurationv1.DeleteFeatureOptions{EnvironmentID: environmentID, FeatureID: id})
+		}
+	case appconfigurationv1.ConfigChange_Kind_Property:
+		return func(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (interface{}, *core.DetailedResponse, error) {
+			return client.DeletePropertyWithContext(ctx, &appconfigurationv1.DeletePropertyOptions{EnvironmentID: environmentID, PropertyID: id})
+		}
+	}
+	return nil
+}
+
+// collectionRefs drops the collection names, which are not accepted on create.
+func collectionRefs(refs []appconfigurationv1.CollectionRef) (result []appconfigurationv1.CollectionRef) {
+	for _, ref := range refs {
+		result = append(result, appconfigurationv1.CollectionRef{CollectionID: ref.CollectionID})
+	}
+	return
+}
+
+func collectionUpdateRefs(current []appconfigurationv1.CollectionRef, desired []appconfigurationv1.CollectionRef) (result []appconfigurationv1.CollectionUpdateRef) {
+	wanted := map[string]bool{}
+	for _, ref := range desired {
+		wanted[*ref.CollectionID] = true
+		result = append(result, appconfigurationv1.CollectionUpdateRef{CollectionID: ref.CollectionID})
+	}
+	for _, ref := range current {
+		if !wanted[*ref.CollectionID] {
+			result = append(result, appconfigurationv1.CollectionUpdateRef{CollectionID: ref.CollectionID, Deleted: core.BoolPtr(true)})
+		}
+	}
+	return
+}
@@ -0,0 +1,514 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package replication : One-way replication of configuration between App Configuration instances
+// A Replicator reads the configuration of a source instance, compares it with a target instance and applies the
+// differences to the target, for example to keep a disaster-recovery instance in sync with the primary one.
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Options : Options that control what is replicated and how.
+type Options struct {
+	// Source environment IDs to replicate. All environments are replicated if empty.
+	Environments []string
+
+	// Source collection IDs to replicate. If set, only these collections, and the features and properties that belong
+	// to at least one of them, are replicated.
+	Collections []string
+
+	// If set, only features, properties and segments tagged with at least one of these tags are replicated. Segments
+	// used by a replicated feature or property are always replicated.
+	Tags []string
+
+	// Maps source environment IDs to target environment IDs. Unmapped IDs are kept.
+	EnvironmentIDMap map[string]string
+
+	// Maps source collection IDs to target collection IDs. Unmapped IDs are kept.
+	CollectionIDMap map[string]string
+
+	// Maps source segment IDs to target segment IDs. Unmapped IDs are kept.
+	SegmentIDMap map[string]string
+
+	// If set to true, target resources that do not exist in the source are deleted. Features and properties are only
+	// deleted from replicated environments; environments, collections and segments are only deleted when none of
+	// Environments, Collections or Tags is set.
+	Delete bool
+
+	// If set to true, both instances are read through the paginated list operations instead of ListInstanceConfig.
+	Live bool
+
+	// If set to true, creates and updates are applied with a single ImportConfig call instead of one call per
+	// resource. Deletes and replacements are always applied one resource at a time.
+	UseImport bool
+
+	// If set to true, the differences are computed and reported but not applied.
+	DryRun bool
+
+	// Controls the concurrency of the per-resource calls.
+	Bulk *appconfigurationv1.BulkOptions
+}
+
+// Replicator : Replicates the configuration of a source instance to a target instance.
+type Replicator struct {
+	source  *appconfigurationv1.AppConfigurationV1
+	target  *appconfigurationv1.AppConfigurationV1
+	options Options
+}
+
+// Report : The outcome of a replication.
+type Report struct {
+	// True if the changes were not applied.
+	DryRun bool
+
+	// The changes needed to bring the target in line with the source.
+	Changes appconfigurationv1.ConfigDiff
+
+	// The outcome of every per-resource call, in the order they were made. Each result is named after its change.
+	Results appconfigurationv1.BulkResults
+
+	// The result of the ImportConfig call, when Options.UseImport is set.
+	ImportResult *appconfigurationv1.InstanceConfigAcceptedResponse
+
+	// The detailed response of the ImportConfig call, when Options.UseImport is set.
+	ImportResponse *core.DetailedResponse
+}
+
+// String returns a summary line followed by one line per change.
+func (report *Report) String() string {
+	changes := report.Changes
+	summary := fmt.Sprintf("%d to create, %d to update, %d to replace, %d to delete",
+		changes.Count(appconfigurationv1.ConfigChange_Action_Create),
+		changes.Count(appconfigurationv1.ConfigChange_Action_Update),
+		changes.Count(appconfigurationv1.ConfigChange_Action_Replace),
+		changes.Count(appconfigurationv1.ConfigChange_Action_Delete))
+	if report.DryRun {
+		summary += " (dry run)"
+	} else if failed := len(report.Results.Failed()); failed > 0 {
+		summary += fmt.Sprintf(", %d failed", failed)
+	}
+	if len(changes) == 0 {
+		return summary
+	}
+	return summary + "\n" + changes.String()
+}
+
+// NewReplicator returns a replicator from source to target. options may be nil.
+func NewReplicator(source *appconfigurationv1.AppConfigurationV1, target *appconfigurationv1.AppConfigurationV1, options *Options) (*Replicator, error) {
+	if source == nil || target == nil {
+		return nil, core.SDKErrorf(nil, "source and target clients are required", "missing-client", common.GetComponentInfo())
+	}
+	replicator := &Replicator{source: source, target: target}
+	if options != nil {
+		replicator.options = *options
+	}
+	return replicator, nil
+}
+
+// Plan returns the changes that Replicate would apply to the target.
+func (replicator *Replicator) Plan() (appconfigurationv1.ConfigDiff, error) {
+	return replicator.PlanWithContext(context.Background())
+}
+
+// PlanWithContext is an alternate form of the Plan method which supports a Context parameter
+func (replicator *Replicator) PlanWithContext(ctx context.Context) (appconfigurationv1.ConfigDiff, error) {
+	diff, _, err := replicator.plan(ctx)
+	return diff, err
+}
+
+// plan returns the changes to apply and the desired (selected and remapped) target configuration.
+func (replicator *Replicator) plan(ctx context.Context) (diff appconfigurationv1.ConfigDiff, desired *appconfigurationv1.ImportConfig, err error) {
+	sourceConfig, err := replicator.read(ctx, replicator.source)
+	if err != nil {
+		return nil, nil, core.SDKErrorf(err, "unable to read the source configuration", "read-source-error", common.GetComponentInfo())
+	}
+	targetConfig, err := replicator.read(ctx, replicator.target)
+	if err != nil {
+		return nil, nil, core.SDKErrorf(err, "unable to read the target configuration", "read-target-error", common.GetComponentInfo())
+	}
+
+	options := replicator.options
+	// The full source, with every environment remapped, tells apart resources that were deleted from the source
+	// from resources that are only outside the replicated scope.
+	source, err := remapConfig(sourceConfig, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	desired = selectConfig(source, remapIDs(options.Environments, options.EnvironmentIDMap), remapIDs(options.Collections, options.CollectionIDMap), options.Tags)
+	current := selectConfig(targetConfig, remapIDs(options.Environments, options.EnvironmentIDMap), remapIDs(options.Collections, options.CollectionIDMap), options.Tags)
+	// A segment selected in the source is compared with the target segment, even if the target does not use it.
+	for _, segment := range targetConfig.Segments {
+		if containsSegment(desired.Segments, *segment.SegmentID) && !containsSegment(current.Segments, *segment.SegmentID) {
+			current.Segments = append(current.Segments, segment)
+		}
+	}
+
+	for _, change := range appconfigurationv1.DiffConfig(current, desired) {
+		if change.Action == appconfigurationv1.ConfigChange_Action_Delete && !replicator.deletable(change, source) {
+			continue
+		}
+		diff = append(diff, change)
+	}
+	return diff, desired, nil
+}
+
+// Replicate applies the differences between the source and the target to the target, or only reports them if
+// Options.DryRun is set. The report is returned even if an error occurs while applying the changes.
+func (replicator *Replicator) Replicate() (*Report, error) {
+	return replicator.ReplicateWithContext(context.Background())
+}
+
+// ReplicateWithContext is an alternate form of the Replicate method which supports a Context parameter
+func (replicator *Replicator) ReplicateWithContext(ctx context.Context) (*Report, error) {
+	diff, desired, err := replicator.plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{DryRun: replicator.options.DryRun, Changes: diff}
+	if report.DryRun || len(diff) == 0 {
+		return report, nil
+	}
+
+	pending := diff
+	if replicator.options.UseImport {
+		var importOptions *appconfigurationv1.ImportConfigOptions
+		importOptions, pending = importChanges(diff, desired)
+		if importOptions != nil {
+			report.ImportResult, report.ImportResponse, err = replicator.target.ImportConfigWithContext(ctx, importOptions)
+			if err != nil {
+				return report, core.SDKErrorf(err, "unable to import the changes into the target", "import-error", common.GetComponentInfo())
+			}
+		}
+	}
+
+	// Parents are created before their children and deleted after them, so each phase waits for the previous one.
+	for _, phase := range phases(pending) {
+		operations := make([]appconfigurationv1.BulkOperation, len(phase))
+		for i, change := range phase {
+			operations[i] = changeOperation(change)
+		}
+		results, err := replicator.target.ExecuteBulkWithContext(ctx, operations, replicator.options.Bulk)
+		report.Results = append(report.Results, results...)
+		if err != nil {
+			return report, err
+		}
+		if failed := results.Failed(); len(failed) > 0 {
+			return report, core.SDKErrorf(failed[0].Err, fmt.Sprintf("replication stopped after %d changes failed", len(failed)), "replication-failed", common.GetComponentInfo())
+		}
+	}
+	return report, nil
+}
+
+func (replicator *Replicator) read(ctx context.Context, client *appconfigurationv1.AppConfigurationV1) (config *appconfigurationv1.ImportConfig, err error) {
+	if replicator.options.Live {
+		return client.GetLiveInstanceConfigWithContext(ctx)
+	}
+	config, _, err = client.ListInstanceConfigWithContext(ctx, &appconfigurationv1.ListInstanceConfigOptions{})
+	return
+}
+
+// deletable reports whether a delete change is within the replicated scope.
+func (replicator *Replicator) deletable(change appconfigurationv1.ConfigChange, source *appconfigurationv1.ImportConfig) bool {
+	options := replicator.options
+	if !options.Delete {
+		return false
+	}
+	if change.Kind != appconfigurationv1.ConfigChange_Kind_Feature && change.Kind != appconfigurationv1.ConfigChange_Kind_Property {
+		return len(options.Environments) == 0 && len(options.Collections) == 0 && len(options.Tags) == 0
+	}
+	// A feature or property that is still in the source only left the selection, for example because it was
+	// removed from a replicated collection. It is not deleted, and it is not updated either: the target keeps its
+	// last replicated copy.
+	for _, environment := range source.Environments {
+		if *environment.EnvironmentID != change.EnvironmentID {
+			continue
+		}
+		for _, feature := range environment.Features {
+			if change.Kind == appconfigurationv1.ConfigChange_Kind_Feature && *feature.FeatureID == change.ID {
+				return false
+			}
+		}
+		for _, property := range environment.Properties {
+			if change.Kind == appconfigurationv1.ConfigChange_Kind_Property && *property.PropertyID == change.ID {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// remapConfig returns a copy of config with the environment, collection and segment IDs mapped to the target IDs.
+func remapConfig(config *appconfigurationv1.ImportConfig, options Options) (*appconfigurationv1.ImportConfig, error) {
+	remapped := &appconfigurationv1.ImportConfig{}
+	if config != nil {
+		buffer, err := json.Marshal(config)
+		if err == nil {
+			err = json.Unmarshal(buffer, remapped)
+		}
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "copy-config-error", common.GetComponentInfo())
+		}
+	}
+
+	remap := func(id *string, mapping map[string]string) {
+		if id != nil {
+			if mapped, ok := mapping[*id]; ok {
+				*id = mapped
+			}
+		}
+	}
+	remapRules := func(rules []appconfigurationv1.TargetSegments) {
+		for i := range rules {
+			for j := range rules[i].Segments {
+				remap(&rules[i].Segments[j], options.SegmentIDMap)
+			}
+		}
+	}
+
+	for i := range remapped.Collections {
+		remap(remapped.Collections[i].CollectionID, options.CollectionIDMap)
+	}
+	for i := range remapped.Segments {
+		remap(remapped.Segments[i].SegmentID, options.SegmentIDMap)
+	}
+	for i := range remapped.Environments {
+		environment := &remapped.Environments[i]
+		remap(environment.EnvironmentID, options.EnvironmentIDMap)
+		for j := range environment.Features {
+			feature := &environment.Features[j]
+			for k := range feature.Collections {
+				remap(feature.Collections[k].CollectionID, options.CollectionIDMap)
+			}
+			for k := range feature.SegmentRules {
+				remapRules(feature.SegmentRules[k].Rules)
+			}
+		}
+		for j := range environment.Properties {
+			property := &environment.Properties[j]
+			for k := range property.Collections {
+				remap(property.Collections[k].CollectionID, options.CollectionIDMap)
+			}
+			for k := range property.SegmentRules {
+				remapRules(property.SegmentRules[k].Rules)
+			}
+		}
+	}
+	return remapped, nil
+}
+
+// selectConfig returns the part of config selected by the environment, collection and tag filters.
+func selectConfig(config *appconfigurationv1.ImportConfig, environments []string, collections []string, tags []string) *appconfigurationv1.ImportConfig {
+	selected := &appconfigurationv1.ImportConfig{}
+	if config == nil {
+		return selected
+	}
+
+	inCollections := func(refs []appconfigurationv1.CollectionRef) bool {
+		if len(collections) == 0 {
+			return true
+		}
+		for _, ref := range refs {
+			if ref.CollectionID != nil && contains(collections, *ref.CollectionID) {
+				return true
+			}
+		}
+		return false
+	}
+	usedSegments := map[string]bool{}
+	useSegments := func(rules []appconfigurationv1.TargetSegments) {
+		for _, rule := range rules {
+			for _, segment := range rule.Segments {
+				usedSegments[segment] = true
+			}
+		}
+	}
+
+	for _, environment := range config.Environments {
+		if len(environments) > 0 && !contains(environments, *environment.EnvironmentID) {
+			continue
+		}
+		features := environment.Features
+		properties := environment.Properties
+		environment.Features, environment.Properties = nil, nil
+		for _, feature := range features {
+			if inCollections(feature.Collections) && hasTag(feature.Tags, tags) {
+				environment.Features = append(environment.Features, feature)
+				for _, rule := range feature.SegmentRules {
+					useSegments(rule.Rules)
+				}
+			}
+		}
+		for _, property := range properties {
+			if inCollections(property.Collections) && hasTag(property.Tags, tags) {
+				environment.Properties = append(environment.Properties, property)
+				for _, rule := range property.SegmentRules {
+					useSegments(rule.Rules)
+				}
+			}
+		}
+		selected.Environments = append(selected.Environments, environment)
+	}
+
+	for _, collection := range config.Collections {
+		if len(collections) == 0 || contains(collections, *collection.CollectionID) {
+			selected.Collections = append(selected.Collections, collection)
+		}
+	}
+
+	for _, segment := range config.Segments {
+		filtered := len(collections) > 0 || len(tags) > 0
+		if !filtered || usedSegments[*segment.SegmentID] || (len(tags) > 0 && hasTag(segment.Tags, tags)) {
+			selected.Segments = append(selected.Segments, segment)
+		}
+	}
+	return selected
+}
+
+// importChanges splits the changes into an ImportConfig request holding the creates and updates, and the changes
+// that have to be applied one resource at a time.
+func importChanges(diff appconfigurationv1.ConfigDiff, desiredConfig *appconfigurationv1.ImportConfig) (options *appconfigurationv1.ImportConfigOptions, remaining appconfigurationv1.ConfigDiff) {
+	config := &appconfigurationv1.ImportConfig{}
+	environments := map[string]int{}
+	environment := func(change appconfigurationv1.ConfigChange) *appconfigurationv1.ImportEnvironmentSchema {
+		index, ok := environments[change.EnvironmentID]
+		if !ok {
+			return nil
+		}
+		return &config.Environments[index]
+	}
+
+	imported := 0
+	for _, change := range diff {
+		if change.Action != appconfigurationv1.ConfigChange_Action_Create && change.Action != appconfigurationv1.ConfigChange_Action_Update {
+			remaining = append(remaining, change)
+			continue
+		}
+		imported++
+		switch desired := change.Desired.(type) {
+		case *appconfigurationv1.ImportCollectionSchema:
+			config.Collections = append(config.Collections, *desired)
+		case *appconfigurationv1.ImportSegmentSchema:
+			config.Segments = append(config.Segments, *desired)
+		case *appconfigurationv1.ImportEnvironmentSchema:
+			copied := *desired
+			copied.Features, copied.Properties = nil, nil
+			environments[*desired.EnvironmentID] = len(config.Environments)
+			config.Environments = append(config.Environments, copied)
+		case *appconfigurationv1.ImportFeatureRequestBody, *appconfigurationv1.ImportPropertyRequestBody:
+			// Features and properties are imported as part of their environment, whose attributes must be sent too.
+			if environment(change) == nil {
+				for _, desiredEnvironment := range desiredConfig.Environments {
+					if *desiredEnvironment.EnvironmentID == change.EnvironmentID {
+						desiredEnvironment.Features, desiredEnvironment.Properties = nil, nil
+						environments[change.EnvironmentID] = len(config.Environments)
+						config.Environments = append(config.Environments, desiredEnvironment)
+					}
+				}
+			}
+			if feature, ok := desired.(*appconfigurationv1.ImportFeatureRequestBody); ok {
+				environment(change).Features = append(environment(change).Features, *feature)
+			} else {
+				environment(change).Properties = append(environment(change).Properties, *desired.(*appconfigurationv1.ImportPropertyRequestBody))
+			}
+		}
+	}
+	if imported == 0 {
+		return nil, remaining
+	}
+	return &appconfigurationv1.ImportConfigOptions{
+		Environments: config.Environments,
+		Collections:  config.Collections,
+		Segments:     config.Segments,
+		Clean:        core.StringPtr("false"),
+	}, remaining
+}
+
+// phases groups the changes into the batches that can be applied in parallel.
+func phases(diff appconfigurationv1.ConfigDiff) (batches []appconfigurationv1.ConfigDiff) {
+	var parents, children, childDeletes, parentDeletes appconfigurationv1.ConfigDiff
+	for _, change := range diff {
+		child := change.Kind == appconfigurationv1.ConfigChange_Kind_Feature || change.Kind == appconfigurationv1.ConfigChange_Kind_Property
+		switch {
+		case change.Action == appconfigurationv1.ConfigChange_Action_Delete && child:
+			childDeletes = append(childDeletes, change)
+		case change.Action == appconfigurationv1.ConfigChange_Action_Delete:
+			parentDeletes = append(parentDeletes, change)
+		case child:
+			children = append(children, change)
+		default:
+			parents = append(parents, change)
+		}
+	}
+	for _, batch := range []appconfigurationv1.ConfigDiff{parents, children, childDeletes, parentDeletes} {
+		if len(batch) > 0 {
+			batches = append(batches, batch)
+		}
+	}
+	return
+}
+
+func remapIDs(ids []string, mapping map[string]string) []string {
+	remapped := make([]string, len(ids))
+	for i, id := range ids {
+		remapped[i] = id
+		if mapped, ok := mapping[id]; ok {
+			remapped[i] = mapped
+		}
+	}
+	return remapped
+}
+
+func hasTag(resourceTags *string, tags []string) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	if resourceTags == nil {
+		return false
+	}
+	for _, tag := range strings.Split(*resourceTags, ",") {
+		if contains(tags, strings.TrimSpace(tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSegment(segments []appconfigurationv1.ImportSegmentSchema, segmentID string) bool {
+	for _, segment := range segments {
+		if *segment.SegmentID == segmentID {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sourceConfig = `{
+	"environments": [
+		{"name": "Dev", "environment_id": "dev", "features": [
+			{"name": "f1", "feature_id": "f1", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true, "tags": "web",
+			 "collections": [{"collection_id": "web"}],
+			 "segment_rules": [{"rules": [{"segments": ["beta"]}], "value": true, "order": 1}]},
+			{"name": "f2", "feature_id": "f2", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false, "tags": "web"},
+			{"name": "f3", "feature_id": "f3", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "tags": "mobile"}
+		], "properties": [
+			{"name": "p1", "property_id": "p1", "type": "STRING", "format": "TEXT", "value": "v1", "tags": "web"}
+		]},
+		{"name": "Prod", "environment_id": "prod"}
+	],
+	"collections": [{"name": "web", "collection_id": "web"}],
+	"segments": [
+		{"name": "beta", "segment_id": "beta", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com"]}]},
+		{"name": "other", "segment_id": "other", "rules": [{"attribute_name": "country", "operator": "is", "values": ["IN"]}]}
+	]
+}`
+
+const targetConfig = `{
+	"environments": [
+		{"name": "Dev", "environment_id": "development", "features": [
+			{"name": "f2", "feature_id": "f2", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true, "tags": "web"},
+			{"name": "stale", "feature_id": "stale", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "tags": "web"}
+		], "properties": [
+			{"name": "p1", "property_id": "p1", "type": "STRING", "format": "TEXT", "value": "v1", "tags": "web"}
+		]},
+		{"name": "Prod", "environment_id": "prod"},
+		{"name": "Extra", "environment_id": "extra"}
+	],
+	"collections": [{"name": "web", "collection_id": "web-collection"}],
+	"segments": [
+		{"name": "beta", "segment_id": "beta-users", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com"]}]}
+	]
+}`
+
+type fakeInstance struct {
+	server   *httptest.Server
+	mutex    sync.Mutex
+	requests []string
+	bodies   map[string][]map[string]interface{}
+}
+
+func newFakeInstance(t *testing.T, config string) (*fakeInstance, *appconfigurationv1.AppConfigurationV1) {
+	instance := &fakeInstance{bodies: map[string][]map[string]interface{}{}}
+	instance.server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		if req.Method == http.MethodGet && req.URL.Path == "/config" {
+			fmt.Fprint(res, config)
+			return
+		}
+		request := req.Method + " " + req.URL.Path
+		body := map[string]interface{}{}
+		if content, _ := io.ReadAll(req.Body); len(content) > 0 {
+			_ = json.Unmarshal(content, &body)
+		}
+		instance.mutex.Lock()
+		instance.requests = append(instance.requests, request)
+		instance.bodies[request] = append(instance.bodies[request], body)
+		instance.mutex.Unlock()
+		if req.Method == http.MethodDelete {
+			res.WriteHeader(204)
+			return
+		}
+		res.WriteHeader(200)
+		fmt.Fprint(res, `{}`)
+	}))
+	t.Cleanup(instance.server.Close)
+
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           instance.server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return instance, client
+}
+
+func (instance *fakeInstance) sortedRequests() []string {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	requests := append([]string{}, instance.requests...)
+	sort.Strings(requests)
+	return requests
+}
+
+func newTestReplicator(t *testing.T, options *Options) (*Replicator, *fakeInstance) {
+	_, source := newFakeInstance(t, sourceConfig)
+	targetInstance, target := newFakeInstance(t, targetConfig)
+	if options.EnvironmentIDMap == nil {
+		options.EnvironmentIDMap = map[string]string{"dev": "development"}
+	}
+	options.CollectionIDMap = map[string]string{"web": "web-collection"}
+	options.SegmentIDMap = map[string]string{"beta": "beta-users"}
+	replicator, err := NewReplicator(source, target, options)
+	require.Nil(t, err)
+	return replicator, targetInstance
+}
+
+func TestDryRun(t *testing.T) {
+	replicator, target := newTestReplicator(t, &Options{Environments: []string{"dev"}, Delete: true, DryRun: true})
+
+	report, err := replicator.Replicate()
+	require.Nil(t, err)
+	assert.Empty(t, target.sortedRequests())
+	// Segments are not scoped to an environment, so unused segments are replicated too.
+	assert.Equal(t, `3 to create, 1 to update, 0 to replace, 1 to delete (dry run)
+create segment other
+create feature development/f1
+update feature development/f2 (enabled)
+create feature development/f3
+delete feature development/stale`, report.String())
+}
+
+func TestReplicate(t *testing.T) {
+	replicator, target := newTestReplicator(t, &Options{Delete: true})
+
+	report, err := replicator.Replicate()
+	require.Nil(t, err)
+	assert.Empty(t, report.Results.Failed())
+	assert.Equal(t, []string{
+		"DELETE /environments/development/features/stale",
+		"DELETE /environments/extra",
+		"POST /environments/development/features",
+		"POST /environments/development/features",
+		"POST /segments",
+		"PUT /environments/development/features/f2",
+	}, target.sortedRequests())
+
+	var created map[string]interface{}
+	for _, body := range target.bodies["POST /environments/development/features"] {
+		if body["feature_id"] == "f1" {
+			created = body
+		}
+	}
+	require.NotNil(t, created)
+	assert.Equal(t, []interface{}{map[string]interface{}{"collection_id": "web-collection"}}, created["collections"])
+	rules := created["segment_rules"].([]interface{})[0].(map[string]interface{})["rules"]
+	assert.Equal(t, []interface{}{map[string]interface{}{"segments": []interface{}{"beta-users"}}}, rules)
+	assert.Equal(t, "other", target.bodies["POST /segments"][0]["segment_id"])
+}
+
+func TestReplicateFilters(t *testing.T) {
+	replicator, target := newTestReplicator(t, &Options{Collections: []string{"web"}, Delete: true})
+
+	diff, err := replicator.Plan()
+	require.Nil(t, err)
+	// f2 left the collection in the source and stale is not in it, so neither is deleted; segments used by f1 are
+	// in scope, other segments are not.
+	assert.Equal(t, "create feature development/f1", diff.String())
+
+	replicator, _ = newTestReplicator(t, &Options{Tags: []string{"mobile"}, Environments: []string{"dev"}})
+	diff, err = replicator.Plan()
+	require.Nil(t, err)
+	assert.Equal(t, "create feature development/f3", diff.String())
+	assert.Empty(t, target.sortedRequests())
+}
+
+func TestReplicateWithImport(t *testing.T) {
+	replicator, target := newTestReplicator(t, &Options{Environments: []string{"dev"}, Delete: true, UseImport: true})
+
+	report, err := replicator.Replicate()
+	require.Nil(t, err)
+	assert.NotNil(t, report.ImportResponse)
+	assert.Equal(t, []string{
+		"DELETE /environments/development/features/stale",
+		"POST /config",
+	}, target.sortedRequests())
+
+	imported := target.bodies["POST /config"][0]
+	environments := imported["environments"].([]interface{})
+	require.Len(t, environments, 1)
+	environment := environments[0].(map[string]interface{})
+	assert.Equal(t, "Dev", environment["name"])
+	assert.Len(t, environment["features"], 3)
+}
+
+func TestNewReplicatorRequiresClients(t *testing.T) {
+	_, err := NewReplicator(nil, nil, nil)
+	assert.NotNil(t, err)
+}
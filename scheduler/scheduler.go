@@ -0,0 +1,582 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package scheduler : Planned changes run at their due time by a local worker
+// A Scheduler keeps changes to feature flags and properties in a Store, and applies each one through the client when
+// it is due. Changes missed while the worker was stopped are run late or skipped by policy, and the outcome of every
+// change is recorded in the store and reported. Only one scheduler should run per store: two workers sharing a store
+// would both apply the changes.
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrNotFound is returned when the store has no change with the requested ID.
+var ErrNotFound = errors.New("scheduled change not found")
+
+// Kind : What a change does.
+type Kind string
+
+const (
+	// KindToggleFeature turns a feature flag on or off.
+	KindToggleFeature Kind = "toggle_feature"
+
+	// KindUpdateFeatureValues sets the enabled value, the disabled value, or both, of a feature flag.
+	KindUpdateFeatureValues Kind = "update_feature_values"
+
+	// KindUpdateRolloutPercentage sets the rollout percentage of a feature flag, or of one of its rules.
+	KindUpdateRolloutPercentage Kind = "update_rollout_percentage"
+
+	// KindUpdatePropertyValue sets the value of a property.
+	KindUpdatePropertyValue Kind = "update_property_value"
+)
+
+// Status : Where a change is in its life.
+type Status string
+
+const (
+	// StatusPending is the status of a change that has not run yet.
+	StatusPending Status = "pending"
+
+	// StatusSucceeded is the status of a change that was applied.
+	StatusSucceeded Status = "succeeded"
+
+	// StatusApprovalRequested is the status of a change that was submitted to the workflow of its environment, and is
+	// applied by the service once approved.
+	StatusApprovalRequested Status = "approval_requested"
+
+	// StatusFailed is the status of a change that the service rejected.
+	StatusFailed Status = "failed"
+
+	// StatusSkipped is the status of a missed change that was not run, by policy.
+	StatusSkipped Status = "skipped"
+
+	// StatusCanceled is the status of a change canceled before it ran.
+	StatusCanceled Status = "canceled"
+)
+
+// MissedPolicy : What to do with a change that was not run at its due time, because the scheduler was not running.
+type MissedPolicy string
+
+const (
+	// MissedRunLate runs every missed change as soon as possible, in the order of their due times.
+	MissedRunLate MissedPolicy = "run_late"
+
+	// MissedSkip skips the missed changes.
+	MissedSkip MissedPolicy = "skip"
+
+	// MissedRunLatest runs, of the missed changes of a kind to the same feature flag, rule or property, only the one
+	// due last, and skips the others.
+	MissedRunLatest MissedPolicy = "run_latest"
+)
+
+// Change : A planned change and its outcome.
+type Change struct {
+	// A unique ID, set by Schedule if empty.
+	ID string `json:"id"`
+
+	Kind Kind `json:"kind"`
+
+	// When the change must be applied.
+	DueTime time.Time `json:"due_time"`
+
+	// The feature flag, or the property, and its environment.
+	EnvironmentID string `json:"environment_id"`
+	FeatureID     string `json:"feature_id,omitempty"`
+	PropertyID    string `json:"property_id,omitempty"`
+
+	// The rule of the feature flag whose rollout percentage is changed, by KindUpdateRolloutPercentage. The rollout
+	// percentage of the feature flag itself is changed if empty.
+	RuleID string `json:"rule_id,omitempty"`
+
+	// The state of the feature flag, for KindToggleFeature.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The values of the feature flag, for KindUpdateFeatureValues. A nil value is left unchanged.
+	EnabledValue  interface{} `json:"enabled_value,omitempty"`
+	DisabledValue interface{} `json:"disabled_value,omitempty"`
+
+	// The rollout percentage, for KindUpdateRolloutPercentage.
+	RolloutPercentage *int64 `json:"rollout_percentage,omitempty"`
+
+	// The value of the property, for KindUpdatePropertyValue.
+	Value interface{} `json:"value,omitempty"`
+
+	// How long before DueTime the change is submitted if its environment has an enabled workflow configuration, so that
+	// the approval is requested ahead of time and the service applies the change once it is approved. The workflow
+	// configurations are looked up when the lead time starts; in environments without one, the change waits for
+	// DueTime.
+	ApprovalLeadTime time.Duration `json:"approval_lead_time,omitempty"`
+
+	// The policy applied if the change is missed, the policy of the scheduler if empty.
+	MissedPolicy MissedPolicy `json:"missed_policy,omitempty"`
+
+	// The outcome of the change.
+	Status Status `json:"status"`
+
+	// When the change was run, or skipped.
+	RunTime *time.Time `json:"run_time,omitempty"`
+
+	// The HTTP status code of the request that applied the change.
+	StatusCode int `json:"status_code,omitempty"`
+
+	// Why the change failed or was skipped.
+	Error string `json:"error,omitempty"`
+
+	// The change request of the workflow approval, for StatusApprovalRequested.
+	ChangeRequestNumber string `json:"change_request_number,omitempty"`
+	ApprovalURL         string `json:"approval_url,omitempty"`
+}
+
+// String returns a one-line description of the change, for example `toggle_feature dev/checkout at 2026-03-02T09:00:00Z`.
+func (change Change) String() string {
+	target := change.FeatureID
+	if change.PropertyID != "" {
+		target = change.PropertyID
+	}
+	if change.RuleID != "" {
+		target += "/" + change.RuleID
+	}
+	return fmt.Sprintf("%s %s/%s at %s", change.Kind, change.EnvironmentID, target, change.DueTime.UTC().Format(time.RFC3339))
+}
+
+// target identifies what a change changes, for MissedRunLatest.
+func (change *Change) target() string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s", change.Kind, change.EnvironmentID, change.FeatureID, change.PropertyID, change.RuleID)
+}
+
+// validate checks that a change has the fields of its kind.
+func (change *Change) validate() error {
+	var problem string
+	switch {
+	case change.Kind != KindToggleFeature && change.Kind != KindUpdateFeatureValues &&
+		change.Kind != KindUpdateRolloutPercentage && change.Kind != KindUpdatePropertyValue:
+		problem = fmt.Sprintf("unknown kind '%s'", change.Kind)
+	case change.EnvironmentID == "":
+		problem = "an environment ID is required"
+	case change.DueTime.IsZero():
+		problem = "a due time is required"
+	case change.Kind == KindUpdatePropertyValue && change.PropertyID == "":
+		problem = "a property ID is required"
+	case change.Kind == KindUpdatePropertyValue && change.Value == nil:
+		problem = "a value is required"
+	case change.Kind != KindUpdatePropertyValue && change.FeatureID == "":
+		problem = "a feature ID is required"
+	case change.Kind == KindToggleFeature && change.Enabled == nil:
+		problem = "an enabled state is required"
+	case change.Kind == KindUpdateFeatureValues && change.EnabledValue == nil && change.DisabledValue == nil:
+		problem = "an enabled value or a disabled value is required"
+	case change.Kind == KindUpdateRolloutPercentage && change.RolloutPercentage == nil:
+		problem = "a rollout percentage is required"
+	case change.Kind == KindUpdateRolloutPercentage && (*change.RolloutPercentage < 0 || *change.RolloutPercentage > 100):
+		problem = "the rollout percentage must be between 0 and 100"
+	case change.ApprovalLeadTime < 0:
+		problem = "the approval lead time cannot be negative"
+	case change.MissedPolicy != "" && change.MissedPolicy != MissedRunLate && change.MissedPolicy != MissedSkip &&
+		change.MissedPolicy != MissedRunLatest:
+		problem = fmt.Sprintf("unknown missed policy '%s'", change.MissedPolicy)
+	default:
+		return nil
+	}
+	return core.SDKErrorf(nil, fmt.Sprintf("invalid change %s: %s", change, problem), "invalid-change-error", common.GetComponentInfo())
+}
+
+// Options : The options of a Scheduler.
+type Options struct {
+	// The policy of the changes without their own, MissedRunLate by default.
+	MissedPolicy MissedPolicy
+
+	// How late a change can run and still be on time rather than missed, one minute by default.
+	Tolerance time.Duration
+}
+
+// Scheduler : Applies the changes of a store at their due time. A Scheduler is safe for concurrent use.
+type Scheduler struct {
+	client  *appconfigurationv1.AppConfigurationV1
+	store   Store
+	options Options
+	now     func() time.Time
+
+	// Whether the environments of the changes in their approval lead time have an enabled workflow configuration.
+	workflows map[string]bool
+
+	mutex sync.Mutex
+}
+
+// NewScheduler returns a scheduler applying the changes of store through client.
+func NewScheduler(client *appconfigurationv1.AppConfigurationV1, store Store, options *Options) (*Scheduler, error) {
+	if client == nil || store == nil {
+		return nil, core.SDKErrorf(nil, "client and store are required", "missing-scheduler-param", common.GetComponentInfo())
+	}
+	scheduler := &Scheduler{client: client, store: store, now: time.Now, workflows: map[string]bool{}}
+	if options != nil {
+		scheduler.options = *options
+	}
+	if scheduler.options.MissedPolicy == "" {
+		scheduler.options.MissedPolicy = MissedRunLate
+	}
+	if scheduler.options.Tolerance == 0 {
+		scheduler.options.Tolerance = time.Minute
+	}
+	return scheduler, nil
+}
+
+// Schedule validates a change and adds it to the store as pending.
+func (scheduler *Scheduler) Schedule(change Change) (*Change, error) {
+	if err := change.validate(); err != nil {
+		return nil, err
+	}
+	if change.ID == "" {
+		change.ID = newChangeID()
+	}
+	change.Status = StatusPending
+	change.RunTime, change.StatusCode, change.Error = nil, 0, ""
+	change.ChangeRequestNumber, change.ApprovalURL = "", ""
+
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	changes, err := scheduler.store.Load()
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range changes {
+		if existing.ID == change.ID {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("a change with the ID '%s' is already scheduled", change.ID), "duplicate-change-error", common.GetComponentInfo())
+		}
+	}
+	if err = scheduler.store.Save(append(changes, change)); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// Cancel cancels a pending change.
+func (scheduler *Scheduler) Cancel(id string) error {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	changes, err := scheduler.store.Load()
+	if err != nil {
+		return err
+	}
+	for i := range changes {
+		if changes[i].ID != id {
+			continue
+		}
+		if changes[i].Status != StatusPending {
+			return core.SDKErrorf(nil, fmt.Sprintf("the change '%s' is %s and cannot be canceled", id, changes[i].Status), "change-not-pending-error", common.GetComponentInfo())
+		}
+		changes[i].Status = StatusCanceled
+		return scheduler.store.Save(changes)
+	}
+	return core.SDKErrorf(fmt.Errorf("%w: %s", ErrNotFound, id), "", "change-not-found", common.GetComponentInfo())
+}
+
+// List returns the changes of the store, ordered by due time.
+func (scheduler *Scheduler) List() ([]Change, error) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	changes, err := scheduler.store.Load()
+	if err != nil {
+		return nil, err
+	}
+	scheduler.sortChanges(changes)
+	return changes, nil
+}
+
+// RunDue runs the pending changes that are due, in the order of their due times, and returns them with their outcome.
+// A change that fails does not stop the others; its error is recorded in the change.
+func (scheduler *Scheduler) RunDue() ([]Change, error) {
+	return scheduler.RunDueWithContext(context.Background())
+}
+
+// RunDueWithContext is an alternate form of the RunDue method which supports a Context parameter
+func (scheduler *Scheduler) RunDueWithContext(ctx context.Context) ([]Change, error) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	changes, err := scheduler.store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	now := scheduler.now()
+	workflowErr := scheduler.lookUpWorkflows(ctx, changes, now)
+	scheduler.sortChanges(changes)
+	due := []int{}
+	latest := map[string]int{}
+	for i := range changes {
+		if changes[i].Status != StatusPending || scheduler.submitTime(&changes[i]).After(now) {
+			continue
+		}
+		due = append(due, i)
+		if scheduler.missed(&changes[i], now) {
+			latest[changes[i].target()] = i
+		}
+	}
+
+	ran := []Change{}
+	for _, i := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		change := &changes[i]
+		if scheduler.missed(change, now) {
+			switch scheduler.policy(change) {
+			case MissedSkip:
+				scheduler.skip(change, fmt.Sprintf("missed by %s", now.Sub(scheduler.submitTime(change)).Round(time.Second)))
+			case MissedRunLatest:
+				if latest[change.target()] != i {
+					scheduler.skip(change, "missed, and superseded by a later change")
+				}
+			}
+		}
+		if change.Status == StatusPending {
+			scheduler.apply(ctx, change)
+		}
+		// The outcome is saved right away, so that a crash does not apply the change again.
+		if err = scheduler.store.Save(changes); err != nil {
+			return ran, err
+		}
+		ran = append(ran, *change)
+	}
+	return ran, workflowErr
+}
+
+// Next returns the time the next pending change must be submitted, or false if there is none.
+func (scheduler *Scheduler) Next() (time.Time, bool, error) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	changes, err := scheduler.store.Load()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	var next time.Time
+	found := false
+	for i := range changes {
+		if changes[i].Status == StatusPending && (!found || scheduler.submitTime(&changes[i]).Before(next)) {
+			next, found = scheduler.submitTime(&changes[i]), true
+		}
+	}
+	return next, found, nil
+}
+
+// Run runs the due changes until ctx is done, and sends each change that ran or was skipped to outcomes, if it is not
+// nil, which it does not close. The store is checked at least every interval, so that changes scheduled by other
+// processes are picked up, and when the next change is due. Errors are reported to onError, if it is not nil. Run
+// returns nil when ctx is done, and an error right away if interval is not positive.
+func (scheduler *Scheduler) Run(ctx context.Context, interval time.Duration, outcomes chan<- Change, onError func(error)) error {
+	if interval <= 0 {
+		return core.SDKErrorf(nil, fmt.Sprintf("the interval must be positive, not %s", interval), "invalid-interval-error", common.GetComponentInfo())
+	}
+	for {
+		ran, err := scheduler.RunDueWithContext(ctx)
+		if err != nil && ctx.Err() == nil && onError != nil {
+			onError(err)
+		}
+		for _, change := range ran {
+			if outcomes == nil {
+				break
+			}
+			select {
+			case outcomes <- change:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+		wait := interval
+		next, found, err := scheduler.Next()
+		if err != nil && onError != nil {
+			onError(err)
+		}
+		if until := next.Sub(scheduler.now()); found && until < wait {
+			// A change still due after RunDue could not be saved, and is retried a little later.
+			wait = max(until, time.Second)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+// submitTime is when a change must be submitted to the service: ApprovalLeadTime before its due time if its
+// environment has an enabled workflow configuration, or has not been looked up yet, and at its due time otherwise.
+func (scheduler *Scheduler) submitTime(change *Change) time.Time {
+	if change.ApprovalLeadTime > 0 {
+		if workflow, known := scheduler.workflows[change.EnvironmentID]; workflow || !known {
+			return change.DueTime.Add(-change.ApprovalLeadTime)
+		}
+	}
+	return change.DueTime
+}
+
+// lookUpWorkflows finds which environments of the pending changes in their approval lead time have an enabled
+// workflow configuration. If the configurations cannot be listed, the changes wait for their due time.
+func (scheduler *Scheduler) lookUpWorkflows(ctx context.Context, changes []Change, now time.Time) error {
+	environments := map[string]bool{}
+	for i := range changes {
+		change := &changes[i]
+		if change.Status == StatusPending && change.ApprovalLeadTime > 0 && !change.DueTime.Add(-change.ApprovalLeadTime).After(now) {
+			environments[change.EnvironmentID] = false
+		}
+	}
+	if len(environments) == 0 {
+		return nil
+	}
+
+	pager, err := scheduler.client.NewWorkflowConfigsPager(&appconfigurationv1.ListWorkflowConfigsOptions{})
+	var configs []appconfigurationv1.WorkflowConfigResponse
+	if err == nil {
+		configs, err = pager.GetAllWithContext(ctx)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "could not list the workflow configurations, the changes of their approval lead time wait for their due time", "list-workflow-configs-error", common.GetComponentInfo())
+	}
+	for _, config := range configs {
+		if config.Enabled == nil || !*config.Enabled || config.Scope == nil {
+			continue
+		}
+		for _, environment := range config.Scope.Environments {
+			if environment.EnvironmentID == nil {
+				continue
+			}
+			if _, ok := environments[*environment.EnvironmentID]; ok {
+				environments[*environment.EnvironmentID] = true
+			}
+		}
+	}
+	for environmentID, workflow := range environments {
+		scheduler.workflows[environmentID] = workflow
+	}
+	return err
+}
+
+func (scheduler *Scheduler) missed(change *Change, now time.Time) bool {
+	return now.Sub(scheduler.submitTime(change)) > scheduler.options.Tolerance
+}
+
+func (scheduler *Scheduler) policy(change *Change) MissedPolicy {
+	if change.MissedPolicy != "" {
+		return change.MissedPolicy
+	}
+	return scheduler.options.MissedPolicy
+}
+
+func (scheduler *Scheduler) skip(change *Change, reason string) {
+	now := scheduler.now()
+	change.Status, change.RunTime, change.Error = StatusSkipped, &now, reason
+}
+
+// apply sends a change and records its outcome.
+func (scheduler *Scheduler) apply(ctx context.Context, change *Change) {
+	response, err := scheduler.send(ctx, change)
+	now := scheduler.now()
+	change.RunTime = &now
+	if response != nil {
+		change.StatusCode = response.StatusCode
+	}
+	if err != nil {
+		change.Status, change.Error = StatusFailed, err.Error()
+		return
+	}
+	change.Status = StatusSucceeded
+	if response.StatusCode != http.StatusAccepted {
+		return
+	}
+	// The service answers with 202 and the approval attached to the resource when the change awaits approval.
+	change.Status = StatusApprovalRequested
+	var approval *appconfigurationv1.WorkflowApprovalInfo
+	switch result := response.Result.(type) {
+	case *appconfigurationv1.Feature:
+		approval = result.WorkflowApproval
+	case *appconfigurationv1.Property:
+		approval = result.WorkflowApproval
+	}
+	if approval != nil {
+		change.ChangeRequestNumber = core.StringNilMapper(approval.ChangeRequestNumber)
+		change.ApprovalURL = core.StringNilMapper(approval.ApprovalURL)
+	}
+}
+
+// send applies a change through the client, with the narrowest operation.
+func (scheduler *Scheduler) send(ctx context.Context, change *Change) (response *core.DetailedResponse, err error) {
+	switch change.Kind {
+	case KindToggleFeature:
+		_, response, err = scheduler.client.ToggleFeatureWithContext(ctx, &appconfigurationv1.ToggleFeatureOptions{
+			EnvironmentID: &change.EnvironmentID,
+			FeatureID:     &change.FeatureID,
+			Enabled:       change.Enabled,
+		})
+	case KindUpdateFeatureValues:
+		_, response, err = scheduler.client.MutateFeature(ctx, change.EnvironmentID, change.FeatureID, func(feature *appconfigurationv1.Feature) error {
+			if change.EnabledValue != nil {
+				feature.EnabledValue = change.EnabledValue
+			}
+			if change.DisabledValue != nil {
+				feature.DisabledValue = change.DisabledValue
+			}
+			return nil
+		})
+	case KindUpdateRolloutPercentage:
+		_, response, err = scheduler.client.MutateFeature(ctx, change.EnvironmentID, change.FeatureID, func(feature *appconfigurationv1.Feature) error {
+			if change.RuleID == "" {
+				feature.RolloutPercentage = change.RolloutPercentage
+				return nil
+			}
+			for i := range feature.SegmentRules {
+				if core.StringNilMapper(feature.SegmentRules[i].RuleID) == change.RuleID {
+					feature.SegmentRules[i].RolloutPercentage = change.RolloutPercentage
+					return nil
+				}
+			}
+			return fmt.Errorf("feature '%s' has no rule '%s'", change.FeatureID, change.RuleID)
+		})
+	case KindUpdatePropertyValue:
+		_, response, err = scheduler.client.MutateProperty(ctx, change.EnvironmentID, change.PropertyID, func(property *appconfigurationv1.Property) error {
+			property.Value = change.Value
+			return nil
+		})
+	}
+	return
+}
+
+func (scheduler *Scheduler) sortChanges(changes []Change) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return scheduler.submitTime(&changes[i]).Before(scheduler.submitTime(&changes[j]))
+	})
+}
+
+func newChangeID() string {
+	suffix := make([]byte, 8)
+	rand.Read(suffix)
+	return hex.EncodeToString(suffix)
+}
@@ -0,0 +1,283 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var monday = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+type fakeInstance struct {
+	mutex    sync.Mutex
+	writes   []string
+	bodies   []map[string]interface{}
+	approval bool
+
+	// The environments of the enabled workflow configuration, and the number of times it was listed.
+	workflowEnvironments []string
+	workflowLookups      int
+}
+
+func newTestScheduler(t *testing.T, store Store, options *Options) (*Scheduler, *fakeInstance) {
+	instance := &fakeInstance{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		instance.mutex.Lock()
+		defer instance.mutex.Unlock()
+		res.Header().Set("Content-type", "application/json")
+		if req.URL.Path == "/workflow/configs" {
+			instance.workflowLookups++
+			environments := []map[string]string{}
+			for _, environmentID := range instance.workflowEnvironments {
+				environments = append(environments, map[string]string{"environment_id": environmentID})
+			}
+			scope, _ := json.Marshal(map[string]interface{}{"environments": environments})
+			fmt.Fprintf(res, `{"workflow_configs": [
+				{"name": "Disabled", "workflow_id": "disabled", "enabled": false, "scope": {"environments": [{"environment_id": "dev"}]}},
+				{"name": "Approvals", "workflow_id": "approvals", "enabled": true, "scope": %s}],
+				"limit": 10, "offset": 0, "total_count": 2}`, scope)
+			return
+		}
+		if req.Method == http.MethodGet {
+			fmt.Fprint(res, `{"name": "Checkout", "feature_id": "checkout", "property_id": "timeout", "type": "NUMERIC",
+				"enabled_value": 1, "disabled_value": 0, "value": 30, "enabled": false, "rollout_percentage": 10,
+				"segment_rules": [{"rules": [{"segments": ["beta"]}], "value": 2, "order": 1, "rule_id": "beta-rule", "rollout_percentage": 100}]}`)
+			return
+		}
+		content, _ := io.ReadAll(req.Body)
+		body := map[string]interface{}{}
+		json.Unmarshal(content, &body)
+		instance.writes = append(instance.writes, req.Method+" "+req.URL.Path)
+		instance.bodies = append(instance.bodies, body)
+		if instance.approval {
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"name": "Checkout", "feature_id": "checkout", "workflow_approval": {"change_request_number": "CHG0001", "approval_url": "https://example.com/CHG0001"}}`)
+			return
+		}
+		res.Write(content)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	scheduler, err := NewScheduler(client, store, options)
+	require.Nil(t, err)
+	scheduler.now = func() time.Time { return monday }
+	return scheduler, instance
+}
+
+func TestScheduleValidatesChanges(t *testing.T) {
+	scheduler, _ := newTestScheduler(t, NewMemoryStore(), nil)
+	_, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "an enabled state is required")
+
+	_, err = scheduler.Schedule(Change{Kind: "delete_everything", EnvironmentID: "dev", DueTime: monday})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unknown kind 'delete_everything'")
+
+	_, err = scheduler.Schedule(Change{Kind: KindUpdateRolloutPercentage, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday, RolloutPercentage: core.Int64Ptr(150)})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "between 0 and 100")
+}
+
+func TestRunDueAppliesChangesAndRecordsOutcomes(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "schedule.json"))
+	scheduler, instance := newTestScheduler(t, store, nil)
+	toggle, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday, Enabled: core.BoolPtr(true)})
+	require.Nil(t, err)
+	_, err = scheduler.Schedule(Change{Kind: KindUpdatePropertyValue, EnvironmentID: "dev", PropertyID: "timeout", DueTime: monday.Add(-time.Second), Value: 60})
+	require.Nil(t, err)
+	_, err = scheduler.Schedule(Change{Kind: KindUpdateRolloutPercentage, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday.Add(4 * 24 * time.Hour), RolloutPercentage: core.Int64Ptr(50)})
+	require.Nil(t, err)
+
+	ran, err := scheduler.RunDue()
+	require.Nil(t, err)
+	require.Len(t, ran, 2)
+	assert.Equal(t, []string{"PATCH /environments/dev/properties/timeout", "PUT /environments/dev/features/checkout/toggle"}, instance.writes)
+	assert.Equal(t, float64(60), instance.bodies[0]["value"])
+	assert.Equal(t, toggle.ID, ran[1].ID)
+	assert.Equal(t, StatusSucceeded, ran[1].Status)
+	assert.Equal(t, 200, ran[1].StatusCode)
+
+	// The outcomes are in the store, so a restarted scheduler does not run the changes again.
+	restarted, _ := newTestScheduler(t, store, nil)
+	changes, err := restarted.List()
+	require.Nil(t, err)
+	assert.Equal(t, []Status{StatusSucceeded, StatusSucceeded, StatusPending}, []Status{changes[0].Status, changes[1].Status, changes[2].Status})
+	ran, err = restarted.RunDue()
+	require.Nil(t, err)
+	assert.Empty(t, ran)
+	next, found, err := restarted.Next()
+	require.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, monday.Add(4*24*time.Hour), next)
+}
+
+func TestMissedChangesFollowTheirPolicy(t *testing.T) {
+	scheduler, instance := newTestScheduler(t, NewMemoryStore(), &Options{MissedPolicy: MissedRunLatest})
+	for i, percentage := range []int64{25, 50} {
+		_, err := scheduler.Schedule(Change{Kind: KindUpdateRolloutPercentage, EnvironmentID: "dev", FeatureID: "checkout", RuleID: "beta-rule",
+			DueTime: monday.Add(time.Duration(i-3) * time.Hour), RolloutPercentage: core.Int64Ptr(percentage)})
+		require.Nil(t, err)
+	}
+	_, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday.Add(-time.Hour),
+		Enabled: core.BoolPtr(true), MissedPolicy: MissedSkip})
+	require.Nil(t, err)
+	// A change late by less than the tolerance is on time.
+	_, err = scheduler.Schedule(Change{Kind: KindUpdateFeatureValues, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday.Add(-30 * time.Second),
+		EnabledValue: 5, MissedPolicy: MissedSkip})
+	require.Nil(t, err)
+
+	ran, err := scheduler.RunDue()
+	require.Nil(t, err)
+	require.Len(t, ran, 4)
+	assert.Equal(t, StatusSkipped, ran[0].Status)
+	assert.Equal(t, "missed, and superseded by a later change", ran[0].Error)
+	assert.Equal(t, StatusSucceeded, ran[1].Status)
+	assert.Equal(t, StatusSkipped, ran[2].Status)
+	assert.Equal(t, "missed by 1h0m0s", ran[2].Error)
+	assert.Equal(t, StatusSucceeded, ran[3].Status)
+
+	assert.Equal(t, []string{"PATCH /environments/dev/features/checkout", "PATCH /environments/dev/features/checkout"}, instance.writes)
+	rules := instance.bodies[0]["segment_rules"].([]interface{})
+	assert.Equal(t, float64(50), rules[0].(map[string]interface{})["rollout_percentage"])
+	assert.Equal(t, float64(5), instance.bodies[1]["enabled_value"])
+}
+
+func TestApprovalsAreRequestedAheadOfTime(t *testing.T) {
+	scheduler, instance := newTestScheduler(t, NewMemoryStore(), nil)
+	instance.approval = true
+	instance.workflowEnvironments = []string{"prod"}
+	_, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "prod", FeatureID: "checkout", DueTime: monday.Add(2 * time.Hour),
+		Enabled: core.BoolPtr(true), ApprovalLeadTime: 3 * time.Hour})
+	require.Nil(t, err)
+
+	ran, err := scheduler.RunDue()
+	require.Nil(t, err)
+	require.Len(t, ran, 1)
+	assert.Equal(t, StatusApprovalRequested, ran[0].Status)
+	assert.Equal(t, "CHG0001", ran[0].ChangeRequestNumber)
+	assert.Equal(t, "https://example.com/CHG0001", ran[0].ApprovalURL)
+
+	assert.NotNil(t, scheduler.Cancel(ran[0].ID))
+	assert.Equal(t, 1, instance.workflowLookups)
+}
+
+func TestChangesWithoutWorkflowWaitForTheirDueTime(t *testing.T) {
+	scheduler, instance := newTestScheduler(t, NewMemoryStore(), nil)
+	instance.workflowEnvironments = []string{"prod"}
+	// The workflow configuration of dev is disabled, and staging has none.
+	for _, environmentID := range []string{"dev", "staging"} {
+		_, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: environmentID, FeatureID: "checkout", DueTime: monday.Add(2 * time.Hour),
+			Enabled: core.BoolPtr(true), ApprovalLeadTime: 3 * time.Hour})
+		require.Nil(t, err)
+	}
+	_, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "prod", FeatureID: "checkout", DueTime: monday.Add(5 * time.Hour),
+		Enabled: core.BoolPtr(true), ApprovalLeadTime: 3 * time.Hour})
+	require.Nil(t, err)
+
+	// Before the lead time starts, the next change is submitted when it starts.
+	next, found, err := scheduler.Next()
+	require.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, monday.Add(-time.Hour), next)
+
+	ran, err := scheduler.RunDue()
+	require.Nil(t, err)
+	assert.Empty(t, ran)
+	assert.Empty(t, instance.writes)
+	assert.Equal(t, 1, instance.workflowLookups)
+	next, _, err = scheduler.Next()
+	require.Nil(t, err)
+	assert.Equal(t, monday.Add(2*time.Hour), next)
+
+	// At their due time, the changes are applied on time rather than missed.
+	scheduler.now = func() time.Time { return monday.Add(2 * time.Hour) }
+	ran, err = scheduler.RunDueWithContext(context.Background())
+	require.Nil(t, err)
+	require.Len(t, ran, 3)
+	assert.Equal(t, []Status{StatusSucceeded, StatusSucceeded, StatusSucceeded}, []Status{ran[0].Status, ran[1].Status, ran[2].Status})
+	// The change of prod, three hours ahead of its due time, is submitted with them.
+	assert.Equal(t, []string{"dev", "staging", "prod"}, []string{ran[0].EnvironmentID, ran[1].EnvironmentID, ran[2].EnvironmentID})
+}
+
+func TestWorkflowLookupFailuresDelayChangesToTheirDueTime(t *testing.T) {
+	scheduler, instance := newTestScheduler(t, NewMemoryStore(), nil)
+	instance.workflowEnvironments = []string{"prod"}
+	scheduler.client.SetServiceURL("http://127.0.0.1:1")
+	_, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "prod", FeatureID: "checkout", DueTime: monday.Add(2 * time.Hour),
+		Enabled: core.BoolPtr(true), ApprovalLeadTime: 3 * time.Hour})
+	require.Nil(t, err)
+
+	ran, err := scheduler.RunDue()
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "could not list the workflow configurations")
+	assert.Empty(t, ran)
+	next, _, err := scheduler.Next()
+	require.Nil(t, err)
+	assert.Equal(t, monday.Add(2*time.Hour), next)
+}
+
+func TestRunRejectsNonPositiveIntervals(t *testing.T) {
+	scheduler, _ := newTestScheduler(t, NewMemoryStore(), nil)
+	for _, interval := range []time.Duration{0, -time.Minute} {
+		err := scheduler.Run(context.Background(), interval, nil, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "the interval must be positive")
+	}
+}
+
+func TestRunReportsOutcomes(t *testing.T) {
+	scheduler, _ := newTestScheduler(t, NewMemoryStore(), nil)
+	scheduled, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday, Enabled: core.BoolPtr(true)})
+	require.Nil(t, err)
+	canceled, err := scheduler.Schedule(Change{Kind: KindToggleFeature, EnvironmentID: "dev", FeatureID: "checkout", DueTime: monday, Enabled: core.BoolPtr(false)})
+	require.Nil(t, err)
+	require.Nil(t, scheduler.Cancel(canceled.ID))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	outcomes := make(chan Change)
+	go func() {
+		assert.Nil(t, scheduler.Run(ctx, time.Hour, outcomes, func(err error) { t.Error(err) }))
+	}()
+	select {
+	case outcome := <-outcomes:
+		assert.Equal(t, scheduled.ID, outcome.ID)
+		assert.Equal(t, StatusSucceeded, outcome.Status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no outcome was reported")
+	}
+}
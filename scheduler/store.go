@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Store : Where the scheduled changes and their outcomes are kept.
+type Store interface {
+	// Load returns all the changes, in any order.
+	Load() ([]Change, error)
+
+	// Save replaces all the changes.
+	Save(changes []Change) error
+}
+
+// MemoryStore : A Store that keeps the changes in memory, for tests and for schedulers that need not survive a
+// restart.
+type MemoryStore struct {
+	mutex   sync.Mutex
+	changes []Change
+}
+
+// NewMemoryStore returns an empty store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Load returns a copy of the changes.
+func (store *MemoryStore) Load() ([]Change, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return append([]Change{}, store.changes...), nil
+}
+
+// Save replaces the changes with a copy of changes.
+func (store *MemoryStore) Save(changes []Change) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.changes = append([]Change{}, changes...)
+	return nil
+}
+
+// FileStore : A Store backed by a JSON file of the local file system.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore returns a store writing to the file path. The file is created by the first Save.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load reads the changes of the file, none if it does not exist.
+func (store *FileStore) Load() ([]Change, error) {
+	data, err := os.ReadFile(store.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []Change{}, nil
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "read-schedule-error", common.GetComponentInfo())
+	}
+	changes := []Change{}
+	if err = json.Unmarshal(data, &changes); err != nil {
+		return nil, core.SDKErrorf(err, "", "read-schedule-error", common.GetComponentInfo())
+	}
+	return changes, nil
+}
+
+// Save writes the changes to a temporary file first, so that a crash never leaves a partially written file.
+func (store *FileStore) Save(changes []Change) error {
+	data, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-schedule-error", common.GetComponentInfo())
+	}
+	file, err := os.CreateTemp(filepath.Dir(store.path), ".tmp-"+filepath.Base(store.path))
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-schedule-error", common.GetComponentInfo())
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), store.path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return core.SDKErrorf(err, "", "write-schedule-error", common.GetComponentInfo())
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultEnvPrefix is the prefix of the variables read by an EnvResolver without a prefix.
+const DefaultEnvPrefix = "APP_CONFIG_SECRET_"
+
+// FileResolver : Reads secrets from files, for local development and tests. The secret of a reference is the content
+// of `<Dir>/<secret type>/<id>`, or of `<Dir>/<id>` if there is no such file, without its trailing line break.
+type FileResolver struct {
+	Dir string
+}
+
+// NewFileResolver returns a FileResolver reading the files of dir.
+func NewFileResolver(dir string) *FileResolver {
+	return &FileResolver{Dir: dir}
+}
+
+// ResolveSecret reads the file of reference.
+func (resolver *FileResolver) ResolveSecret(ctx context.Context, reference Reference) (Value, error) {
+	if !validFileName(reference.ID) || (reference.SecretType != "" && !validFileName(reference.SecretType)) {
+		return Value{}, core.SDKErrorf(nil, fmt.Sprintf("secret %s is not a valid file name", reference), "invalid-secret-reference", common.GetComponentInfo())
+	}
+	paths := []string{filepath.Join(resolver.Dir, reference.ID)}
+	if reference.SecretType != "" {
+		paths = append([]string{filepath.Join(resolver.Dir, reference.SecretType, reference.ID)}, paths...)
+	}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return Value{}, core.SDKErrorf(err, "", "read-secret-error", common.GetComponentInfo())
+		}
+		return NewValue(trimLineBreak(string(content))), nil
+	}
+	return Value{}, fmt.Errorf("secret %s in '%s': %w", reference, resolver.Dir, ErrNotFound)
+}
+
+func validFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+func trimLineBreak(content string) string {
+	content = strings.TrimSuffix(content, "\n")
+	return strings.TrimSuffix(content, "\r")
+}
+
+var invalidEnvCharacters = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// EnvResolver : Reads secrets from environment variables, for local development and tests. The secret of a reference
+// is the variable named Prefix followed by the upper-cased secret ID, with the characters that are not letters, digits
+// or underscores replaced by underscores: the secret `db-password` is read from `APP_CONFIG_SECRET_DB_PASSWORD`.
+type EnvResolver struct {
+	// The prefix of the variables, DefaultEnvPrefix if empty.
+	Prefix string
+}
+
+// NewEnvResolver returns an EnvResolver reading the variables starting with prefix.
+func NewEnvResolver(prefix string) *EnvResolver {
+	return &EnvResolver{Prefix: prefix}
+}
+
+// VariableName returns the name of the variable holding the secret of reference.
+func (resolver *EnvResolver) VariableName(reference Reference) string {
+	prefix := resolver.Prefix
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+	return prefix + invalidEnvCharacters.ReplaceAllString(strings.ToUpper(reference.ID), "_")
+}
+
+// ResolveSecret reads the variable of reference.
+func (resolver *EnvResolver) ResolveSecret(ctx context.Context, reference Reference) (Value, error) {
+	name := resolver.VariableName(reference)
+	secret, ok := os.LookupEnv(name)
+	if !ok {
+		return Value{}, fmt.Errorf("secret %s in variable %s: %w", reference, name, ErrNotFound)
+	}
+	return NewValue(secret), nil
+}
+
+// CachingResolver : Caches the secrets returned by another resolver for a fixed time. Errors are not cached. Expired
+// secrets are removed from memory when they are looked up, and by a sweep of the whole cache that ResolveSecret runs
+// at most once per TTL. It is safe for concurrent use.
+type CachingResolver struct {
+	resolver SecretResolver
+	ttl      time.Duration
+	now      func() time.Time
+
+	mutex     sync.Mutex
+	entries   map[Reference]cacheEntry
+	lastSweep time.Time
+}
+
+type cacheEntry struct {
+	value   Value
+	expires time.Time
+}
+
+// NewCachingResolver returns a CachingResolver keeping the secrets returned by resolver for ttl.
+func NewCachingResolver(resolver SecretResolver, ttl time.Duration) *CachingResolver {
+	return &CachingResolver{
+		resolver: resolver,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  map[Reference]cacheEntry{},
+	}
+}
+
+// ResolveSecret returns the cached secret of reference, or resolves it if it is not cached or has expired.
+func (resolver *CachingResolver) ResolveSecret(ctx context.Context, reference Reference) (Value, error) {
+	now := resolver.now()
+	resolver.mutex.Lock()
+	if now.Sub(resolver.lastSweep) >= resolver.ttl {
+		resolver.removeExpired(now)
+	}
+	entry, ok := resolver.entries[reference]
+	if ok && !now.Before(entry.expires) {
+		delete(resolver.entries, reference)
+		ok = false
+	}
+	resolver.mutex.Unlock()
+	if ok {
+		return entry.value, nil
+	}
+
+	value, err := resolver.resolver.ResolveSecret(ctx, reference)
+	if err != nil {
+		return Value{}, err
+	}
+	resolver.mutex.Lock()
+	resolver.entries[reference] = cacheEntry{value: value, expires: resolver.now().Add(resolver.ttl)}
+	resolver.mutex.Unlock()
+	return value, nil
+}
+
+// RemoveExpired removes the expired secrets from the cache.
+func (resolver *CachingResolver) RemoveExpired() {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	resolver.removeExpired(resolver.now())
+}
+
+// removeExpired removes the secrets expired at now. The mutex must be held.
+func (resolver *CachingResolver) removeExpired(now time.Time) {
+	for reference, entry := range resolver.entries {
+		if !now.Before(entry.expires) {
+			delete(resolver.entries, reference)
+		}
+	}
+	resolver.lastSweep = now
+}
+
+// Invalidate removes the secret of reference from the cache.
+func (resolver *CachingResolver) Invalidate(reference Reference) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	delete(resolver.entries, reference)
+}
+
+// Purge empties the cache.
+func (resolver *CachingResolver) Purge() {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	resolver.entries = map[Reference]cacheEntry{}
+}
@@ -0,0 +1,251 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package secrets : Resolution of SECRETREF properties
+// The value of a SECRETREF property is a reference to an IBM Cloud Secrets Manager secret. The helpers of this package
+// parse the references of properties and segment rules and fetch the secrets through a SecretResolver. Resolved
+// secrets are returned as a Value, which redacts itself when printed, logged or marshaled.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrNotFound is returned by resolvers that have no secret for a reference.
+var ErrNotFound = errors.New("secret not found")
+
+// Redacted replaces secret values when they are printed, logged or marshaled.
+const Redacted = "[REDACTED]"
+
+// Reference : A reference to an IBM Cloud Secrets Manager secret, the value of a SECRETREF property.
+type Reference struct {
+	// The ID of the secret.
+	ID string `json:"id"`
+
+	// The type of the secret, for example `kv` or `username_password`.
+	SecretType string `json:"secret_type,omitempty"`
+
+	// The CRN of the Secrets Manager instance holding the secret.
+	SMInstanceCRN string `json:"sm_instance_crn,omitempty"`
+}
+
+// String returns `<secret type>/<id>`, followed by the instance CRN if it is set.
+func (reference Reference) String() string {
+	description := reference.ID
+	if reference.SecretType != "" {
+		description = reference.SecretType + "/" + description
+	}
+	if reference.SMInstanceCRN != "" {
+		description += " of " + reference.SMInstanceCRN
+	}
+	return description
+}
+
+// Value : A resolved secret. The value is only returned by Reveal: formatting, logging and marshaling a Value produce
+// Redacted instead.
+type Value struct {
+	secret string
+}
+
+// NewValue returns a Value holding secret. Resolvers use it to return the secrets they fetched.
+func NewValue(secret string) Value {
+	return Value{secret: secret}
+}
+
+// Reveal returns the secret.
+func (value Value) Reveal() string {
+	return value.secret
+}
+
+// String returns Redacted.
+func (value Value) String() string {
+	return Redacted
+}
+
+// GoString returns Redacted, for the %#v verb.
+func (value Value) GoString() string {
+	return Redacted
+}
+
+// Format writes Redacted whatever the verb.
+func (value Value) Format(state fmt.State, verb rune) {
+	fmt.Fprint(state, Redacted)
+}
+
+// LogValue returns Redacted to log/slog.
+func (value Value) LogValue() slog.Value {
+	return slog.StringValue(Redacted)
+}
+
+// MarshalJSON returns Redacted as a JSON string.
+func (value Value) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Redacted)
+}
+
+// MarshalText returns Redacted.
+func (value Value) MarshalText() ([]byte, error) {
+	return []byte(Redacted), nil
+}
+
+// SecretResolver : Fetches the secrets of references. Implementations must not log or otherwise expose the secrets they
+// return, and should return an error wrapping ErrNotFound for unknown secrets.
+type SecretResolver interface {
+	ResolveSecret(ctx context.Context, reference Reference) (Value, error)
+}
+
+// ResolverFunc : Adapts a function to the SecretResolver interface.
+type ResolverFunc func(ctx context.Context, reference Reference) (Value, error)
+
+// ResolveSecret calls resolverFunc.
+func (resolverFunc ResolverFunc) ResolveSecret(ctx context.Context, reference Reference) (Value, error) {
+	return resolverFunc(ctx, reference)
+}
+
+// ParseReference parses a SECRETREF value: an object with the `id`, `secret_type` and `sm_instance_crn` fields, or
+// the same object encoded as a JSON string.
+func ParseReference(value interface{}) (Reference, error) {
+	return mergeReference(value, nil)
+}
+
+// PropertyReference returns the reference of the value of a SECRETREF property.
+func PropertyReference(property *appconfigurationv1.Property) (Reference, error) {
+	if err := checkSecretRef(property); err != nil {
+		return Reference{}, err
+	}
+	return mergeReference(property.Value, nil)
+}
+
+// SegmentRuleReference returns the reference of the value of a segment rule of a SECRETREF property. Segment rules may
+// only set the secret ID: the other fields then come from the value of the property. The DefaultValue rule value of the
+// evaluator package stands for the value of the property.
+func SegmentRuleReference(property *appconfigurationv1.Property, rule *appconfigurationv1.SegmentRule) (Reference, error) {
+	if err := checkSecretRef(property); err != nil {
+		return Reference{}, err
+	}
+	return ValueReference(property, rule.Value)
+}
+
+// ValueReference returns the reference of value, the value of property or of one of its segment rules, as returned
+// by evaluator.EvaluateProperty. The fields missing from value come from the value of the property.
+func ValueReference(property *appconfigurationv1.Property, value interface{}) (Reference, error) {
+	if text, ok := value.(string); ok && text == evaluator.DefaultValue {
+		value = property.Value
+	}
+	return mergeReference(value, property.Value)
+}
+
+// ResolveProperty resolves the value of a SECRETREF property.
+func ResolveProperty(ctx context.Context, resolver SecretResolver, property *appconfigurationv1.Property) (Value, error) {
+	reference, err := PropertyReference(property)
+	if err != nil {
+		return Value{}, err
+	}
+	return resolve(ctx, resolver, reference)
+}
+
+// ResolveSegmentRule resolves the value of a segment rule of a SECRETREF property.
+func ResolveSegmentRule(ctx context.Context, resolver SecretResolver, property *appconfigurationv1.Property, rule *appconfigurationv1.SegmentRule) (Value, error) {
+	reference, err := SegmentRuleReference(property, rule)
+	if err != nil {
+		return Value{}, err
+	}
+	return resolve(ctx, resolver, reference)
+}
+
+// ResolveValue resolves value, the value of a SECRETREF property evaluated for an entity.
+func ResolveValue(ctx context.Context, resolver SecretResolver, property *appconfigurationv1.Property, value interface{}) (Value, error) {
+	if err := checkSecretRef(property); err != nil {
+		return Value{}, err
+	}
+	reference, err := ValueReference(property, value)
+	if err != nil {
+		return Value{}, err
+	}
+	return resolve(ctx, resolver, reference)
+}
+
+func resolve(ctx context.Context, resolver SecretResolver, reference Reference) (Value, error) {
+	value, err := resolver.ResolveSecret(ctx, reference)
+	if err != nil {
+		return Value{}, core.SDKErrorf(err, fmt.Sprintf("unable to resolve secret %s", reference), "resolve-secret-error", common.GetComponentInfo())
+	}
+	return value, nil
+}
+
+func checkSecretRef(property *appconfigurationv1.Property) error {
+	if property.Type == nil || *property.Type != appconfigurationv1.Property_Type_Secretref {
+		propertyID := ""
+		if property.PropertyID != nil {
+			propertyID = *property.PropertyID
+		}
+		return core.SDKErrorf(nil, fmt.Sprintf("property '%s' is not a SECRETREF property", propertyID), "not-secret-reference", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// mergeReference parses value, taking the fields it does not set from fallback.
+func mergeReference(value interface{}, fallback interface{}) (Reference, error) {
+	reference, err := referenceFields(value)
+	if err != nil {
+		return Reference{}, err
+	}
+	if fallback != nil {
+		defaults, err := referenceFields(fallback)
+		if err != nil {
+			return Reference{}, err
+		}
+		if reference.SecretType == "" {
+			reference.SecretType = defaults.SecretType
+		}
+		if reference.SMInstanceCRN == "" {
+			reference.SMInstanceCRN = defaults.SMInstanceCRN
+		}
+	}
+	if reference.ID == "" {
+		return Reference{}, core.SDKErrorf(nil, "the secret reference has no ID", "invalid-secret-reference", common.GetComponentInfo())
+	}
+	return reference, nil
+}
+
+func referenceFields(value interface{}) (Reference, error) {
+	var fields map[string]interface{}
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		fields = typed
+	case string:
+		if err := json.Unmarshal([]byte(typed), &fields); err != nil {
+			return Reference{}, core.SDKErrorf(err, "the secret reference is not a JSON object", "invalid-secret-reference", common.GetComponentInfo())
+		}
+	case Reference:
+		return typed, nil
+	default:
+		return Reference{}, core.SDKErrorf(nil, fmt.Sprintf("the secret reference is a %T, not an object", value), "invalid-secret-reference", common.GetComponentInfo())
+	}
+	field := func(key string) string {
+		text, _ := fields[key].(string)
+		return text
+	}
+	return Reference{ID: field("id"), SecretType: field("secret_type"), SMInstanceCRN: field("sm_instance_crn")}, nil
+}
@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func secretProperty() *appconfigurationv1.Property {
+	return &appconfigurationv1.Property{
+		PropertyID: core.StringPtr("db-password"),
+		Type:       core.StringPtr(appconfigurationv1.Property_Type_Secretref),
+		Value:      map[string]interface{}{"id": "abc-1", "secret_type": "kv", "sm_instance_crn": "crn:v1:sm"},
+		SegmentRules: []appconfigurationv1.SegmentRule{
+			{Value: map[string]interface{}{"id": "abc-2"}},
+			{Value: "$default"},
+		},
+	}
+}
+
+func TestReferences(t *testing.T) {
+	property := secretProperty()
+	reference, err := PropertyReference(property)
+	require.Nil(t, err)
+	assert.Equal(t, Reference{ID: "abc-1", SecretType: "kv", SMInstanceCRN: "crn:v1:sm"}, reference)
+	assert.Equal(t, "kv/abc-1 of crn:v1:sm", reference.String())
+
+	reference, err = SegmentRuleReference(property, &property.SegmentRules[0])
+	require.Nil(t, err)
+	assert.Equal(t, Reference{ID: "abc-2", SecretType: "kv", SMInstanceCRN: "crn:v1:sm"}, reference)
+	reference, err = SegmentRuleReference(property, &property.SegmentRules[1])
+	require.Nil(t, err)
+	assert.Equal(t, "abc-1", reference.ID)
+
+	reference, err = ParseReference(`{"id": "abc-3", "secret_type": "arbitrary"}`)
+	require.Nil(t, err)
+	assert.Equal(t, Reference{ID: "abc-3", SecretType: "arbitrary"}, reference)
+
+	_, err = ParseReference(map[string]interface{}{"secret_type": "kv"})
+	assert.NotNil(t, err)
+	_, err = ParseReference(42.0)
+	assert.NotNil(t, err)
+	_, err = PropertyReference(&appconfigurationv1.Property{PropertyID: core.StringPtr("host"), Type: core.StringPtr("STRING"), Value: "db"})
+	assert.NotNil(t, err)
+}
+
+func TestValueIsRedacted(t *testing.T) {
+	value := NewValue("hunter2")
+	assert.Equal(t, "hunter2", value.Reveal())
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%d"} {
+		assert.Equal(t, Redacted, fmt.Sprintf(format, value), format)
+	}
+	assert.NotContains(t, fmt.Sprintf("%+v", struct{ Password Value }{value}), "hunter2")
+
+	content, err := json.Marshal(map[string]Value{"password": value})
+	require.Nil(t, err)
+	assert.Equal(t, `{"password":"[REDACTED]"}`, string(content))
+
+	var buffer bytes.Buffer
+	slog.New(slog.NewTextHandler(&buffer, nil)).Info("resolved", "password", value)
+	assert.NotContains(t, buffer.String(), "hunter2")
+	assert.Contains(t, buffer.String(), "password=[REDACTED]")
+}
+
+func TestFileResolver(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.Mkdir(filepath.Join(dir, "kv"), 0700))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "kv", "abc-1"), []byte("typed\n"), 0600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "abc-2"), []byte("untyped\r\n"), 0600))
+	resolver := NewFileResolver(dir)
+	property := secretProperty()
+
+	value, err := ResolveProperty(context.Background(), resolver, property)
+	require.Nil(t, err)
+	assert.Equal(t, "typed", value.Reveal())
+	value, err = ResolveSegmentRule(context.Background(), resolver, property, &property.SegmentRules[0])
+	require.Nil(t, err)
+	assert.Equal(t, "untyped", value.Reveal())
+
+	_, err = ResolveValue(context.Background(), resolver, property, map[string]interface{}{"id": "abc-3"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+	_, err = resolver.ResolveSecret(context.Background(), Reference{ID: "../abc-2"})
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, ErrNotFound))
+}
+
+func TestEnvResolver(t *testing.T) {
+	t.Setenv("APP_CONFIG_SECRET_DB_PASSWORD", "from-env")
+	resolver := NewEnvResolver("")
+	assert.Equal(t, "APP_CONFIG_SECRET_DB_PASSWORD", resolver.VariableName(Reference{ID: "db-password"}))
+
+	value, err := resolver.ResolveSecret(context.Background(), Reference{ID: "db-password"})
+	require.Nil(t, err)
+	assert.Equal(t, "from-env", value.Reveal())
+	_, err = NewEnvResolver("OTHER_").ResolveSecret(context.Background(), Reference{ID: "db-password"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestCachingResolver(t *testing.T) {
+	calls := 0
+	fail := false
+	resolver := NewCachingResolver(ResolverFunc(func(ctx context.Context, reference Reference) (Value, error) {
+		calls++
+		if fail {
+			return Value{}, ErrNotFound
+		}
+		return NewValue(fmt.Sprintf("%s-%d", reference.ID, calls)), nil
+	}), time.Minute)
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+	reference := Reference{ID: "abc-1"}
+
+	value, err := resolver.ResolveSecret(context.Background(), reference)
+	require.Nil(t, err)
+	assert.Equal(t, "abc-1-1", value.Reveal())
+	now = now.Add(59 * time.Second)
+	value, _ = resolver.ResolveSecret(context.Background(), reference)
+	assert.Equal(t, "abc-1-1", value.Reveal())
+
+	now = now.Add(time.Second)
+	value, _ = resolver.ResolveSecret(context.Background(), reference)
+	assert.Equal(t, "abc-1-2", value.Reveal())
+	resolver.Invalidate(reference)
+	value, _ = resolver.ResolveSecret(context.Background(), reference)
+	assert.Equal(t, "abc-1-3", value.Reveal())
+
+	resolver.Purge()
+	fail = true
+	_, err = resolver.ResolveSecret(context.Background(), reference)
+	assert.NotNil(t, err)
+	fail = false
+	value, _ = resolver.ResolveSecret(context.Background(), reference)
+	assert.Equal(t, "abc-1-5", value.Reveal())
+	assert.Equal(t, 5, calls)
+}
+
+func TestCachingResolverRemovesExpiredSecrets(t *testing.T) {
+	resolver := NewCachingResolver(ResolverFunc(func(ctx context.Context, reference Reference) (Value, error) {
+		if reference.ID == "missing" {
+			return Value{}, ErrNotFound
+		}
+		return NewValue("secret-" + reference.ID), nil
+	}), time.Minute)
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+
+	for _, id := range []string{"a", "b", "c"} {
+		_, err := resolver.ResolveSecret(context.Background(), Reference{ID: id})
+		require.Nil(t, err)
+	}
+	assert.Len(t, resolver.entries, 3)
+
+	// A failed lookup of an expired secret leaves no plaintext behind.
+	now = now.Add(30 * time.Second)
+	_, err := resolver.ResolveSecret(context.Background(), Reference{ID: "d"})
+	require.Nil(t, err)
+	now = now.Add(31 * time.Second)
+	_, err = resolver.ResolveSecret(context.Background(), Reference{ID: "missing"})
+	assert.NotNil(t, err)
+	assert.Equal(t, []Reference{{ID: "d"}}, slices.Collect(maps.Keys(resolver.entries)))
+
+	now = now.Add(time.Minute)
+	resolver.RemoveExpired()
+	assert.Empty(t, resolver.entries)
+}
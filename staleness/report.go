@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package staleness
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Format : An output format of a Report.
+type Format string
+
+const (
+	// FormatCSV writes a row per finding and group, see WriteCSV.
+	FormatCSV Format = "csv"
+
+	// FormatJSON writes the findings grouped by collection and by tag, see WriteJSON.
+	FormatJSON Format = "json"
+
+	// FormatMarkdown writes a table per collection and per tag, see WriteMarkdown.
+	FormatMarkdown Format = "markdown"
+)
+
+// Groupings of the findings of a report.
+const (
+	GroupByCollection = "collection"
+	GroupByTag        = "tag"
+)
+
+// Group : The findings of a collection or a tag. The findings of the flags without collection or without tag are in
+// a group whose key is empty.
+type Group struct {
+	Key  string `json:"key"`
+	Name string `json:"name,omitempty"`
+
+	Findings []Finding `json:"findings"`
+}
+
+// ByCollection returns the findings grouped by collection, sorted by collection ID, with the group of the flags
+// without collection last. A flag in several collections is in each of their groups.
+func (report *Report) ByCollection() []Group {
+	return report.group(func(finding Finding) []Group {
+		groups := make([]Group, len(finding.Collections))
+		for i, collection := range finding.Collections {
+			groups[i] = Group{Key: collection.ID, Name: collection.Name}
+		}
+		return groups
+	})
+}
+
+// ByTag returns the findings grouped by tag, sorted by tag, with the group of the flags without tag last. A flag
+// with several tags is in each of their groups.
+func (report *Report) ByTag() []Group {
+	return report.group(func(finding Finding) []Group {
+		groups := make([]Group, len(finding.Tags))
+		for i, tag := range finding.Tags {
+			groups[i] = Group{Key: tag}
+		}
+		return groups
+	})
+}
+
+func (report *Report) group(groupsOf func(Finding) []Group) []Group {
+	groups := map[string]*Group{}
+	for _, finding := range report.Findings {
+		keys := groupsOf(finding)
+		if len(keys) == 0 {
+			keys = []Group{{}}
+		}
+		for _, key := range keys {
+			if groups[key.Key] == nil {
+				groups[key.Key] = &Group{Key: key.Key, Name: key.Name}
+			}
+			groups[key.Key].Findings = append(groups[key.Key].Findings, finding)
+		}
+	}
+	sorted := make([]Group, 0, len(groups))
+	for _, group := range groups {
+		sorted = append(sorted, *group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if (sorted[i].Key == "") != (sorted[j].Key == "") {
+			return sorted[j].Key == ""
+		}
+		return sorted[i].Key < sorted[j].Key
+	})
+	return sorted
+}
+
+// Write writes the report to writer in format.
+func (report *Report) Write(writer io.Writer, format Format) error {
+	switch format {
+	case FormatCSV:
+		return report.WriteCSV(writer)
+	case FormatJSON:
+		return report.WriteJSON(writer)
+	case FormatMarkdown:
+		return report.WriteMarkdown(writer)
+	}
+	return core.SDKErrorf(nil, fmt.Sprintf("unknown format '%s'", format), "unknown-format", common.GetComponentInfo())
+}
+
+// WriteCSV writes one row per finding and group, under a header row. The grouping column is one of the GroupBy*
+// constants, and lists separate their values with semicolons.
+func (report *Report) WriteCSV(writer io.Writer) error {
+	rows := csv.NewWriter(writer)
+	rows.Write([]string{"grouping", "group", "feature_id", "name", "reason", "detail", "environments", "collections", "tags", "last_evaluated", "last_updated"})
+	for _, grouping := range []struct {
+		name   string
+		groups []Group
+	}{{GroupByCollection, report.ByCollection()}, {GroupByTag, report.ByTag()}} {
+		for _, group := range grouping.groups {
+			for _, finding := range group.Findings {
+				rows.Write([]string{grouping.name, group.Key, finding.FeatureID, finding.Name, string(finding.Reason), finding.Detail,
+					strings.Join(finding.Environments, ";"), strings.Join(collectionIDs(finding.Collections), ";"), strings.Join(finding.Tags, ";"),
+					formatTime(finding.LastEvaluated), formatTime(finding.LastUpdated)})
+			}
+		}
+	}
+	rows.Flush()
+	if err := rows.Error(); err != nil {
+		return core.SDKErrorf(err, "", "write-report-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteJSON writes the report with its findings grouped by collection and by tag.
+func (report *Report) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(struct {
+		GeneratedAt  time.Time `json:"generated_at"`
+		Environments []string  `json:"environments"`
+		ByCollection []Group   `json:"by_collection"`
+		ByTag        []Group   `json:"by_tag"`
+	}{report.GeneratedAt, report.Environments, report.ByCollection(), report.ByTag()})
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-report-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteMarkdown writes the report as a Markdown document with a table per collection and per tag.
+func (report *Report) WriteMarkdown(writer io.Writer) error {
+	var builder strings.Builder
+	flags := map[string]bool{}
+	for _, finding := range report.Findings {
+		flags[finding.FeatureID] = true
+	}
+	fmt.Fprintf(&builder, "# Stale feature flags\n\n%d findings for %d flags in %d environments (%s), generated at %s.\n",
+		len(report.Findings), len(flags), len(report.Environments), strings.Join(report.Environments, ", "), report.GeneratedAt.UTC().Format(time.RFC3339))
+	for _, grouping := range []struct {
+		title  string
+		none   string
+		groups []Group
+	}{{"By collection", "Without collection", report.ByCollection()}, {"By tag", "Without tag", report.ByTag()}} {
+		fmt.Fprintf(&builder, "\n## %s\n", grouping.title)
+		if len(grouping.groups) == 0 {
+			builder.WriteString("\nNo findings.\n")
+		}
+		for _, group := range grouping.groups {
+			switch {
+			case group.Key == "":
+				fmt.Fprintf(&builder, "\n### %s\n\n", grouping.none)
+			case group.Name != "":
+				fmt.Fprintf(&builder, "\n### %s (`%s`)\n\n", markdownCell(group.Name), group.Key)
+			default:
+				fmt.Fprintf(&builder, "\n### `%s`\n\n", group.Key)
+			}
+			builder.WriteString("| Flag | Reason | Detail | Environments | Last evaluated |\n| --- | --- | --- | --- | --- |\n")
+			for _, finding := range group.Findings {
+				lastEvaluated := "never"
+				if finding.LastEvaluated != nil {
+					lastEvaluated = finding.LastEvaluated.UTC().Format(time.DateOnly)
+				}
+				fmt.Fprintf(&builder, "| %s (`%s`) | %s | %s | %s | %s |\n", markdownCell(finding.Name), finding.FeatureID, finding.Reason,
+					markdownCell(finding.Detail), strings.Join(finding.Environments, ", "), lastEvaluated)
+			}
+		}
+	}
+	if _, err := io.WriteString(writer, builder.String()); err != nil {
+		return core.SDKErrorf(err, "", "write-report-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func collectionIDs(collections []Collection) []string {
+	ids := make([]string, len(collections))
+	for i, collection := range collections {
+		ids[i] = collection.ID
+	}
+	return ids
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+// markdownCell escapes the characters of value that would break a table row.
+func markdownCell(value string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(value)
+}
@@ -0,0 +1,305 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package staleness : Cleanup candidates among the feature flags of an instance
+// An Analyzer lists the feature flags of every environment of an instance and reports the flags that are no longer
+// evaluated, the flags that have been fully rolled out everywhere for a while, and the flags whose enabled and
+// disabled values are the same. The Report groups the findings by collection and by tag, and is written as CSV, JSON
+// or Markdown.
+package staleness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// Reason : Why a feature flag is a cleanup candidate.
+type Reason string
+
+const (
+	// ReasonNotEvaluated is reported for a flag that no environment evaluated for Options.NotEvaluatedDays, or ever.
+	ReasonNotEvaluated Reason = "not_evaluated"
+
+	// ReasonFullyRolledOut is reported for a flag that is enabled, rolled out to 100% and without segment rules in
+	// every environment, and was not updated for Options.RolledOutDays.
+	ReasonFullyRolledOut Reason = "fully_rolled_out"
+
+	// ReasonSameValues is reported for a flag whose enabled and disabled values are the same in some environment.
+	ReasonSameValues Reason = "same_values"
+)
+
+// Options : The thresholds of an Analyzer.
+type Options struct {
+	// Report the flags that were not evaluated for this many days. 30 if zero.
+	NotEvaluatedDays int
+
+	// Report the flags that have been fully rolled out for this many days. 30 if zero.
+	RolledOutDays int
+}
+
+// Finding : A flag that is a cleanup candidate.
+type Finding struct {
+	FeatureID string `json:"feature_id"`
+	Name      string `json:"name"`
+	Reason    Reason `json:"reason"`
+
+	// Describes the finding, for example `last evaluated 45 days ago`.
+	Detail string `json:"detail"`
+
+	// The environments the finding applies to, sorted.
+	Environments []string `json:"environments"`
+
+	// The collections and tags of the flag in any environment, sorted.
+	Collections []Collection `json:"collections"`
+	Tags        []string     `json:"tags"`
+
+	// The last evaluation of the flag in any environment, nil if it was never evaluated.
+	LastEvaluated *time.Time `json:"last_evaluated,omitempty"`
+
+	// The last update of the flag in any environment.
+	LastUpdated *time.Time `json:"last_updated,omitempty"`
+}
+
+// Collection : A collection of a flag.
+type Collection struct {
+	ID   string `json:"collection_id"`
+	Name string `json:"name,omitempty"`
+}
+
+// Report : The findings of an analysis.
+type Report struct {
+	GeneratedAt  time.Time `json:"generated_at"`
+	Environments []string  `json:"environments"`
+
+	// The findings, sorted by feature ID and reason. A flag has at most one finding per reason.
+	Findings []Finding `json:"findings"`
+}
+
+// Analyzer : Finds the cleanup candidates among the feature flags of an instance.
+type Analyzer struct {
+	client  *appconfigurationv1.AppConfigurationV1
+	options Options
+	now     func() time.Time
+}
+
+// NewAnalyzer returns an analyzer of the instance of client. options may be nil for the defaults.
+func NewAnalyzer(client *appconfigurationv1.AppConfigurationV1, options *Options) (*Analyzer, error) {
+	if client == nil {
+		return nil, core.SDKErrorf(nil, "a client is required", "missing-client", common.GetComponentInfo())
+	}
+	analyzer := &Analyzer{client: client, now: time.Now}
+	if options != nil {
+		analyzer.options = *options
+	}
+	if analyzer.options.NotEvaluatedDays < 0 || analyzer.options.RolledOutDays < 0 {
+		return nil, core.SDKErrorf(nil, "the thresholds cannot be negative", "invalid-options", common.GetComponentInfo())
+	}
+	if analyzer.options.NotEvaluatedDays == 0 {
+		analyzer.options.NotEvaluatedDays = 30
+	}
+	if analyzer.options.RolledOutDays == 0 {
+		analyzer.options.RolledOutDays = 30
+	}
+	return analyzer, nil
+}
+
+// Analyze lists the feature flags of every environment and reports the cleanup candidates.
+func (analyzer *Analyzer) Analyze() (*Report, error) {
+	return analyzer.AnalyzeWithContext(context.Background())
+}
+
+// AnalyzeWithContext is an alternate form of the Analyze method which supports a Context parameter
+func (analyzer *Analyzer) AnalyzeWithContext(ctx context.Context) (*Report, error) {
+	environmentsPager, err := analyzer.client.NewEnvironmentsPager(&appconfigurationv1.ListEnvironmentsOptions{Expand: core.BoolPtr(true)})
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-environments-error", common.GetComponentInfo())
+	}
+	environments, err := environmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "list-environments-error", common.GetComponentInfo())
+	}
+	features := map[string][]appconfigurationv1.Feature{}
+	for _, environment := range environments {
+		environmentID := *environment.EnvironmentID
+		featuresPager, err := analyzer.client.NewFeaturesPager(&appconfigurationv1.ListFeaturesOptions{
+			EnvironmentID: environment.EnvironmentID,
+			Expand:        core.BoolPtr(true),
+			Include:       []string{appconfigurationv1.ListFeaturesOptions_Include_Collections, appconfigurationv1.ListFeaturesOptions_Include_Rules},
+		})
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "list-features-error", common.GetComponentInfo())
+		}
+		features[environmentID], err = featuresPager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("could not list the features of environment '%s'", environmentID), "list-features-error", common.GetComponentInfo())
+		}
+	}
+	return analyzer.Report(features), nil
+}
+
+// Report analyzes the feature flags of each environment, by environment ID.
+func (analyzer *Analyzer) Report(features map[string][]appconfigurationv1.Feature) *Report {
+	now := analyzer.now()
+	report := &Report{GeneratedAt: now, Environments: []string{}, Findings: []Finding{}}
+
+	// The flags by feature ID, and their state in each environment.
+	flags := map[string]map[string]*appconfigurationv1.Feature{}
+	for environmentID, environmentFeatures := range features {
+		report.Environments = append(report.Environments, environmentID)
+		for i := range environmentFeatures {
+			feature := &environmentFeatures[i]
+			if feature.FeatureID == nil {
+				continue
+			}
+			if flags[*feature.FeatureID] == nil {
+				flags[*feature.FeatureID] = map[string]*appconfigurationv1.Feature{}
+			}
+			flags[*feature.FeatureID][environmentID] = feature
+		}
+	}
+	sort.Strings(report.Environments)
+
+	for _, featureID := range sortedKeys(flags) {
+		report.Findings = append(report.Findings, analyzer.analyze(featureID, flags[featureID], now)...)
+	}
+	return report
+}
+
+// analyze returns the findings of the flag featureID, given its state in each environment.
+func (analyzer *Analyzer) analyze(featureID string, environments map[string]*appconfigurationv1.Feature, now time.Time) []Finding {
+	base := Finding{FeatureID: featureID, Collections: []Collection{}, Tags: []string{}}
+	var created *time.Time
+	rolledOut := true
+	sameValues := []string{}
+	for _, environmentID := range sortedKeys(environments) {
+		feature := environments[environmentID]
+		if base.Name == "" && feature.Name != nil {
+			base.Name = *feature.Name
+		}
+		for _, collection := range feature.Collections {
+			if collection.CollectionID != nil && !slices.ContainsFunc(base.Collections, func(known Collection) bool { return known.ID == *collection.CollectionID }) {
+				base.Collections = append(base.Collections, Collection{ID: *collection.CollectionID, Name: stringValue(collection.Name)})
+			}
+		}
+		if feature.Tags != nil {
+			for _, tag := range strings.Split(*feature.Tags, ",") {
+				if tag = strings.TrimSpace(tag); tag != "" && !slices.Contains(base.Tags, tag) {
+					base.Tags = append(base.Tags, tag)
+				}
+			}
+		}
+		base.LastEvaluated = latest(base.LastEvaluated, feature.EvaluationTime)
+		base.LastUpdated = latest(base.LastUpdated, feature.UpdatedTime)
+		created = earliest(created, feature.CreatedTime)
+
+		if feature.Enabled == nil || !*feature.Enabled || (feature.RolloutPercentage != nil && *feature.RolloutPercentage != 100) || len(feature.SegmentRules) > 0 {
+			rolledOut = false
+		}
+		if sameJSON(feature.EnabledValue, feature.DisabledValue) {
+			sameValues = append(sameValues, environmentID)
+		}
+	}
+	sort.Slice(base.Collections, func(i, j int) bool { return base.Collections[i].ID < base.Collections[j].ID })
+	sort.Strings(base.Tags)
+	allEnvironments := sortedKeys(environments)
+
+	findings := []Finding{}
+	finding := func(reason Reason, environments []string, detail string) {
+		found := base
+		found.Reason, found.Environments, found.Detail = reason, environments, detail
+		findings = append(findings, found)
+	}
+	notEvaluatedSince := now.AddDate(0, 0, -analyzer.options.NotEvaluatedDays)
+	switch {
+	case base.LastEvaluated == nil && (created == nil || created.Before(notEvaluatedSince)):
+		finding(ReasonNotEvaluated, allEnvironments, "never evaluated")
+	case base.LastEvaluated != nil && base.LastEvaluated.Before(notEvaluatedSince):
+		finding(ReasonNotEvaluated, allEnvironments, fmt.Sprintf("last evaluated %d days ago", days(now.Sub(*base.LastEvaluated))))
+	}
+	if rolledOut && base.LastUpdated != nil && base.LastUpdated.Before(now.AddDate(0, 0, -analyzer.options.RolledOutDays)) {
+		finding(ReasonFullyRolledOut, allEnvironments, fmt.Sprintf("enabled for everyone in every environment, unchanged for %d days", days(now.Sub(*base.LastUpdated))))
+	}
+	if len(sameValues) > 0 {
+		value, _ := json.Marshal(environments[sameValues[0]].EnabledValue)
+		finding(ReasonSameValues, sameValues, fmt.Sprintf("enabled and disabled values are both %s", value))
+	}
+	return findings
+}
+
+func latest(current *time.Time, value *strfmt.DateTime) *time.Time {
+	if value == nil {
+		return current
+	}
+	candidate := time.Time(*value)
+	if current == nil || candidate.After(*current) {
+		return &candidate
+	}
+	return current
+}
+
+func earliest(current *time.Time, value *strfmt.DateTime) *time.Time {
+	if value == nil {
+		return current
+	}
+	candidate := time.Time(*value)
+	if current == nil || candidate.Before(*current) {
+		return &candidate
+	}
+	return current
+}
+
+func days(duration time.Duration) int {
+	return int(duration.Hours() / 24)
+}
+
+// sameJSON reports whether the values of a flag are the same once encoded, so that 1 and 1.0 are equal.
+func sameJSON(a interface{}, b interface{}) bool {
+	var values [2]interface{}
+	for i, value := range []interface{}{a, b} {
+		data, err := json.Marshal(value)
+		if err != nil || json.Unmarshal(data, &values[i]) != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(values[0], values[1])
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,165 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package staleness
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var monday = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+// The features of the fake instance, by environment:
+//   - unused was created long ago and never evaluated;
+//   - launched is fully rolled out everywhere since January;
+//   - partial is fully rolled out in prod only;
+//   - constant has the same values in dev;
+//   - fresh was created two days ago and not evaluated yet.
+var testFeatures = map[string]string{
+	"dev": `
+		{"name": "Unused", "feature_id": "unused", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false,
+			"tags": "cleanup", "collections": [{"collection_id": "web", "name": "Web | shop"}],
+			"created_time": "2025-06-01T00:00:00Z", "updated_time": "2025-06-01T00:00:00Z"},
+		{"name": "Launched", "feature_id": "launched", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true,
+			"rollout_percentage": 100, "tags": "web, checkout", "collections": [{"collection_id": "web", "name": "Web | shop"}],
+			"created_time": "2025-12-01T00:00:00Z", "updated_time": "2026-01-01T00:00:00Z", "evaluation_time": "2026-03-01T00:00:00Z"},
+		{"name": "Partial", "feature_id": "partial", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false,
+			"created_time": "2025-12-01T00:00:00Z", "updated_time": "2026-01-01T00:00:00Z", "evaluation_time": "2026-03-01T00:00:00Z"},
+		{"name": "Constant", "feature_id": "constant", "type": "NUMERIC", "enabled_value": 5, "disabled_value": 5.0, "enabled": false,
+			"tags": "checkout", "created_time": "2026-02-01T00:00:00Z", "updated_time": "2026-02-01T00:00:00Z", "evaluation_time": "2026-03-01T00:00:00Z"},
+		{"name": "Fresh", "feature_id": "fresh", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false,
+			"created_time": "2026-02-28T00:00:00Z", "updated_time": "2026-02-28T00:00:00Z"}`,
+	"prod": `
+		{"name": "Unused", "feature_id": "unused", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false,
+			"created_time": "2025-06-01T00:00:00Z", "updated_time": "2025-06-01T00:00:00Z"},
+		{"name": "Launched", "feature_id": "launched", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true,
+			"created_time": "2025-12-01T00:00:00Z", "updated_time": "2025-12-15T00:00:00Z", "evaluation_time": "2026-02-01T00:00:00Z"},
+		{"name": "Partial", "feature_id": "partial", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true,
+			"created_time": "2025-12-01T00:00:00Z", "updated_time": "2026-01-01T00:00:00Z", "evaluation_time": "2026-03-01T00:00:00Z"},
+		{"name": "Constant", "feature_id": "constant", "type": "NUMERIC", "enabled_value": 5, "disabled_value": 0, "enabled": true,
+			"segment_rules": [{"rules": [{"segments": ["beta"]}], "value": 5, "order": 1}],
+			"created_time": "2026-02-01T00:00:00Z", "updated_time": "2026-02-01T00:00:00Z", "evaluation_time": "2026-03-01T00:00:00Z"},
+		{"name": "Fresh", "feature_id": "fresh", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false,
+			"created_time": "2026-02-28T00:00:00Z", "updated_time": "2026-02-28T00:00:00Z"}`,
+}
+
+func newTestReport(t *testing.T) *Report {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.Path {
+		case "/environments":
+			fmt.Fprint(res, `{"environments": [{"name": "Dev", "environment_id": "dev"}, {"name": "Prod", "environment_id": "prod"}], "total_count": 2}`)
+		case "/environments/dev/features", "/environments/prod/features":
+			assert.Equal(t, "collections,rules", req.URL.Query().Get("include"))
+			fmt.Fprintf(res, `{"features": [%s], "total_count": 5}`, testFeatures[req.URL.Path[len("/environments/"):len(req.URL.Path)-len("/features")]])
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	analyzer, err := NewAnalyzer(client, &Options{NotEvaluatedDays: 14})
+	require.Nil(t, err)
+	analyzer.now = func() time.Time { return monday }
+	report, err := analyzer.Analyze()
+	require.Nil(t, err)
+	return report
+}
+
+func TestAnalyzeFindsStaleFlags(t *testing.T) {
+	report := newTestReport(t)
+	assert.Equal(t, []string{"dev", "prod"}, report.Environments)
+
+	findings := []string{}
+	for _, finding := range report.Findings {
+		findings = append(findings, fmt.Sprintf("%s %s %v: %s", finding.FeatureID, finding.Reason, finding.Environments, finding.Detail))
+	}
+	assert.Equal(t, []string{
+		"constant same_values [dev]: enabled and disabled values are both 5",
+		"launched fully_rolled_out [dev prod]: enabled for everyone in every environment, unchanged for 60 days",
+		"unused not_evaluated [dev prod]: never evaluated",
+	}, findings)
+
+	launched := report.Findings[1]
+	assert.Equal(t, []Collection{{ID: "web", Name: "Web | shop"}}, launched.Collections)
+	assert.Equal(t, []string{"checkout", "web"}, launched.Tags)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), *launched.LastEvaluated)
+	assert.Nil(t, report.Findings[2].LastEvaluated)
+}
+
+func TestReportGroupsFindings(t *testing.T) {
+	report := newTestReport(t)
+	keys := func(groups []Group) []string {
+		keys := []string{}
+		for _, group := range groups {
+			keys = append(keys, fmt.Sprintf("%s:%d", group.Key, len(group.Findings)))
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"web:2", ":1"}, keys(report.ByCollection()))
+	assert.Equal(t, []string{"checkout:2", "cleanup:1", "web:1"}, keys(report.ByTag()))
+}
+
+func TestReportFormats(t *testing.T) {
+	report := newTestReport(t)
+
+	var output bytes.Buffer
+	require.Nil(t, report.Write(&output, FormatCSV))
+	rows, err := csv.NewReader(&output).ReadAll()
+	require.Nil(t, err)
+	require.Len(t, rows, 8)
+	assert.Equal(t, "grouping", rows[0][0])
+	assert.Equal(t, []string{"collection", "web", "launched", "Launched", "fully_rolled_out",
+		"enabled for everyone in every environment, unchanged for 60 days", "dev;prod", "web", "checkout;web", "2026-03-01T00:00:00Z", "2026-01-01T00:00:00Z"}, rows[1])
+	assert.Equal(t, []string{"tag", "cleanup", "unused"}, rows[6][:3])
+
+	output.Reset()
+	require.Nil(t, report.Write(&output, FormatJSON))
+	decoded := struct {
+		ByCollection []Group `json:"by_collection"`
+		ByTag        []Group `json:"by_tag"`
+	}{}
+	require.Nil(t, json.Unmarshal(output.Bytes(), &decoded))
+	assert.Equal(t, "Web | shop", decoded.ByCollection[0].Name)
+	assert.Len(t, decoded.ByTag, 3)
+
+	output.Reset()
+	require.Nil(t, report.Write(&output, FormatMarkdown))
+	markdown := output.String()
+	assert.Contains(t, markdown, "3 findings for 3 flags in 2 environments (dev, prod), generated at 2026-03-02T09:00:00Z.")
+	assert.Contains(t, markdown, "\n### Web \\| shop (`web`)\n")
+	assert.Contains(t, markdown, "\n### Without collection\n")
+	assert.Contains(t, markdown, "| Unused (`unused`) | not_evaluated | never evaluated | dev, prod | never |\n")
+
+	assert.NotNil(t, report.Write(&output, "xml"))
+}
@@ -0,0 +1,108 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package terraform
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// hclBody : The attributes and nested blocks of an HCL block, written in the layout of `terraform fmt`.
+type hclBody struct {
+	items []bodyItem
+}
+
+type bodyItem struct {
+	name  string
+	value string
+	block *hclBody
+}
+
+// attribute adds an attribute whose value is an HCL expression.
+func (body *hclBody) attribute(name string, expression string) {
+	body.items = append(body.items, bodyItem{name: name, value: expression})
+}
+
+// block adds a nested block and returns its body. The header is the block type followed by its labels.
+func (body *hclBody) block(header string) *hclBody {
+	nested := &hclBody{}
+	body.items = append(body.items, bodyItem{name: header, block: nested})
+	return nested
+}
+
+// write writes the items, aligning the `=` of consecutive attributes and separating blocks with blank lines.
+func (body *hclBody) write(builder *strings.Builder, indent int) {
+	prefix := strings.Repeat("  ", indent)
+	for i := 0; i < len(body.items); {
+		item := body.items[i]
+		if item.block != nil {
+			if i > 0 {
+				builder.WriteString("\n")
+			}
+			builder.WriteString(prefix + item.name + " {\n")
+			item.block.write(builder, indent+1)
+			builder.WriteString(prefix + "}\n")
+			i++
+			continue
+		}
+
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		end, width := i, 0
+		for ; end < len(body.items) && body.items[end].block == nil; end++ {
+			if len(body.items[end].name) > width {
+				width = len(body.items[end].name)
+			}
+		}
+		for ; i < end; i++ {
+			name := body.items[i].name
+			builder.WriteString(prefix + name + strings.Repeat(" ", width-len(name)) + " = " + body.items[i].value + "\n")
+		}
+	}
+}
+
+// quote returns an HCL string literal. Template sequences are escaped so that the value is taken literally.
+func quote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "${", "$${", "%{", "%%{")
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// list returns an HCL tuple of expressions.
+func list(expressions []string) string {
+	return "[" + strings.Join(expressions, ", ") + "]"
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// names : Allocates unique Terraform resource names.
+type names map[string]bool
+
+// allocate returns a valid resource name built from parts, unique among the names allocated so far.
+func (names names) allocate(parts ...string) string {
+	name := invalidNameCharacters.ReplaceAllString(strings.Join(parts, "_"), "_")
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z')) {
+		name = "_" + name
+	}
+	unique := name
+	for suffix := 2; names[unique]; suffix++ {
+		unique = name + "_" + strconv.Itoa(suffix)
+	}
+	names[unique] = true
+	return unique
+}
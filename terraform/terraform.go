@@ -0,0 +1,361 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package terraform : Export of an App Configuration instance as Terraform configuration
+// Generate writes the environments, collections, segments, features and properties of an instance as resources of the
+// IBM Cloud Terraform provider (ibm_app_config_*), together with the `terraform import` commands that adopt the
+// existing objects into a Terraform state. References between objects (environment of a feature, segments of a rule,
+// collections) are written as resource references, so that Terraform orders their creation.
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Names of the files written by Module.WriteFiles.
+const (
+	ConfigurationFileName = "appconfiguration.tf"
+	ImportScriptFileName  = "import.sh"
+)
+
+// Resource types of the IBM Cloud Terraform provider.
+const (
+	ResourceTypeEnvironment = "ibm_app_config_environment"
+	ResourceTypeCollection  = "ibm_app_config_collection"
+	ResourceTypeSegment     = "ibm_app_config_segment"
+	ResourceTypeFeature     = "ibm_app_config_feature"
+	ResourceTypeProperty    = "ibm_app_config_property"
+)
+
+// GUIDLocal is the local value holding the instance GUID in the generated configuration.
+const GUIDLocal = "app_config_guid"
+
+// Module : A generated Terraform configuration.
+type Module struct {
+	// The HCL configuration.
+	Configuration string
+
+	// One `terraform import <address> <id>` command per resource, in the order of the configuration.
+	ImportCommands []string
+
+	// Settings of the instance that the generated resources do not manage.
+	Warnings []string
+}
+
+// Export reads the configuration of the instance with GetLiveInstanceConfig and generates its Terraform module. The
+// client's service URL must point to the instance whose GUID is given.
+func Export(client *appconfigurationv1.AppConfigurationV1, guid string) (*Module, error) {
+	return ExportWithContext(context.Background(), client, guid)
+}
+
+// ExportWithContext is an alternate form of the Export method which supports a Context parameter
+func ExportWithContext(ctx context.Context, client *appconfigurationv1.AppConfigurationV1, guid string) (*Module, error) {
+	config, err := client.GetLiveInstanceConfigWithContext(ctx)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "unable to read the instance configuration", "read-config-error", common.GetComponentInfo())
+	}
+	return Generate(config, guid)
+}
+
+// Generate returns the Terraform module of an instance configuration, as returned by GetLiveInstanceConfig or
+// ListInstanceConfig.
+func Generate(config *appconfigurationv1.ImportConfig, guid string) (*Module, error) {
+	if guid == "" {
+		return nil, core.SDKErrorf(nil, "the instance GUID is required", "missing-guid", common.GetComponentInfo())
+	}
+	generator := &generator{
+		guid:         guid,
+		module:       &Module{},
+		names:        map[string]names{},
+		environments: map[string]string{},
+		collections:  map[string]string{},
+		segments:     map[string]string{},
+	}
+
+	file := &hclBody{}
+	file.block("locals").attribute(GUIDLocal, quote(guid))
+	for _, collection := range config.Collections {
+		generator.collection(file, collection)
+	}
+	for _, segment := range config.Segments {
+		generator.segment(file, segment)
+	}
+	for _, environment := range config.Environments {
+		generator.environment(file, environment)
+	}
+	for _, environment := range config.Environments {
+		for _, feature := range environment.Features {
+			generator.feature(file, stringValue(environment.EnvironmentID), feature)
+		}
+		for _, property := range environment.Properties {
+			generator.property(file, stringValue(environment.EnvironmentID), property)
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# App Configuration instance %s\n\n", guid))
+	file.write(&builder, 0)
+	generator.module.Configuration = builder.String()
+	return generator.module, nil
+}
+
+// WriteFiles writes the configuration and an executable script running the import commands to a directory.
+func (module *Module) WriteFiles(directory string) error {
+	if err := os.WriteFile(filepath.Join(directory, ConfigurationFileName), []byte(module.Configuration), 0644); err != nil {
+		return core.SDKErrorf(err, "", "write-configuration-error", common.GetComponentInfo())
+	}
+	script := "#!/bin/sh\nset -e\n\n" + strings.Join(module.ImportCommands, "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(directory, ImportScriptFileName), []byte(script), 0755); err != nil {
+		return core.SDKErrorf(err, "", "write-import-script-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+type generator struct {
+	guid   string
+	module *Module
+
+	// Resource names by resource type, since they only need to be unique within a type.
+	names map[string]names
+
+	// Resource names by ID.
+	environments map[string]string
+	collections  map[string]string
+	segments     map[string]string
+}
+
+// resource adds a resource block and its import command.
+func (generator *generator) resource(file *hclBody, resourceType string, name string, importID string) *hclBody {
+	resource := file.block(fmt.Sprintf("resource %q %q", resourceType, name))
+	resource.attribute("guid", "local."+GUIDLocal)
+	generator.module.ImportCommands = append(generator.module.ImportCommands,
+		fmt.Sprintf("terraform import %s.%s %s/%s", resourceType, name, generator.guid, importID))
+	return resource
+}
+
+// name allocates the resource name of an object of a resource type.
+func (generator *generator) name(resourceType string, parts ...string) string {
+	if generator.names[resourceType] == nil {
+		generator.names[resourceType] = names{}
+	}
+	return generator.names[resourceType].allocate(parts...)
+}
+
+func (generator *generator) warn(format string, args ...interface{}) {
+	generator.module.Warnings = append(generator.module.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (generator *generator) collection(file *hclBody, collection appconfigurationv1.ImportCollectionSchema) {
+	collectionID := stringValue(collection.CollectionID)
+	name := generator.name(ResourceTypeCollection, collectionID)
+	generator.collections[collectionID] = name
+	resource := generator.resource(file, ResourceTypeCollection, name, collectionID)
+	resource.attribute("collection_id", quote(collectionID))
+	resource.attribute("name", quote(stringValue(collection.Name)))
+	optionalString(resource, "description", collection.Description)
+	optionalString(resource, "tags", collection.Tags)
+}
+
+func (generator *generator) segment(file *hclBody, segment appconfigurationv1.ImportSegmentSchema) {
+	segmentID := stringValue(segment.SegmentID)
+	name := generator.name(ResourceTypeSegment, segmentID)
+	generator.segments[segmentID] = name
+	resource := generator.resource(file, ResourceTypeSegment, name, segmentID)
+	resource.attribute("segment_id", quote(segmentID))
+	resource.attribute("name", quote(stringValue(segment.Name)))
+	optionalString(resource, "description", segment.Description)
+	optionalString(resource, "tags", segment.Tags)
+	for _, rule := range segment.Rules {
+		block := resource.block("rules")
+		block.attribute("attribute_name", quote(stringValue(rule.AttributeName)))
+		block.attribute("operator", quote(stringValue(rule.Operator)))
+		block.attribute("values", quoteAll(rule.Values))
+	}
+}
+
+func (generator *generator) environment(file *hclBody, environment appconfigurationv1.ImportEnvironmentSchema) {
+	environmentID := stringValue(environment.EnvironmentID)
+	name := generator.name(ResourceTypeEnvironment, environmentID)
+	generator.environments[environmentID] = name
+	resource := generator.resource(file, ResourceTypeEnvironment, name, environmentID)
+	resource.attribute("environment_id", quote(environmentID))
+	resource.attribute("name", quote(stringValue(environment.Name)))
+	optionalString(resource, "description", environment.Description)
+	optionalString(resource, "tags", environment.Tags)
+	optionalString(resource, "color_code", environment.ColorCode)
+}
+
+func (generator *generator) feature(file *hclBody, environmentID string, feature appconfigurationv1.ImportFeatureRequestBody) {
+	featureID := stringValue(feature.FeatureID)
+	name := generator.name(ResourceTypeFeature, environmentID, featureID)
+	resource := generator.resource(file, ResourceTypeFeature, name, environmentID+"/"+featureID)
+	resource.attribute("environment_id", generator.reference(ResourceTypeEnvironment, generator.environments, environmentID, "environment_id"))
+	resource.attribute("feature_id", quote(featureID))
+	resource.attribute("name", quote(stringValue(feature.Name)))
+	resource.attribute("type", quote(stringValue(feature.Type)))
+	optionalString(resource, "format", feature.Format)
+	resource.attribute("enabled_value", quote(valueString(feature.EnabledValue)))
+	resource.attribute("disabled_value", quote(valueString(feature.DisabledValue)))
+	optionalString(resource, "description", feature.Description)
+	optionalString(resource, "tags", feature.Tags)
+	if feature.RolloutPercentage != nil {
+		resource.attribute("rollout_percentage", strconv.FormatInt(*feature.RolloutPercentage, 10))
+	}
+	optionalString(resource, "rollout_type", feature.RolloutType)
+	generator.rolloutConfiguration(resource, feature.RolloutConfiguration)
+	if feature.Enabled != nil && *feature.Enabled {
+		generator.warn("feature '%s' of environment '%s' is enabled; the toggle state is not managed by %s", featureID, environmentID, ResourceTypeFeature)
+	}
+
+	for _, rule := range feature.SegmentRules {
+		block := resource.block("segment_rules")
+		generator.targetSegments(block, rule.Rules)
+		block.attribute("value", quote(valueString(rule.Value)))
+		block.attribute("order", strconv.FormatInt(int64Value(rule.Order), 10))
+		if rule.RolloutPercentage != nil {
+			block.attribute("rollout_percentage", strconv.FormatInt(*rule.RolloutPercentage, 10))
+		}
+		optionalString(block, "rollout_type", rule.RolloutType)
+		generator.rolloutConfiguration(block, rule.RolloutConfiguration)
+	}
+	generator.collectionRefs(resource, feature.Collections)
+}
+
+func (generator *generator) property(file *hclBody, environmentID string, property appconfigurationv1.ImportPropertyRequestBody) {
+	propertyID := stringValue(property.PropertyID)
+	name := generator.name(ResourceTypeProperty, environmentID, propertyID)
+	resource := generator.resource(file, ResourceTypeProperty, name, environmentID+"/"+propertyID)
+	resource.attribute("environment_id", generator.reference(ResourceTypeEnvironment, generator.environments, environmentID, "environment_id"))
+	resource.attribute("property_id", quote(propertyID))
+	resource.attribute("name", quote(stringValue(property.Name)))
+	resource.attribute("type", quote(stringValue(property.Type)))
+	optionalString(resource, "format", property.Format)
+	resource.attribute("value", quote(valueString(property.Value)))
+	optionalString(resource, "description", property.Description)
+	optionalString(resource, "tags", property.Tags)
+
+	for _, rule := range property.SegmentRules {
+		block := resource.block("segment_rules")
+		generator.targetSegments(block, rule.Rules)
+		block.attribute("value", quote(valueString(rule.Value)))
+		block.attribute("order", strconv.FormatInt(int64Value(rule.Order), 10))
+	}
+	generator.collectionRefs(resource, property.Collections)
+}
+
+func (generator *generator) targetSegments(block *hclBody, rules []appconfigurationv1.TargetSegments) {
+	for _, rule := range rules {
+		segments := make([]string, len(rule.Segments))
+		for i, segmentID := range rule.Segments {
+			segments[i] = generator.reference(ResourceTypeSegment, generator.segments, segmentID, "segment_id")
+		}
+		block.block("rules").attribute("segments", list(segments))
+	}
+}
+
+func (generator *generator) collectionRefs(resource *hclBody, collections []appconfigurationv1.CollectionRef) {
+	for _, collection := range collections {
+		collectionID := stringValue(collection.CollectionID)
+		resource.block("collections").attribute("collection_id", generator.reference(ResourceTypeCollection, generator.collections, collectionID, "collection_id"))
+	}
+}
+
+func (generator *generator) rolloutConfiguration(block *hclBody, configuration *appconfigurationv1.RolloutConfiguration) {
+	if configuration == nil {
+		return
+	}
+	nested := block.block("rollout_configuration")
+	optionalString(nested, "duration_preset", configuration.DurationPreset)
+	if configuration.StartAt != nil {
+		nested.attribute("start_at", quote(configuration.StartAt.String()))
+	}
+	for _, phase := range configuration.Phases {
+		phaseBlock := nested.block("phases")
+		phaseBlock.attribute("percentage", strconv.FormatInt(int64Value(phase.Percentage), 10))
+		if phase.Duration != nil {
+			phaseBlock.attribute("duration", strconv.FormatInt(*phase.Duration, 10))
+		}
+		optionalString(phaseBlock, "duration_type", phase.DurationType)
+	}
+}
+
+// reference returns a reference to an attribute of a generated resource, or the ID as a literal if the configuration
+// has no such resource.
+func (generator *generator) reference(resourceType string, resources map[string]string, id string, attribute string) string {
+	if name, ok := resources[id]; ok {
+		return resourceType + "." + name + "." + attribute
+	}
+	generator.warn("%s '%s' is referenced but not part of the configuration", strings.TrimPrefix(resourceType, "ibm_app_config_"), id)
+	return quote(id)
+}
+
+func optionalString(body *hclBody, name string, value *string) {
+	if value != nil && *value != "" {
+		body.attribute(name, quote(*value))
+	}
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quote(value)
+	}
+	return list(quoted)
+}
+
+// valueString returns a feature or property value as the string the provider expects: booleans and numbers in their
+// literal form, JSON values serialized.
+func valueString(value interface{}) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case bool:
+		return strconv.FormatBool(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(buffer)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `{
+	"environments": [{"name": "Dev", "environment_id": "dev", "color_code": "#FDD13A",
+		"features": [
+			{"name": "Checkout", "feature_id": "checkout", "type": "STRING", "format": "JSON", "enabled_value": {"v": "${x}"}, "disabled_value": {}, "enabled": true,
+			 "rollout_percentage": 50, "collections": [{"collection_id": "web"}],
+			 "segment_rules": [{"rules": [{"segments": ["beta", "ghost"]}], "value": "$default", "order": 1, "rollout_percentage": 20}]}
+		],
+		"properties": [{"name": "Limit", "property_id": "limit", "type": "NUMERIC", "value": 1.5, "description": "Line one\nLine \"two\""}]
+	}],
+	"collections": [{"name": "Web", "collection_id": "web"}],
+	"segments": [{"name": "Beta", "segment_id": "beta", "rules": [{"attribute_name": "plan", "operator": "is", "values": ["pro", "gold"]}]}]
+}`
+
+const expectedConfiguration = `# App Configuration instance abc-123
+
+locals {
+  app_config_guid = "abc-123"
+}
+
+resource "ibm_app_config_collection" "web" {
+  guid          = local.app_config_guid
+  collection_id = "web"
+  name          = "Web"
+}
+
+resource "ibm_app_config_segment" "beta" {
+  guid       = local.app_config_guid
+  segment_id = "beta"
+  name       = "Beta"
+
+  rules {
+    attribute_name = "plan"
+    operator       = "is"
+    values         = ["pro", "gold"]
+  }
+}
+
+resource "ibm_app_config_environment" "dev" {
+  guid           = local.app_config_guid
+  environment_id = "dev"
+  name           = "Dev"
+  color_code     = "#FDD13A"
+}
+
+resource "ibm_app_config_feature" "dev_checkout" {
+  guid               = local.app_config_guid
+  environment_id     = ibm_app_config_environment.dev.environment_id
+  feature_id         = "checkout"
+  name               = "Checkout"
+  type               = "STRING"
+  format             = "JSON"
+  enabled_value      = "{\"v\":\"$${x}\"}"
+  disabled_value     = "{}"
+  rollout_percentage = 50
+
+  segment_rules {
+    rules {
+      segments = [ibm_app_config_segment.beta.segment_id, "ghost"]
+    }
+
+    value              = "$default"
+    order              = 1
+    rollout_percentage = 20
+  }
+
+  collections {
+    collection_id = ibm_app_config_collection.web.collection_id
+  }
+}
+
+resource "ibm_app_config_property" "dev_limit" {
+  guid           = local.app_config_guid
+  environment_id = ibm_app_config_environment.dev.environment_id
+  property_id    = "limit"
+  name           = "Limit"
+  type           = "NUMERIC"
+  value          = "1.5"
+  description    = "Line one\nLine \"two\""
+}
+`
+
+func TestGenerate(t *testing.T) {
+	config, err := evaluator.LoadConfig(strings.NewReader(testConfig))
+	require.Nil(t, err)
+	module, err := Generate(config, "abc-123")
+	require.Nil(t, err)
+
+	assert.Equal(t, expectedConfiguration, module.Configuration)
+	assert.Equal(t, []string{
+		"terraform import ibm_app_config_collection.web abc-123/web",
+		"terraform import ibm_app_config_segment.beta abc-123/beta",
+		"terraform import ibm_app_config_environment.dev abc-123/dev",
+		"terraform import ibm_app_config_feature.dev_checkout abc-123/dev/checkout",
+		"terraform import ibm_app_config_property.dev_limit abc-123/dev/limit",
+	}, module.ImportCommands)
+	assert.Equal(t, []string{
+		"feature 'checkout' of environment 'dev' is enabled; the toggle state is not managed by ibm_app_config_feature",
+		"segment 'ghost' is referenced but not part of the configuration",
+	}, module.Warnings)
+
+	directory := t.TempDir()
+	require.Nil(t, module.WriteFiles(directory))
+	script, err := os.ReadFile(filepath.Join(directory, ImportScriptFileName))
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(script), "#!/bin/sh\nset -e\n\nterraform import ibm_app_config_collection.web abc-123/web\n"))
+	written, err := os.ReadFile(filepath.Join(directory, ConfigurationFileName))
+	require.Nil(t, err)
+	assert.Equal(t, expectedConfiguration, string(written))
+
+	_, err = Generate(config, "")
+	assert.NotNil(t, err)
+}
+
+func TestResourceNames(t *testing.T) {
+	allocated := names{}
+	assert.Equal(t, "dev_my-feature", allocated.allocate("dev", "my-feature"))
+	assert.Equal(t, "dev_my-feature_2", allocated.allocate("dev", "my-feature"))
+	assert.Equal(t, "_1st_flag_v2", allocated.allocate("1st", "flag.v2"))
+	assert.Equal(t, `"a\\b $${c} %%{d}"`, quote(`a\b ${c} %{d}`))
+}
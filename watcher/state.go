@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watcher
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// State : The resources seen by the last poll, persisted between runs so that a restarted watcher only reports the
+// changes made since.
+type State struct {
+	// When the state was polled.
+	PolledAt time.Time `json:"polled_at"`
+
+	// The resources by key, `<kind>/<environment ID>/<ID>`.
+	Resources map[string]Resource `json:"resources"`
+}
+
+// Resource : A resource as seen by a poll.
+type Resource struct {
+	Kind          string `json:"kind"`
+	EnvironmentID string `json:"environment_id,omitempty"`
+	ID            string `json:"id"`
+	Name          string `json:"name,omitempty"`
+
+	// The updated_time of the resource, in RFC 3339 format.
+	UpdatedTime string `json:"updated_time,omitempty"`
+
+	// The attributes of the resource in their JSON form, without the server-computed ones.
+	Fields map[string]interface{} `json:"fields"`
+}
+
+func (resource Resource) key() string {
+	return resource.Kind + "/" + resource.EnvironmentID + "/" + resource.ID
+}
+
+// LoadState reads a state saved by SaveState. It returns nil without error if the file does not exist.
+func LoadState(path string) (*State, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "read-state-error", common.GetComponentInfo())
+	}
+	state := &State{}
+	if err = json.Unmarshal(content, state); err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-state", common.GetComponentInfo())
+	}
+	if state.Resources == nil {
+		state.Resources = map[string]Resource{}
+	}
+	return state, nil
+}
+
+// SaveState writes state to path, through a temporary file so that a crash leaves the previous state intact.
+func SaveState(path string, state *State) error {
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-state-error", common.GetComponentInfo())
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-state-error", common.GetComponentInfo())
+	}
+	defer os.Remove(file.Name())
+	if _, err = file.Write(content); err == nil {
+		err = file.Chmod(0600)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-state-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ignoredFields are computed by the server and change without the resource being changed.
+var ignoredFields = []string{"href", "created_time", "updated_time", "evaluation_time", "segment_exists", "segment_count", "features", "properties", "features_count", "properties_count"}
+
+// newResource converts a listed resource to its JSON form.
+func newResource(kind string, environmentID string, id *string, name *string, updatedTime *strfmt.DateTime, value interface{}) (Resource, error) {
+	resource := Resource{Kind: kind, EnvironmentID: environmentID, ID: stringValue(id), Name: stringValue(name), Fields: map[string]interface{}{}}
+	if updatedTime != nil {
+		resource.UpdatedTime = time.Time(*updatedTime).UTC().Format(time.RFC3339Nano)
+	}
+	buffer, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(buffer, &resource.Fields)
+	}
+	if err != nil {
+		return Resource{}, core.SDKErrorf(err, "", "normalize-resource-error", common.GetComponentInfo())
+	}
+	for _, field := range ignoredFields {
+		delete(resource.Fields, field)
+	}
+	if rules, ok := resource.Fields["segment_rules"].([]interface{}); ok {
+		for _, rule := range rules {
+			if rule, ok := rule.(map[string]interface{}); ok {
+				delete(rule, "rule_id")
+			}
+		}
+	}
+	return resource, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
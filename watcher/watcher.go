@@ -0,0 +1,461 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package watcher : Change events by polling an App Configuration instance
+// A Watcher periodically lists the environments, segments, features and properties of an instance, compares them
+// with the previous poll and emits typed events for the resources that were created, updated, deleted or toggled, and
+// for the rollouts whose status changed. The state of the last poll can be persisted so that a restarted watcher
+// resumes where it stopped.
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The kinds of resources that are watched.
+const (
+	KindEnvironment = appconfigurationv1.ConfigChange_Kind_Environment
+	KindSegment     = appconfigurationv1.ConfigChange_Kind_Segment
+	KindFeature     = appconfigurationv1.ConfigChange_Kind_Feature
+	KindProperty    = appconfigurationv1.ConfigChange_Kind_Property
+)
+
+// EventType : What happened to a resource.
+type EventType string
+
+const (
+	// EventCreated is emitted for a resource that was not in the previous poll.
+	EventCreated EventType = "created"
+
+	// EventUpdated is emitted for a resource whose attributes changed, other than the enabled state of a feature and
+	// the status of its rollout, which have their own events.
+	EventUpdated EventType = "updated"
+
+	// EventDeleted is emitted for a resource that is no longer listed.
+	EventDeleted EventType = "deleted"
+
+	// EventToggled is emitted for a feature that was turned on or off.
+	EventToggled EventType = "toggled"
+
+	// EventRolloutStatusChanged is emitted for a feature whose progressive rollout was queued, started or stopped.
+	EventRolloutStatusChanged EventType = "rollout_status_changed"
+)
+
+// Event : A change of a resource.
+type Event struct {
+	Type EventType
+
+	// The kind of resource, one of the Kind* constants.
+	Kind string
+
+	// The environment of the feature or property. Empty for other kinds of resources.
+	EnvironmentID string
+
+	ID   string
+	Name string
+
+	// The updated_time of the resource, zero for deletes.
+	Time time.Time
+
+	// The attributes that changed, for EventUpdated.
+	Changes []FieldChange
+
+	// Whether the feature is now enabled, for EventToggled.
+	Enabled bool
+
+	// The previous and current status of the rollout, one of the RolloutConfiguration_Status_* constants or empty if
+	// the feature has no rollout configuration, for EventRolloutStatusChanged.
+	PreviousRolloutStatus string
+	RolloutStatus         string
+}
+
+// FieldChange : The previous and current JSON values of an attribute, nil if it was not set.
+type FieldChange struct {
+	Field    string
+	Previous interface{}
+	Current  interface{}
+}
+
+// String returns a one-line description of the event, for example `toggled feature dev/flag_1 (enabled)`.
+func (event Event) String() string {
+	path := event.ID
+	if event.EnvironmentID != "" {
+		path = event.EnvironmentID + "/" + event.ID
+	}
+	description := fmt.Sprintf("%s %s %s", event.Type, event.Kind, path)
+	switch event.Type {
+	case EventUpdated:
+		fields := make([]string, len(event.Changes))
+		for i, change := range event.Changes {
+			fields[i] = change.Field
+		}
+		description += " (" + strings.Join(fields, ", ") + ")"
+	case EventToggled:
+		if event.Enabled {
+			description += " (enabled)"
+		} else {
+			description += " (disabled)"
+		}
+	case EventRolloutStatusChanged:
+		description += fmt.Sprintf(" (%s -> %s)", statusOrNone(event.PreviousRolloutStatus), statusOrNone(event.RolloutStatus))
+	}
+	return description
+}
+
+func statusOrNone(status string) string {
+	if status == "" {
+		return "none"
+	}
+	return status
+}
+
+// Options : The options of a Watcher.
+type Options struct {
+	// The file the state is persisted to after each poll. If it exists, the first poll reports the changes made since
+	// the state was saved. The state is kept in memory only if empty.
+	StateFile string
+
+	// Emit EventCreated for every resource on the first poll without a saved state. By default that poll only records
+	// the resources.
+	EmitInitial bool
+}
+
+// Watcher : Polls an instance and reports the changes since the previous poll. A Watcher is not safe for concurrent use.
+type Watcher struct {
+	client  *appconfigurationv1.AppConfigurationV1
+	options Options
+	state   *State
+	now     func() time.Time
+}
+
+// NewWatcher returns a watcher of the instance of client, loading the state of Options.StateFile if it exists.
+func NewWatcher(client *appconfigurationv1.AppConfigurationV1, options *Options) (*Watcher, error) {
+	watcher := &Watcher{client: client, now: time.Now}
+	if options != nil {
+		watcher.options = *options
+	}
+	if watcher.options.StateFile != "" {
+		state, err := LoadState(watcher.options.StateFile)
+		if err != nil {
+			return nil, err
+		}
+		watcher.state = state
+	}
+	return watcher, nil
+}
+
+// State returns the state of the last poll, nil before the first poll without a saved state.
+func (watcher *Watcher) State() *State {
+	return watcher.state
+}
+
+// Poll lists the resources of the instance and returns the changes since the previous poll.
+func (watcher *Watcher) Poll() ([]Event, error) {
+	return watcher.PollWithContext(context.Background())
+}
+
+// PollWithContext is an alternate form of the Poll method which supports a Context parameter
+func (watcher *Watcher) PollWithContext(ctx context.Context) ([]Event, error) {
+	events, state, err := watcher.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = watcher.commit(state); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Run polls the instance every interval until ctx is done and sends the events to events, which it does not close.
+// The state is saved once all the events of a poll were received, so that a stopped watcher reports the undelivered
+// events again when restarted. Errors are reported to onError, if it is not nil, and the poll is retried at the next
+// tick.
+func (watcher *Watcher) Run(ctx context.Context, interval time.Duration, events chan<- Event, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := watcher.deliver(ctx, events); err != nil && ctx.Err() == nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (watcher *Watcher) deliver(ctx context.Context, events chan<- Event) error {
+	polled, state, err := watcher.poll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, event := range polled {
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return watcher.commit(state)
+}
+
+func (watcher *Watcher) commit(state *State) error {
+	if watcher.options.StateFile != "" {
+		if err := SaveState(watcher.options.StateFile, state); err != nil {
+			return err
+		}
+	}
+	watcher.state = state
+	return nil
+}
+
+// poll lists the resources, sorted by updated_time, and compares them with the current state.
+func (watcher *Watcher) poll(ctx context.Context) ([]Event, *State, error) {
+	resources, err := watcher.list(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	state := &State{PolledAt: watcher.now().UTC(), Resources: map[string]Resource{}}
+	for _, resource := range resources {
+		state.Resources[resource.key()] = resource
+	}
+	if watcher.state == nil && !watcher.options.EmitInitial {
+		return nil, state, nil
+	}
+
+	previous := map[string]Resource{}
+	if watcher.state != nil {
+		previous = watcher.state.Resources
+	}
+	events := []Event{}
+	for _, resource := range resources {
+		events = append(events, compare(previous[resource.key()], resource)...)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+
+	keys := make([]string, 0, len(previous))
+	for key := range previous {
+		if _, ok := state.Resources[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		resource := previous[key]
+		events = append(events, Event{
+			Type:          EventDeleted,
+			Kind:          resource.Kind,
+			EnvironmentID: resource.EnvironmentID,
+			ID:            resource.ID,
+			Name:          resource.Name,
+		})
+	}
+	return events, state, nil
+}
+
+// compare returns the events that turn previous, the zero Resource if there is none, into current.
+func compare(previous Resource, current Resource) []Event {
+	event := Event{
+		Kind:          current.Kind,
+		EnvironmentID: current.EnvironmentID,
+		ID:            current.ID,
+		Name:          current.Name,
+	}
+	if updatedTime, err := time.Parse(time.RFC3339Nano, current.UpdatedTime); err == nil {
+		event.Time = updatedTime
+	}
+	if previous.Fields == nil {
+		event.Type = EventCreated
+		return []Event{event}
+	}
+
+	events := []Event{}
+	changes := []FieldChange{}
+	for _, field := range fieldNames(previous.Fields, current.Fields) {
+		before, after := previous.Fields[field], current.Fields[field]
+		if reflect.DeepEqual(before, after) {
+			continue
+		}
+		switch {
+		case current.Kind == KindFeature && field == "enabled":
+			toggled := event
+			toggled.Type = EventToggled
+			toggled.Enabled = after == true
+			events = append(events, toggled)
+			continue
+		case current.Kind == KindFeature && field == "rollout_configuration":
+			previousStatus, status := rolloutStatus(before), rolloutStatus(after)
+			if previousStatus != status {
+				changed := event
+				changed.Type = EventRolloutStatusChanged
+				changed.PreviousRolloutStatus = previousStatus
+				changed.RolloutStatus = status
+				events = append(events, changed)
+				if reflect.DeepEqual(withoutStatus(before), withoutStatus(after)) {
+					continue
+				}
+			}
+		}
+		changes = append(changes, FieldChange{Field: field, Previous: before, Current: after})
+	}
+	if len(changes) > 0 {
+		updated := event
+		updated.Type = EventUpdated
+		updated.Changes = changes
+		events = append([]Event{updated}, events...)
+	}
+	return events
+}
+
+func rolloutStatus(configuration interface{}) string {
+	if fields, ok := configuration.(map[string]interface{}); ok {
+		if status, ok := fields["status"].(string); ok {
+			return status
+		}
+		return appconfigurationv1.RolloutConfiguration_Status_Queued
+	}
+	return ""
+}
+
+func withoutStatus(configuration interface{}) interface{} {
+	fields, ok := configuration.(map[string]interface{})
+	if !ok {
+		return configuration
+	}
+	copied := map[string]interface{}{}
+	for key, value := range fields {
+		if key != "status" {
+			copied[key] = value
+		}
+	}
+	return copied
+}
+
+func fieldNames(maps ...map[string]interface{}) []string {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, fields := range maps {
+		for name := range fields {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// list returns the environments, segments, features and properties of the instance.
+func (watcher *Watcher) list(ctx context.Context) ([]Resource, error) {
+	resources := []Resource{}
+
+	segmentsPager, err := watcher.client.NewSegmentsPager(&appconfigurationv1.ListSegmentsOptions{
+		Expand:  core.BoolPtr(true),
+		Sort:    core.StringPtr(appconfigurationv1.ListSegmentsOptions_Sort_UpdatedTime),
+		Include: []string{appconfigurationv1.ListSegmentsOptions_Include_Rules},
+	})
+	if err != nil {
+		return nil, err
+	}
+	segments, err := segmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range segments {
+		segment := &segments[i]
+		resource, err := newResource(KindSegment, "", segment.SegmentID, segment.Name, segment.UpdatedTime, segment)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+
+	environmentsPager, err := watcher.client.NewEnvironmentsPager(&appconfigurationv1.ListEnvironmentsOptions{
+		Expand: core.BoolPtr(true),
+		Sort:   core.StringPtr(appconfigurationv1.ListEnvironmentsOptions_Sort_UpdatedTime),
+	})
+	if err != nil {
+		return nil, err
+	}
+	environments, err := environmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range environments {
+		environment := &environments[i]
+		environmentID := stringValue(environment.EnvironmentID)
+		resource, err := newResource(KindEnvironment, "", environment.EnvironmentID, environment.Name, environment.UpdatedTime, environment)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+
+		featuresPager, err := watcher.client.NewFeaturesPager(&appconfigurationv1.ListFeaturesOptions{
+			EnvironmentID: environment.EnvironmentID,
+			Expand:        core.BoolPtr(true),
+			Sort:          core.StringPtr(appconfigurationv1.ListFeaturesOptions_Sort_UpdatedTime),
+			Include:       []string{appconfigurationv1.ListFeaturesOptions_Include_Collections, appconfigurationv1.ListFeaturesOptions_Include_Rules},
+		})
+		if err != nil {
+			return nil, err
+		}
+		features, err := featuresPager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j := range features {
+			feature := &features[j]
+			resource, err := newResource(KindFeature, environmentID, feature.FeatureID, feature.Name, feature.UpdatedTime, feature)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, resource)
+		}
+
+		propertiesPager, err := watcher.client.NewPropertiesPager(&appconfigurationv1.ListPropertiesOptions{
+			EnvironmentID: environment.EnvironmentID,
+			Expand:        core.BoolPtr(true),
+			Sort:          core.StringPtr(appconfigurationv1.ListPropertiesOptions_Sort_UpdatedTime),
+			Include:       []string{appconfigurationv1.ListPropertiesOptions_Include_Collections, appconfigurationv1.ListPropertiesOptions_Include_Rules},
+		})
+		if err != nil {
+			return nil, err
+		}
+		properties, err := propertiesPager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j := range properties {
+			property := &properties[j]
+			resource, err := newResource(KindProperty, environmentID, property.PropertyID, property.Name, property.UpdatedTime, property)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
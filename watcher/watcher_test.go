@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInstance serves the list operations of an instance with a single environment.
+type fakeInstance struct {
+	mutex      sync.Mutex
+	segments   string
+	features   string
+	properties string
+}
+
+func (instance *fakeInstance) set(field *string, value string) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	*field = value
+}
+
+func (instance *fakeInstance) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	if req.URL.Query().Get("sort") != "updated_time" {
+		res.WriteHeader(400)
+		return
+	}
+	res.Header().Set("Content-type", "application/json")
+	switch req.URL.Path {
+	case "/environments":
+		fmt.Fprint(res, `{"environments": [{"name": "Dev", "environment_id": "dev", "updated_time": "2026-10-01T10:00:00Z"}], "total_count": 1}`)
+	case "/segments":
+		fmt.Fprintf(res, `{"segments": [%s], "total_count": 1}`, instance.segments)
+	case "/environments/dev/features":
+		fmt.Fprintf(res, `{"features": [%s], "total_count": 1}`, instance.features)
+	case "/environments/dev/properties":
+		fmt.Fprintf(res, `{"properties": [%s], "total_count": 1}`, instance.properties)
+	default:
+		res.WriteHeader(404)
+	}
+}
+
+func newTestClient(t *testing.T, instance *fakeInstance) *appconfigurationv1.AppConfigurationV1 {
+	server := httptest.NewServer(instance)
+	t.Cleanup(server.Close)
+	client, err := appconfigurationv1.NewAppConfigurationV1(&appconfigurationv1.AppConfigurationV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return client
+}
+
+const (
+	testSegment  = `{"name": "Beta", "segment_id": "beta", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com"]}], "updated_time": "2026-10-01T10:00:00Z"}`
+	testFeature  = `{"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": false, "rollout_percentage": 100, "updated_time": "2026-10-01T11:00:00Z"}`
+	testProperty = `{"name": "Timeout", "property_id": "timeout", "type": "NUMERIC", "value": 10, "updated_time": "2026-10-01T12:00:00Z", "evaluation_time": "2026-10-10T12:00:00Z"}`
+)
+
+func TestWatcherPoll(t *testing.T) {
+	instance := &fakeInstance{segments: testSegment, features: testFeature, properties: testProperty}
+	client := newTestClient(t, instance)
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	watcher, err := NewWatcher(client, &Options{StateFile: stateFile})
+	require.Nil(t, err)
+	events, err := watcher.Poll()
+	require.Nil(t, err)
+	assert.Empty(t, events)
+	assert.Len(t, watcher.State().Resources, 4)
+
+	instance.set(&instance.features, `{"name": "Checkout", "feature_id": "checkout", "type": "BOOLEAN", "enabled_value": true, "disabled_value": false, "enabled": true, "rollout_percentage": 50, "tags": "shop", "updated_time": "2026-10-02T09:00:00Z"}`)
+	instance.set(&instance.properties, `{"name": "Timeout", "property_id": "timeout", "type": "NUMERIC", "value": 10, "updated_time": "2026-10-01T12:00:00Z", "evaluation_time": "2026-10-11T12:00:00Z"}`)
+	instance.set(&instance.segments, `{"name": "Beta", "segment_id": "beta-2", "rules": [], "updated_time": "2026-10-02T08:00:00Z"}`)
+
+	// A new watcher resumes from the saved state.
+	watcher, err = NewWatcher(client, &Options{StateFile: stateFile})
+	require.Nil(t, err)
+	events, err = watcher.Poll()
+	require.Nil(t, err)
+	descriptions := []string{}
+	for _, event := range events {
+		descriptions = append(descriptions, event.String())
+	}
+	assert.Equal(t, []string{
+		"created segment beta-2",
+		"updated feature dev/checkout (rollout_percentage, tags)",
+		"toggled feature dev/checkout (enabled)",
+		"deleted segment beta",
+	}, descriptions)
+	assert.Equal(t, FieldChange{Field: "rollout_percentage", Previous: 100.0, Current: 50.0}, events[1].Changes[0])
+	assert.Equal(t, time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC), events[1].Time)
+
+	events, err = watcher.Poll()
+	require.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestCompareRolloutStatus(t *testing.T) {
+	previous := Resource{Kind: KindFeature, EnvironmentID: "dev", ID: "checkout", Fields: map[string]interface{}{
+		"rollout_configuration": map[string]interface{}{"duration_preset": "CUSTOM", "status": "QUEUED"},
+	}}
+	current := Resource{Kind: KindFeature, EnvironmentID: "dev", ID: "checkout", Fields: map[string]interface{}{
+		"rollout_configuration": map[string]interface{}{"duration_preset": "CUSTOM", "status": "RUNNING"},
+	}}
+	events := compare(previous, current)
+	require.Len(t, events, 1)
+	assert.Equal(t, "rollout_status_changed feature dev/checkout (QUEUED -> RUNNING)", events[0].String())
+
+	current.Fields["rollout_configuration"] = map[string]interface{}{"duration_preset": "FAST", "status": "STOPPED"}
+	events = compare(previous, current)
+	require.Len(t, events, 2)
+	assert.Equal(t, EventUpdated, events[0].Type)
+	assert.Equal(t, "STOPPED", events[1].RolloutStatus)
+
+	delete(current.Fields, "rollout_configuration")
+	events = compare(previous, current)
+	require.Len(t, events, 2)
+	assert.Equal(t, "", events[1].RolloutStatus)
+}
+
+func TestWatcherRun(t *testing.T) {
+	instance := &fakeInstance{segments: testSegment, features: testFeature, properties: testProperty}
+	watcher, err := NewWatcher(newTestClient(t, instance), &Options{EmitInitial: true})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan Event)
+	done := make(chan struct{})
+	go func() {
+		watcher.Run(ctx, 10*time.Millisecond, events, func(err error) { t.Error(err) })
+		close(done)
+	}()
+
+	kinds := []string{}
+	for i := 0; i < 4; i++ {
+		event := <-events
+		assert.Equal(t, EventCreated, event.Type)
+		kinds = append(kinds, event.Kind)
+	}
+	assert.Equal(t, "segment,environment,feature,property", strings.Join(kinds, ","))
+
+	instance.set(&instance.properties, "")
+	event := <-events
+	assert.Equal(t, "deleted property dev/timeout", event.String())
+	cancel()
+	<-done
+}
@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"reflect"
+	"strings"
+
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultSignatureHeader is the header holding the signature of the notifications of a webhook destination whose
+// payload signing is enabled.
+const DefaultSignatureHeader = "X-En-Signature"
+
+// SignatureVerifier : Accepts the requests of a webhook destination whose payload signing is enabled. The signature
+// header holds a JSON Web Signature in the compact serialization, signed with the private key of the destination, and
+// PublicKey is its public key, as returned for the destination by Event Notifications. The signature is accepted if
+// it is valid, and if its payload is the request body: the same bytes or the same JSON value, or a detached payload.
+type SignatureVerifier struct {
+	// The public key of the destination, a *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey. See ParsePublicKey.
+	PublicKey crypto.PublicKey
+
+	// The header holding the signature, DefaultSignatureHeader if empty.
+	Header string
+
+	// Compares the payload of a valid signature with the request body, if the signed payload is not the body itself.
+	// It should return an error wrapping ErrUnauthorized if they do not match. The default requires the same JSON
+	// value.
+	MatchPayload func(payload []byte, body []byte) error
+}
+
+// ParsePublicKey parses a PEM encoded public key: a PKIX "PUBLIC KEY" block or a PKCS #1 "RSA PUBLIC KEY" block.
+func ParsePublicKey(content []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, core.SDKErrorf(nil, "no PEM encoded public key found", "invalid-public-key", common.GetComponentInfo())
+	}
+	var key crypto.PublicKey
+	var err error
+	if block.Type == "RSA PUBLIC KEY" {
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-public-key", common.GetComponentInfo())
+	}
+	return key, nil
+}
+
+// Verify checks the signature header of req against body.
+func (verifier *SignatureVerifier) Verify(req *http.Request, body []byte) error {
+	header := verifier.Header
+	if header == "" {
+		header = DefaultSignatureHeader
+	}
+	signature := strings.TrimSpace(req.Header.Get(header))
+	if signature == "" {
+		return fmt.Errorf("header %s: no signature: %w", header, ErrUnauthorized)
+	}
+	payload, err := verifier.verifySignature(signature, body)
+	if err != nil {
+		return fmt.Errorf("header %s: %v: %w", header, err, ErrUnauthorized)
+	}
+	if bytes.Equal(payload, body) {
+		return nil
+	}
+	if verifier.MatchPayload != nil {
+		return verifier.MatchPayload(payload, body)
+	}
+	var signed, received interface{}
+	if json.Unmarshal(payload, &signed) != nil || json.Unmarshal(body, &received) != nil || !reflect.DeepEqual(signed, received) {
+		return fmt.Errorf("header %s: the signed payload is not the request body: %w", header, ErrUnauthorized)
+	}
+	return nil
+}
+
+// verifySignature checks a compact JSON Web Signature and returns its payload, which is body if the payload is
+// detached.
+func (verifier *SignatureVerifier) verifySignature(signature string, body []byte) ([]byte, error) {
+	parts := strings.Split(signature, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("the signature is not a compact JSON Web Signature")
+	}
+	var protected struct {
+		Algorithm string `json:"alg"`
+	}
+	headerContent, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || json.Unmarshal(headerContent, &protected) != nil {
+		return nil, fmt.Errorf("the signature has an invalid header")
+	}
+	payload := body
+	if parts[1] == "" {
+		parts[1] = base64.RawURLEncoding.EncodeToString(body)
+	} else if payload, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+		return nil, fmt.Errorf("the signature has an invalid payload")
+	}
+	signed, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("the signature is not base64url encoded")
+	}
+	if err = verifyJWS(protected.Algorithm, verifier.PublicKey, []byte(parts[0]+"."+parts[1]), signed); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// jwsHashes are the hashes of the supported JSON Web Signature algorithms, other than EdDSA.
+var jwsHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+}
+
+// verifyJWS checks the signature of input with the algorithm of a JSON Web Signature, which must suit the key.
+func verifyJWS(algorithm string, key crypto.PublicKey, input []byte, signature []byte) error {
+	valid := false
+	hash, supported := jwsHashes[algorithm]
+	switch publicKey := key.(type) {
+	case ed25519.PublicKey:
+		supported = algorithm == "EdDSA"
+		valid = supported && ed25519.Verify(publicKey, input, signature)
+	case *rsa.PublicKey:
+		if supported {
+			sum := digest(hash, input)
+			switch algorithm[:2] {
+			case "RS":
+				valid = rsa.VerifyPKCS1v15(publicKey, hash, sum, signature) == nil
+			case "PS":
+				valid = rsa.VerifyPSS(publicKey, hash, sum, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+			}
+		}
+	case *ecdsa.PublicKey:
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		if supported && algorithm[:2] == "ES" && len(signature) == 2*size {
+			r := new(big.Int).SetBytes(signature[:size])
+			s := new(big.Int).SetBytes(signature[size:])
+			valid = ecdsa.Verify(publicKey, digest(hash, input), r, s)
+		}
+	default:
+		return fmt.Errorf("unsupported public key %T", key)
+	}
+	if !supported {
+		return fmt.Errorf("unsupported signature algorithm '%s'", algorithm)
+	}
+	if !valid {
+		return fmt.Errorf("invalid %s signature", algorithm)
+	}
+	return nil
+}
+
+func digest(hash crypto.Hash, input []byte) []byte {
+	digest := hash.New()
+	digest.Write(input)
+	return digest.Sum(nil)
+}
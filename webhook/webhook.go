@@ -0,0 +1,378 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package webhook : Receiver of the App Configuration notifications sent by Event Notifications
+// When an instance is integrated with Event Notifications (CreateIntegration with
+// CreateIntegrationMetadataCreateEnIntegrationMetadata), its changes are published as notifications that a webhook
+// destination delivers over HTTP. A Receiver is the http.Handler of such a destination: it verifies the requests,
+// decodes the notifications into events holding the Feature, Property or Segment models of the SDK and dispatches
+// them to the registered handlers.
+//
+// Event Notifications delivers a notification as a CloudEvents envelope in the structured JSON mode, with its
+// extension attributes: ibmensourceid, ibmenseverity, ibmendefaultshort and ibmendefaultlong (see Notification).
+// The data of the envelope is the App Configuration notification, in data or, base64 encoded, in data_base64. The
+// type of App Configuration notifications is TypePrefix followed by `<kind>:<action>`, and their data holds the
+// resource model, under the name of its kind or at the top level (see DecodeNotification). This layout of the App
+// Configuration data is not taken from a published schema: check it against the notifications of your instance, and
+// set Options.Decoder if they differ.
+//
+// SignatureVerifier checks the signature of the destinations whose payload signing is enabled. TokenVerifier only
+// compares a shared secret sent as a custom header of the destination, for destinations without signing.
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	common "github.com/IBM/appconfiguration-go-admin-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TypePrefix starts the type of App Configuration notifications, followed by `<kind>:<action>`, for example
+// `com.ibm.cloud.app-configuration.feature:toggle`. This naming is assumed, see the package documentation.
+const TypePrefix = "com.ibm.cloud.app-configuration."
+
+// DefaultMaxBodySize is the largest request body a Receiver reads if Options.MaxBodySize is not set.
+const DefaultMaxBodySize = 1 << 20
+
+// The kinds of resources notifications are sent for.
+const (
+	KindFeature  = appconfigurationv1.ConfigChange_Kind_Feature
+	KindProperty = appconfigurationv1.ConfigChange_Kind_Property
+	KindSegment  = appconfigurationv1.ConfigChange_Kind_Segment
+)
+
+// The actions notifications are sent for. ActionToggle is only sent for features.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+	ActionToggle = "toggle"
+)
+
+// ErrUnauthorized is returned by verifiers for requests that do not come from the Event Notifications destination.
+var ErrUnauthorized = errors.New("unauthorized notification request")
+
+// Notification : A notification as delivered by Event Notifications, in the CloudEvents format.
+type Notification struct {
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	SpecVersion     string    `json:"specversion"`
+	Type            string    `json:"type"`
+	Time            time.Time `json:"time"`
+	Subject         string    `json:"subject,omitempty"`
+	DataContentType string    `json:"datacontenttype,omitempty"`
+
+	// The ID of the Event Notifications source of the instance.
+	SourceID string `json:"ibmensourceid,omitempty"`
+
+	// The severity of the notification, for example `LOW`.
+	Severity string `json:"ibmenseverity,omitempty"`
+
+	// The short and long descriptions of the notification, used by the destinations that display a message.
+	DefaultShort string `json:"ibmendefaultshort,omitempty"`
+	DefaultLong  string `json:"ibmendefaultlong,omitempty"`
+
+	// The data of the notification. A notification delivered with data_base64 has it decoded here.
+	Data json.RawMessage `json:"data,omitempty"`
+
+	// The base64 encoded data of the notification, if it is not JSON.
+	DataBase64 string `json:"data_base64,omitempty"`
+}
+
+// Event : A decoded App Configuration notification. One of Feature, Property and Segment is set, according to Kind.
+// The resources of delete notifications may only have their ID set.
+type Event struct {
+	Notification Notification
+
+	// The kind of resource, one of the Kind* constants.
+	Kind string
+
+	// What happened to the resource, one of the Action* constants.
+	Action string
+
+	// The environment of the feature or property. Empty for segments.
+	EnvironmentID string
+
+	// The ID of the resource.
+	ID string
+
+	Feature  *appconfigurationv1.Feature
+	Property *appconfigurationv1.Property
+	Segment  *appconfigurationv1.Segment
+}
+
+// String returns a one-line description of the event, for example `toggle feature dev/checkout`.
+func (event *Event) String() string {
+	path := event.ID
+	if event.EnvironmentID != "" {
+		path = event.EnvironmentID + "/" + event.ID
+	}
+	return fmt.Sprintf("%s %s %s", event.Action, event.Kind, path)
+}
+
+// Decode decodes the body of a webhook request: a notification, or an array of notifications. The notifications that
+// are not App Configuration notifications, such as the test notifications of Event Notifications, are skipped.
+func Decode(body []byte) ([]*Event, error) {
+	var notifications []Notification
+	trimmed := strings.TrimSpace(string(body))
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(body, &notifications); err != nil {
+			return nil, core.SDKErrorf(err, "", "decode-notification-error", common.GetComponentInfo())
+		}
+	} else {
+		notification := Notification{}
+		if err := json.Unmarshal(body, &notification); err != nil {
+			return nil, core.SDKErrorf(err, "", "decode-notification-error", common.GetComponentInfo())
+		}
+		notifications = []Notification{notification}
+	}
+
+	events := []*Event{}
+	for _, notification := range notifications {
+		if !strings.HasPrefix(notification.Type, TypePrefix) {
+			continue
+		}
+		event, err := DecodeNotification(notification)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// DecodeNotification decodes an App Configuration notification. Its data holds the ID of the environment, for features
+// and properties, and the resource under the name of its kind, or the fields of the resource at the top level:
+//
+//	{"environment_id": "dev", "feature": {"feature_id": "checkout", ...}}
+//	{"environment_id": "dev", "feature_id": "checkout", ...}
+func DecodeNotification(notification Notification) (*Event, error) {
+	kind, action, found := strings.Cut(strings.TrimPrefix(notification.Type, TypePrefix), ":")
+	if !strings.HasPrefix(notification.Type, TypePrefix) || !found {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("'%s' is not an App Configuration notification type", notification.Type), "invalid-notification-type", common.GetComponentInfo())
+	}
+	event := &Event{Notification: notification, Kind: kind, Action: action}
+
+	content := []byte(notification.Data)
+	if len(content) == 0 && notification.DataBase64 != "" {
+		var err error
+		if content, err = base64.StdEncoding.DecodeString(notification.DataBase64); err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("notification '%s' has invalid data", notification.ID), "decode-notification-error", common.GetComponentInfo())
+		}
+		event.Notification.Data = content
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("notification '%s' has invalid data", notification.ID), "decode-notification-error", common.GetComponentInfo())
+	}
+	if raw, ok := data["environment_id"]; ok {
+		if err := json.Unmarshal(raw, &event.EnvironmentID); err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("notification '%s' has an invalid environment ID", notification.ID), "decode-notification-error", common.GetComponentInfo())
+		}
+	}
+	if _, ok := data[kind]; !ok {
+		data = map[string]json.RawMessage{kind: content}
+	}
+
+	var err error
+	switch kind {
+	case KindFeature:
+		err = core.UnmarshalModel(data, kind, &event.Feature, appconfigurationv1.UnmarshalFeature)
+		if err == nil {
+			event.ID = stringValue(event.Feature.FeatureID)
+		}
+	case KindProperty:
+		err = core.UnmarshalModel(data, kind, &event.Property, appconfigurationv1.UnmarshalProperty)
+		if err == nil {
+			event.ID = stringValue(event.Property.PropertyID)
+		}
+	case KindSegment:
+		err = core.UnmarshalModel(data, kind, &event.Segment, appconfigurationv1.UnmarshalSegment)
+		if err == nil {
+			event.ID = stringValue(event.Segment.SegmentID)
+		}
+	default:
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("notification '%s' is for an unknown kind of resource '%s'", notification.ID, kind), "invalid-notification-type", common.GetComponentInfo())
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("notification '%s' has an invalid %s", notification.ID, kind), "decode-notification-error", common.GetComponentInfo())
+	}
+	if event.ID == "" {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("notification '%s' has no %s", notification.ID, kind), "decode-notification-error", common.GetComponentInfo())
+	}
+	return event, nil
+}
+
+// Verifier : Checks that a request comes from the Event Notifications destination, before its body is decoded.
+// Verifiers should return an error wrapping ErrUnauthorized for requests that are rejected.
+type Verifier interface {
+	Verify(req *http.Request, body []byte) error
+}
+
+// VerifierFunc : Adapts a function to the Verifier interface.
+type VerifierFunc func(req *http.Request, body []byte) error
+
+// Verify calls verifierFunc.
+func (verifierFunc VerifierFunc) Verify(req *http.Request, body []byte) error {
+	return verifierFunc(req, body)
+}
+
+// TokenVerifier : Accepts the requests whose Header is Token, a secret configured as a custom header of the webhook
+// destination. The comparison takes a constant time. It only proves that the sender knows the secret: use
+// SignatureVerifier for the destinations whose payload signing is enabled.
+type TokenVerifier struct {
+	Header string
+	Token  string
+}
+
+// Verify checks the header of req.
+func (verifier *TokenVerifier) Verify(req *http.Request, body []byte) error {
+	value := req.Header.Get(verifier.Header)
+	if verifier.Token == "" || subtle.ConstantTimeCompare([]byte(value), []byte(verifier.Token)) != 1 {
+		return fmt.Errorf("header %s: %w", verifier.Header, ErrUnauthorized)
+	}
+	return nil
+}
+
+// HandlerFunc : Handles an event. An error makes the Receiver answer with a server error, so that Event Notifications
+// delivers the notification again.
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+// Options : The options of a Receiver.
+type Options struct {
+	// Checks the requests. Required unless InsecureSkipVerify is set.
+	Verifier Verifier
+
+	// Accept every request. Only use it behind a proxy that authenticates the requests, or in tests.
+	InsecureSkipVerify bool
+
+	// The largest request body read, DefaultMaxBodySize if zero.
+	MaxBodySize int64
+
+	// Decodes the verified request bodies into events, Decode if nil. Set it if the notifications of the instance do
+	// not have the format expected by Decode.
+	Decoder func(body []byte) ([]*Event, error)
+
+	// Called with the errors of the requests that are rejected and of the handlers that fail. The event is nil if the
+	// request was rejected before being decoded.
+	OnError func(event *Event, err error)
+}
+
+type registration struct {
+	kind    string
+	action  string
+	handler HandlerFunc
+}
+
+// Receiver : An http.Handler receiving the notifications of a webhook destination. Handlers must be registered before
+// the Receiver serves requests.
+type Receiver struct {
+	options       Options
+	registrations []registration
+}
+
+// NewReceiver returns a Receiver without handlers.
+func NewReceiver(options *Options) (*Receiver, error) {
+	receiver := &Receiver{}
+	if options != nil {
+		receiver.options = *options
+	}
+	if receiver.options.Verifier == nil && !receiver.options.InsecureSkipVerify {
+		return nil, core.SDKErrorf(nil, "a verifier is required unless InsecureSkipVerify is set", "missing-verifier", common.GetComponentInfo())
+	}
+	if receiver.options.MaxBodySize == 0 {
+		receiver.options.MaxBodySize = DefaultMaxBodySize
+	}
+	if receiver.options.Decoder == nil {
+		receiver.options.Decoder = Decode
+	}
+	return receiver, nil
+}
+
+// Handle registers handler for the events of a kind of resource and an action. An empty kind or action matches any.
+// The handlers of an event are called in the order they were registered.
+func (receiver *Receiver) Handle(kind string, action string, handler HandlerFunc) {
+	receiver.registrations = append(receiver.registrations, registration{kind: kind, action: action, handler: handler})
+}
+
+// Dispatch calls the handlers of event, stopping at the first error.
+func (receiver *Receiver) Dispatch(ctx context.Context, event *Event) error {
+	for _, registration := range receiver.registrations {
+		if (registration.kind == "" || registration.kind == event.Kind) && (registration.action == "" || registration.action == event.Action) {
+			if err := registration.handler(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ServeHTTP verifies and decodes a webhook request and dispatches its events. It answers 204 once every handler
+// succeeded, 401 for requests rejected by the verifier, 400 for invalid notifications and 500 if a handler failed.
+func (receiver *Receiver) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		res.Header().Set("Allow", http.MethodPost)
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(res, req.Body, receiver.options.MaxBodySize))
+	if err != nil {
+		receiver.reject(res, nil, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	if receiver.options.Verifier != nil {
+		if err = receiver.options.Verifier.Verify(req, body); err != nil {
+			receiver.reject(res, nil, http.StatusUnauthorized, err)
+			return
+		}
+	}
+	events, err := receiver.options.Decoder(body)
+	if err != nil {
+		receiver.reject(res, nil, http.StatusBadRequest, err)
+		return
+	}
+	for _, event := range events {
+		if err = receiver.Dispatch(req.Context(), event); err != nil {
+			receiver.reject(res, event, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (receiver *Receiver) reject(res http.ResponseWriter, event *Event, status int, err error) {
+	if receiver.options.OnError != nil {
+		receiver.options.OnError(event, err)
+	}
+	http.Error(res, http.StatusText(status), status)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,250 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/appconfiguration-go-admin-sdk/webhook"
+	"github.com/IBM/appconfiguration-go-admin-sdk/webhook/webhooktest"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestdata(t *testing.T, name string) []byte {
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	require.Nil(t, err)
+	return content
+}
+
+func TestDecode(t *testing.T) {
+	events, err := webhook.Decode(readTestdata(t, "feature_toggle.json"))
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "toggle feature dev/checkout", events[0].String())
+	assert.Equal(t, true, *events[0].Feature.Enabled)
+	assert.Equal(t, int64(50), *events[0].Feature.SegmentRules[0].RolloutPercentage)
+	assert.Equal(t, "shop", *events[0].Feature.Collections[0].CollectionID)
+	assert.Equal(t, "LOW", events[0].Notification.Severity)
+	assert.Equal(t, "Feature flag Checkout was turned on", events[0].Notification.DefaultShort)
+	assert.Equal(t, "5c0d7b1e-3a2f-4d8c-9e6b-7f1a2b3c4d5e:api", events[0].Notification.SourceID)
+	assert.Equal(t, time.Date(2026, 10, 12, 9, 41, 27, 318000000, time.UTC), events[0].Notification.Time)
+
+	// The test notification of Event Notifications is skipped, the property has its fields at the top level of the
+	// data, and the segment has base64 encoded data.
+	events, err = webhook.Decode(readTestdata(t, "notifications.json"))
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "update property dev/timeout", events[0].String())
+	assert.Equal(t, float64(45), events[0].Property.Value)
+	assert.Equal(t, "delete segment beta", events[1].String())
+	assert.Nil(t, events[1].Segment.Name)
+	assert.JSONEq(t, `{"segment": {"segment_id": "beta"}}`, string(events[1].Notification.Data))
+
+	_, err = webhook.Decode([]byte(`{"type": "com.ibm.cloud.app-configuration.feature:create", "data": {"environment_id": "dev"}}`))
+	assert.NotNil(t, err)
+	_, err = webhook.Decode([]byte(`{"type": "com.ibm.cloud.app-configuration.segment:create", "data_base64": "not base64"}`))
+	assert.NotNil(t, err)
+	_, err = webhook.Decode([]byte(`{"type": "com.ibm.cloud.app-configuration.collection:create", "data": {"collection": {}}}`))
+	assert.NotNil(t, err)
+	_, err = webhook.Decode([]byte(`not json`))
+	assert.NotNil(t, err)
+}
+
+func TestSamples(t *testing.T) {
+	payload := webhooktest.Payload(
+		webhooktest.FeatureNotification(webhook.ActionToggle, "dev", webhooktest.SampleFeature()),
+		webhooktest.PropertyNotification(webhook.ActionDelete, "dev", &appconfigurationv1.Property{PropertyID: core.StringPtr("timeout")}),
+		webhooktest.SegmentNotification(webhook.ActionUpdate, webhooktest.SampleSegment()),
+	)
+	events, err := webhook.Decode(payload)
+	require.Nil(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "toggle feature dev/checkout", events[0].String())
+	assert.Equal(t, "delete property dev/timeout", events[1].String())
+	assert.Nil(t, events[1].Property.Value)
+	assert.Equal(t, "update segment beta", events[2].String())
+	assert.Equal(t, "@ibm.com", events[2].Segment.Rules[0].Values[0])
+}
+
+func TestSignatureVerifier(t *testing.T) {
+	publicKey, err := webhook.ParsePublicKey(readTestdata(t, "public_key.pem"))
+	require.Nil(t, err)
+	verifier := &webhook.SignatureVerifier{PublicKey: publicKey}
+	payload := readTestdata(t, "feature_toggle.json")
+	signature := strings.TrimSpace(string(readTestdata(t, "feature_toggle.json.sig")))
+
+	verify := func(body []byte, signature string) error {
+		return verifier.Verify(webhooktest.NewRequest(body, webhook.DefaultSignatureHeader, signature), body)
+	}
+	assert.Nil(t, verify(payload, signature))
+
+	// The same JSON value, formatted otherwise, is accepted.
+	var value interface{}
+	require.Nil(t, json.Unmarshal(payload, &value))
+	compact, err := json.Marshal(value)
+	require.Nil(t, err)
+	assert.Nil(t, verify(compact, signature))
+
+	tampered := bytes.Replace(payload, []byte(`"enabled": true`), []byte(`"enabled": false`), 1)
+	assert.True(t, errors.Is(verify(tampered, signature), webhook.ErrUnauthorized))
+	parts := strings.Split(signature, ".")
+	assert.True(t, errors.Is(verify(payload, parts[0]+".."+parts[2]+"x"), webhook.ErrUnauthorized))
+	assert.True(t, errors.Is(verify(payload, ""), webhook.ErrUnauthorized))
+	assert.True(t, errors.Is(verify(payload, "not a signature"), webhook.ErrUnauthorized))
+
+	_, err = webhook.ParsePublicKey([]byte("not a key"))
+	assert.NotNil(t, err)
+}
+
+func TestSignatureVerifierAlgorithms(t *testing.T) {
+	payload := readTestdata(t, "feature_toggle.json")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	for _, key := range []crypto.Signer{rsaKey, ecdsaKey, ed25519Key} {
+		verifier := &webhook.SignatureVerifier{PublicKey: key.Public()}
+		assert.Nil(t, verifier.Verify(webhooktest.NewSignedRequest(payload, key), payload), "%T", key)
+
+		// A detached payload is the request body.
+		parts := strings.Split(webhooktest.Sign(payload, key), ".")
+		assert.Nil(t, verifier.Verify(webhooktest.NewRequest(payload, webhook.DefaultSignatureHeader, parts[0]+".."+parts[2]), payload), "%T", key)
+		assert.True(t, errors.Is(verifier.Verify(webhooktest.NewRequest(payload, webhook.DefaultSignatureHeader, parts[0]+".."+parts[2]), payload[1:]), webhook.ErrUnauthorized))
+	}
+
+	// A signature of another key, or of an algorithm not suiting the key, is rejected.
+	verifier := &webhook.SignatureVerifier{PublicKey: rsaKey.Public()}
+	assert.True(t, errors.Is(verifier.Verify(webhooktest.NewSignedRequest(payload, ecdsaKey), payload), webhook.ErrUnauthorized))
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	assert.True(t, errors.Is(verifier.Verify(webhooktest.NewSignedRequest(payload, otherKey), payload), webhook.ErrUnauthorized))
+}
+
+func TestReceiver(t *testing.T) {
+	_, err := webhook.NewReceiver(nil)
+	assert.NotNil(t, err)
+
+	publicKey, err := webhook.ParsePublicKey(readTestdata(t, "public_key.pem"))
+	require.Nil(t, err)
+	var rejected []error
+	receiver, err := webhook.NewReceiver(&webhook.Options{
+		Verifier: &webhook.SignatureVerifier{PublicKey: publicKey},
+		OnError:  func(event *webhook.Event, err error) { rejected = append(rejected, err) },
+	})
+	require.Nil(t, err)
+
+	var toggled, all []string
+	fail := false
+	receiver.Handle(webhook.KindFeature, webhook.ActionToggle, func(ctx context.Context, event *webhook.Event) error {
+		toggled = append(toggled, event.ID)
+		return nil
+	})
+	receiver.Handle("", "", func(ctx context.Context, event *webhook.Event) error {
+		all = append(all, event.String())
+		if fail {
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+
+	payload := readTestdata(t, "feature_toggle.json")
+	signature := strings.TrimSpace(string(readTestdata(t, "feature_toggle.json.sig")))
+	serve := func(req *http.Request) int {
+		recorder := httptest.NewRecorder()
+		receiver.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve(webhooktest.NewRequest(payload, webhook.DefaultSignatureHeader, signature)))
+	assert.Equal(t, []string{"checkout"}, toggled)
+	assert.Equal(t, []string{"toggle feature dev/checkout"}, all)
+
+	assert.Equal(t, http.StatusUnauthorized, serve(webhooktest.NewRequest(payload, "", "")))
+	assert.Equal(t, http.StatusUnauthorized, serve(webhooktest.NewRequest(readTestdata(t, "notifications.json"), webhook.DefaultSignatureHeader, signature)))
+	assert.True(t, errors.Is(rejected[0], webhook.ErrUnauthorized))
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(httptest.NewRequest(http.MethodGet, "/", nil)))
+
+	fail = true
+	assert.Equal(t, http.StatusInternalServerError, serve(webhooktest.NewRequest(payload, webhook.DefaultSignatureHeader, signature)))
+	assert.Len(t, rejected, 3)
+	assert.Len(t, toggled, 2)
+}
+
+func TestReceiverWithToken(t *testing.T) {
+	receiver, err := webhook.NewReceiver(&webhook.Options{
+		Verifier: &webhook.TokenVerifier{Header: "X-Webhook-Token", Token: "s3cret"},
+	})
+	require.Nil(t, err)
+	var events []string
+	receiver.Handle("", "", func(ctx context.Context, event *webhook.Event) error {
+		events = append(events, event.String())
+		return nil
+	})
+	serve := func(req *http.Request) int {
+		recorder := httptest.NewRecorder()
+		receiver.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	payload := readTestdata(t, "notifications.json")
+	assert.Equal(t, http.StatusNoContent, serve(webhooktest.NewRequest(payload, "X-Webhook-Token", "s3cret")))
+	assert.Equal(t, []string{"update property dev/timeout", "delete segment beta"}, events)
+	assert.Equal(t, http.StatusUnauthorized, serve(webhooktest.NewRequest(payload, "X-Webhook-Token", "guess")))
+	assert.Equal(t, http.StatusBadRequest, serve(webhooktest.NewRequest([]byte(`{`), "X-Webhook-Token", "s3cret")))
+}
+
+func TestReceiverWithCustomDecoder(t *testing.T) {
+	receiver, err := webhook.NewReceiver(&webhook.Options{
+		InsecureSkipVerify: true,
+		Decoder: func(body []byte) ([]*webhook.Event, error) {
+			return []*webhook.Event{{Kind: webhook.KindFeature, Action: webhook.ActionUpdate, EnvironmentID: "dev", ID: string(body)}}, nil
+		},
+	})
+	require.Nil(t, err)
+	var events []string
+	receiver.Handle(webhook.KindFeature, "", func(ctx context.Context, event *webhook.Event) error {
+		events = append(events, event.String())
+		return nil
+	})
+
+	recorder := httptest.NewRecorder()
+	receiver.ServeHTTP(recorder, webhooktest.NewRequest([]byte("checkout"), "", ""))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, []string{"update feature dev/checkout"}, events)
+}
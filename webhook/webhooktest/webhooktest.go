@@ -0,0 +1,194 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package webhooktest : Sample App Configuration notifications for testing webhook receivers
+// The samples have the Event Notifications envelope, with the App Configuration data in the layout decoded by the
+// webhook package, and Sign signs them as a webhook destination with payload signing does.
+package webhooktest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/appconfiguration-go-admin-sdk/webhook"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Source is the source of the sample notifications.
+const Source = "crn:v1:bluemix:public:apprapp:us-south:a/0123456789:0123-4567-89ab-cdef::"
+
+var sequence atomic.Int64
+
+// Notification returns a notification of an action on a resource, the data of which is data.
+func Notification(kind string, action string, data map[string]interface{}) webhook.Notification {
+	buffer, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	return webhook.Notification{
+		ID:              fmt.Sprintf("sample-%d", sequence.Add(1)),
+		Source:          Source,
+		SpecVersion:     "1.0",
+		Type:            webhook.TypePrefix + kind + ":" + action,
+		Time:            time.Now().UTC().Truncate(time.Millisecond),
+		DataContentType: "application/json",
+		SourceID:        "app-configuration",
+		Severity:        "LOW",
+		DefaultShort:    fmt.Sprintf("App Configuration %s %s", kind, action),
+		DefaultLong:     fmt.Sprintf("An App Configuration %s had the action %s.", kind, action),
+		Data:            buffer,
+	}
+}
+
+// FeatureNotification returns a notification of an action on a feature of an environment.
+func FeatureNotification(action string, environmentID string, feature *appconfigurationv1.Feature) webhook.Notification {
+	return Notification(webhook.KindFeature, action, map[string]interface{}{"environment_id": environmentID, webhook.KindFeature: feature})
+}
+
+// PropertyNotification returns a notification of an action on a property of an environment.
+func PropertyNotification(action string, environmentID string, property *appconfigurationv1.Property) webhook.Notification {
+	return Notification(webhook.KindProperty, action, map[string]interface{}{"environment_id": environmentID, webhook.KindProperty: property})
+}
+
+// SegmentNotification returns a notification of an action on a segment.
+func SegmentNotification(action string, segment *appconfigurationv1.Segment) webhook.Notification {
+	return Notification(webhook.KindSegment, action, map[string]interface{}{webhook.KindSegment: segment})
+}
+
+// Payload returns the body of a webhook request delivering notifications: a single notification, or an array.
+func Payload(notifications ...webhook.Notification) []byte {
+	var value interface{} = notifications
+	if len(notifications) == 1 {
+		value = notifications[0]
+	}
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return buffer
+}
+
+// NewRequest returns a webhook request delivering payload, with the header set to token if header is not empty, as
+// checked by webhook.TokenVerifier.
+func NewRequest(payload []byte, header string, token string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	if header != "" {
+		req.Header.Set(header, token)
+	}
+	return req
+}
+
+// Sign returns the compact JSON Web Signature of payload with key, as checked by webhook.SignatureVerifier: RS256 for
+// a *rsa.PrivateKey, ES256 for a P-256 *ecdsa.PrivateKey and EdDSA for an ed25519.PrivateKey.
+func Sign(payload []byte, key crypto.Signer) string {
+	algorithm := "RS256"
+	switch key.(type) {
+	case *ecdsa.PrivateKey:
+		algorithm = "ES256"
+	case ed25519.PrivateKey:
+		algorithm = "EdDSA"
+	}
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "typ": "JWT"})
+	if err != nil {
+		panic(err)
+	}
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	switch signer := key.(type) {
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(signer, []byte(input))
+	case *ecdsa.PrivateKey:
+		sum := sha256.Sum256([]byte(input))
+		r, s, signErr := ecdsa.Sign(rand.Reader, signer, sum[:])
+		if signErr != nil {
+			panic(signErr)
+		}
+		size := (signer.Curve.Params().BitSize + 7) / 8
+		signature = make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+	default:
+		sum := sha256.Sum256([]byte(input))
+		if signature, err = key.Sign(rand.Reader, sum[:], crypto.SHA256); err != nil {
+			panic(err)
+		}
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// NewSignedRequest returns a webhook request delivering payload, signed with key in the
+// webhook.DefaultSignatureHeader header.
+func NewSignedRequest(payload []byte, key crypto.Signer) *http.Request {
+	return NewRequest(payload, webhook.DefaultSignatureHeader, Sign(payload, key))
+}
+
+// SampleFeature returns a boolean feature targeting a segment.
+func SampleFeature() *appconfigurationv1.Feature {
+	return &appconfigurationv1.Feature{
+		Name:              core.StringPtr("Checkout"),
+		FeatureID:         core.StringPtr("checkout"),
+		Type:              core.StringPtr(appconfigurationv1.Feature_Type_Boolean),
+		EnabledValue:      true,
+		DisabledValue:     false,
+		Enabled:           core.BoolPtr(true),
+		RolloutPercentage: core.Int64Ptr(100),
+		SegmentRules: []appconfigurationv1.FeatureSegmentRule{{
+			Rules:             []appconfigurationv1.TargetSegments{{Segments: []string{"beta"}}},
+			Value:             true,
+			Order:             core.Int64Ptr(1),
+			RolloutPercentage: core.Int64Ptr(50),
+		}},
+		Collections: []appconfigurationv1.CollectionRef{{CollectionID: core.StringPtr("shop")}},
+	}
+}
+
+// SampleProperty returns a numeric property.
+func SampleProperty() *appconfigurationv1.Property {
+	return &appconfigurationv1.Property{
+		Name:        core.StringPtr("Timeout"),
+		PropertyID:  core.StringPtr("timeout"),
+		Type:        core.StringPtr(appconfigurationv1.Property_Type_Numeric),
+		Value:       30,
+		Collections: []appconfigurationv1.CollectionRef{{CollectionID: core.StringPtr("shop")}},
+	}
+}
+
+// SampleSegment returns the segment targeted by SampleFeature.
+func SampleSegment() *appconfigurationv1.Segment {
+	return &appconfigurationv1.Segment{
+		Name:      core.StringPtr("Beta"),
+		SegmentID: core.StringPtr("beta"),
+		Rules: []appconfigurationv1.Rule{{
+			AttributeName: core.StringPtr("email"),
+			Operator:      core.StringPtr(appconfigurationv1.Rule_Operator_Endswith),
+			Values:        []string{"@ibm.com"},
+		}},
+	}
+}